@@ -0,0 +1,119 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/store/streaming/v1/changeset.proto
+
+package streamingv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ChangesetSink_ListenChangeset_FullMethodName = "/cosmos.store.streaming.v1.ChangesetSink/ListenChangeset"
+)
+
+// ChangesetSinkClient is the client API for ChangesetSink service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChangesetSinkClient interface {
+	// ListenChangeset delivers the changeset of a committed version. Changesets
+	// are delivered at least once and in order of version, thus a changeset may
+	// be delivered again, e.g. after a restart, and the sink is expected to
+	// handle it idempotently. An error response causes the changeset to be
+	// delivered again.
+	ListenChangeset(ctx context.Context, in *ListenChangesetRequest, opts ...grpc.CallOption) (*ListenChangesetResponse, error)
+}
+
+type changesetSinkClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChangesetSinkClient(cc grpc.ClientConnInterface) ChangesetSinkClient {
+	return &changesetSinkClient{cc}
+}
+
+func (c *changesetSinkClient) ListenChangeset(ctx context.Context, in *ListenChangesetRequest, opts ...grpc.CallOption) (*ListenChangesetResponse, error) {
+	out := new(ListenChangesetResponse)
+	err := c.cc.Invoke(ctx, ChangesetSink_ListenChangeset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChangesetSinkServer is the server API for ChangesetSink service.
+// All implementations must embed UnimplementedChangesetSinkServer
+// for forward compatibility
+type ChangesetSinkServer interface {
+	// ListenChangeset delivers the changeset of a committed version. Changesets
+	// are delivered at least once and in order of version, thus a changeset may
+	// be delivered again, e.g. after a restart, and the sink is expected to
+	// handle it idempotently. An error response causes the changeset to be
+	// delivered again.
+	ListenChangeset(context.Context, *ListenChangesetRequest) (*ListenChangesetResponse, error)
+	mustEmbedUnimplementedChangesetSinkServer()
+}
+
+// UnimplementedChangesetSinkServer must be embedded to have forward compatible implementations.
+type UnimplementedChangesetSinkServer struct {
+}
+
+func (UnimplementedChangesetSinkServer) ListenChangeset(context.Context, *ListenChangesetRequest) (*ListenChangesetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListenChangeset not implemented")
+}
+func (UnimplementedChangesetSinkServer) mustEmbedUnimplementedChangesetSinkServer() {}
+
+// UnsafeChangesetSinkServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChangesetSinkServer will
+// result in compilation errors.
+type UnsafeChangesetSinkServer interface {
+	mustEmbedUnimplementedChangesetSinkServer()
+}
+
+func RegisterChangesetSinkServer(s grpc.ServiceRegistrar, srv ChangesetSinkServer) {
+	s.RegisterService(&ChangesetSink_ServiceDesc, srv)
+}
+
+func _ChangesetSink_ListenChangeset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListenChangesetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangesetSinkServer).ListenChangeset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChangesetSink_ListenChangeset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangesetSinkServer).ListenChangeset(ctx, req.(*ListenChangesetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChangesetSink_ServiceDesc is the grpc.ServiceDesc for ChangesetSink service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChangesetSink_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.store.streaming.v1.ChangesetSink",
+	HandlerType: (*ChangesetSinkServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListenChangeset",
+			Handler:    _ChangesetSink_ListenChangeset_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/store/streaming/v1/changeset.proto",
+}
@@ -11,6 +11,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/gogoproto/proto"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
 
@@ -387,6 +388,9 @@ func (app *BaseApp) CheckTx(req *abci.RequestCheckTx) (*abci.ResponseCheckTx, er
 // Ref: https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-060-abci-1.0.md
 // Ref: https://github.com/cometbft/cometbft/blob/main/spec/abci/abci%2B%2B_basic_concepts.md
 func (app *BaseApp) PrepareProposal(req *abci.RequestPrepareProposal) (resp *abci.ResponsePrepareProposal, err error) {
+	_, span := telemetry.StartSpan(context.Background(), "PrepareProposal", attribute.Int64(spanAttrHeight, req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	if app.prepareProposal == nil {
 		return nil, errors.New("PrepareProposal handler not set")
 	}
@@ -427,7 +431,7 @@ func (app *BaseApp) PrepareProposal(req *abci.RequestPrepareProposal) (resp *abc
 			Time:    req.Time,
 		}))
 
-	app.prepareProposalState.SetContext(app.prepareProposalState.Context().
+	app.prepareProposalState.SetContext(withSpan(app.prepareProposalState.Context(), span).
 		WithConsensusParams(app.GetConsensusParams(app.prepareProposalState.Context())).
 		WithBlockGasMeter(app.getBlockGasMeter(app.prepareProposalState.Context())))
 
@@ -447,9 +451,11 @@ func (app *BaseApp) PrepareProposal(req *abci.RequestPrepareProposal) (resp *abc
 	resp, err = app.prepareProposal(app.prepareProposalState.Context(), req)
 	if err != nil {
 		app.logger.Error("failed to prepare proposal", "height", req.Height, "time", req.Time, "err", err)
+		span.RecordError(err)
 		return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
 	}
 
+	span.SetAttributes(attribute.Int(spanAttrNumTxs, len(resp.Txs)))
 	return resp, nil
 }
 
@@ -469,6 +475,12 @@ func (app *BaseApp) PrepareProposal(req *abci.RequestPrepareProposal) (resp *abc
 // Ref: https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-060-abci-1.0.md
 // Ref: https://github.com/cometbft/cometbft/blob/main/spec/abci/abci%2B%2B_basic_concepts.md
 func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abci.ResponseProcessProposal, err error) {
+	_, span := telemetry.StartSpan(context.Background(), "ProcessProposal",
+		attribute.Int64(spanAttrHeight, req.Height),
+		attribute.Int(spanAttrNumTxs, len(req.Txs)),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	if app.processProposal == nil {
 		return nil, errors.New("ProcessProposal handler not set")
 	}
@@ -521,7 +533,7 @@ func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abc
 			Time:    req.Time,
 		}))
 
-	app.processProposalState.SetContext(app.processProposalState.Context().
+	app.processProposalState.SetContext(withSpan(app.processProposalState.Context(), span).
 		WithConsensusParams(app.GetConsensusParams(app.processProposalState.Context())).
 		WithBlockGasMeter(app.getBlockGasMeter(app.processProposalState.Context())))
 
@@ -541,6 +553,7 @@ func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abc
 	resp, err = app.processProposal(app.processProposalState.Context(), req)
 	if err != nil {
 		app.logger.Error("failed to process proposal", "height", req.Height, "time", req.Time, "hash", fmt.Sprintf("%X", req.Hash), "err", err)
+		span.RecordError(err)
 		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
 	}
 
@@ -710,9 +723,15 @@ func (app *BaseApp) VerifyVoteExtension(req *abci.RequestVerifyVoteExtension) (r
 // Execution flow or by the FinalizeBlock ABCI method. The context received is
 // only used to handle early cancellation, for anything related to state app.finalizeBlockState.Context()
 // must be used.
-func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.RequestFinalizeBlock) (_ *abci.ResponseFinalizeBlock, err error) {
 	var events []abci.Event
 
+	_, span := telemetry.StartSpan(ctx, "ExecuteBlock",
+		attribute.Int64(spanAttrHeight, req.Height),
+		attribute.Int(spanAttrNumTxs, len(req.Txs)),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	if err := app.checkHalt(req.Height, req.Time); err != nil {
 		return nil, err
 	}
@@ -766,7 +785,7 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 
 	// GasMeter must be set after we get a context with updated consensus params.
 	gasMeter := app.getBlockGasMeter(app.finalizeBlockState.Context())
-	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().WithBlockGasMeter(gasMeter))
+	app.finalizeBlockState.SetContext(withSpan(app.finalizeBlockState.Context(), span).WithBlockGasMeter(gasMeter))
 
 	if app.checkState != nil {
 		app.checkState.SetContext(app.checkState.Context().
@@ -872,6 +891,9 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 // extensions into the proposal, which should not themselves be executed in cases
 // where they adhere to the sdk.Tx interface.
 func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.ResponseFinalizeBlock, err error) {
+	ctx, span := telemetry.StartSpan(context.Background(), "FinalizeBlock", attribute.Int64(spanAttrHeight, req.Height))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	defer func() {
 		// call the streaming service hooks with the FinalizeBlock messages
 		for _, streamingListener := range app.streamingManager.ABCIListeners {
@@ -902,7 +924,7 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.Res
 	}
 
 	// if no OE is running, just run the block (this is either a block replay or a OE that got aborted)
-	res, err = app.internalFinalizeBlock(context.Background(), req)
+	res, err = app.internalFinalizeBlock(ctx, req)
 	if res != nil {
 		res.AppHash = app.workingHash()
 	}
@@ -939,6 +961,10 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 	header := app.finalizeBlockState.Context().BlockHeader()
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
 
+	_, span := telemetry.StartSpan(context.Background(), "Commit", attribute.Int64(spanAttrHeight, header.Height))
+	defer span.End()
+	app.finalizeBlockState.SetContext(withSpan(app.finalizeBlockState.Context(), span))
+
 	if app.precommiter != nil {
 		app.precommiter(app.finalizeBlockState.Context())
 	}
@@ -948,7 +974,9 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 		rms.SetCommitHeader(header)
 	}
 
+	_, storeSpan := startSpan(app.finalizeBlockState.Context(), "CommitMultiStore")
 	app.cms.Commit()
+	storeSpan.End()
 
 	resp := &abci.ResponseCommit{
 		RetainHeight: retainHeight,
@@ -277,7 +277,7 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 		}
 
 		iterator := h.mempool.Select(ctx, req.Txs)
-		selectedTxsSignersSeqs := make(map[string]uint64)
+		selectedTxsSignersSeqs := make(signerSequences)
 		var selectedTxsNums int
 		for iterator != nil {
 			memTx := iterator.Tx()
@@ -286,27 +286,8 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 				return nil, err
 			}
 
-			// If the signers aren't in selectedTxsSignersSeqs then we haven't seen them before
-			// so we add them and continue given that we don't need to check the sequence.
-			shouldAdd := true
-			txSignersSeqs := make(map[string]uint64)
-			for _, signer := range signerData {
-				seq, ok := selectedTxsSignersSeqs[signer.Signer.String()]
-				if !ok {
-					txSignersSeqs[signer.Signer.String()] = signer.Sequence
-					continue
-				}
-
-				// If we have seen this signer before in this block, we must make
-				// sure that the current sequence is seq+1; otherwise is invalid
-				// and we skip it.
-				if seq+1 != signer.Sequence {
-					shouldAdd = false
-					break
-				}
-				txSignersSeqs[signer.Signer.String()] = signer.Sequence
-			}
-			if !shouldAdd {
+			txSignersSeqs, ok := selectedTxsSignersSeqs.check(signerData)
+			if !ok {
 				iterator = iterator.Next()
 				continue
 			}
@@ -327,21 +308,10 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 					break
 				}
 
+				// If txsLen != selectedTxsNums is true, it means that we've added
+				// a new tx to the selected txs.
 				txsLen := len(h.txSelector.SelectedTxs(ctx))
-				for sender, seq := range txSignersSeqs {
-					// If txsLen != selectedTxsNums is true, it means that we've
-					// added a new tx to the selected txs, so we need to update
-					// the sequence of the sender.
-					if txsLen != selectedTxsNums {
-						selectedTxsSignersSeqs[sender] = seq
-					} else if _, ok := selectedTxsSignersSeqs[sender]; !ok {
-						// The transaction hasn't been added but it passed the
-						// verification, so we know that the sequence is correct.
-						// So we set this sender's sequence to seq-1, in order
-						// to avoid unnecessary calls to PrepareProposalVerifyTx.
-						selectedTxsSignersSeqs[sender] = seq - 1
-					}
-				}
+				selectedTxsSignersSeqs.update(txSignersSeqs, txsLen != selectedTxsNums)
 				selectedTxsNums = txsLen
 			}
 
@@ -401,6 +371,44 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 	}
 }
 
+// signerSequences tracks the sequence of the signers of the transactions
+// selected for a proposal, to only select transactions following the previous
+// transaction of each of their signers.
+type signerSequences map[string]uint64
+
+// check returns the sequences of the signers of a transaction, and false if the
+// transaction doesn't follow the last selected transaction of one of its
+// signers, in which case it must be skipped.
+func (s signerSequences) check(signerData []mempool.SignerData) (map[string]uint64, bool) {
+	txSignersSeqs := make(map[string]uint64)
+	for _, signer := range signerData {
+		seq, ok := s[signer.Signer.String()]
+		// If we have seen this signer before in this block, we must make sure
+		// that the current sequence is seq+1; otherwise is invalid and we skip it.
+		if ok && seq+1 != signer.Sequence {
+			return nil, false
+		}
+		txSignersSeqs[signer.Signer.String()] = signer.Sequence
+	}
+
+	return txSignersSeqs, true
+}
+
+// update records the sequences of the signers of a verified transaction.
+func (s signerSequences) update(txSignersSeqs map[string]uint64, selected bool) {
+	for sender, seq := range txSignersSeqs {
+		if selected {
+			s[sender] = seq
+		} else if _, ok := s[sender]; !ok {
+			// The transaction hasn't been added but it passed the verification,
+			// so we know that the sequence is correct. So we set this sender's
+			// sequence to seq-1, in order to avoid unnecessary calls to
+			// PrepareProposalVerifyTx.
+			s[sender] = seq - 1
+		}
+	}
+}
+
 // NoOpPrepareProposal defines a no-op PrepareProposal handler. It will always
 // return the transactions sent by the client's request.
 func NoOpPrepareProposal() sdk.PrepareProposalHandler {
@@ -14,6 +14,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/proto"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/exp/maps"
 	protov2 "google.golang.org/protobuf/proto"
 
@@ -826,6 +827,14 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
+	// The span is ended after the recovery of a panic, so it records the
+	// resulting error.
+	ctx, span := startSpan(ctx, "runTx", attribute.String(spanAttrExecMode, mode.String()))
+	defer func() { telemetry.EndSpan(span, err) }()
+	if span.IsRecording() {
+		span.SetAttributes(attribute.String(spanAttrTxHash, fmt.Sprintf("%X", tmhash.Sum(txBytes))))
+	}
+
 	// only run the tx if there is block gas remaining
 	if mode == execModeFinalize && ctx.BlockGasMeter().IsOutOfGas() {
 		return gInfo, nil, nil, errorsmod.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
@@ -893,7 +902,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		if mode == execModeSimulate {
 			anteCtx = anteCtx.WithExecMode(sdk.ExecMode(execModeSimulate))
 		}
+		anteCtx, anteSpan := startSpan(anteCtx, "AnteHandler")
 		newCtx, err := app.anteHandler(anteCtx, tx, mode == execModeSimulate)
+		telemetry.EndSpan(anteSpan, err)
 
 		if !newCtx.IsZero() {
 			// At this point, newCtx.MultiStore() is a store branch, or something else
@@ -901,8 +912,9 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 			//
 			// Also, in the case of the tx aborting, we need to track gas consumed via
 			// the instantiated gas meter in the AnteHandler, so we update the context
-			// prior to returning.
-			ctx = newCtx.WithMultiStore(ms)
+			// prior to returning. The spans of the messages are children of the span
+			// of the tx, not of the span of the AnteHandler.
+			ctx = withSpan(newCtx.WithMultiStore(ms), span)
 		}
 
 		events := ctx.EventManager().Events()
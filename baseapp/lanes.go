@@ -0,0 +1,441 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// Lane defines a partition of the block space, reserved to the transactions it
+// matches and backed by a mempool of its own.
+type Lane struct {
+	// Name identifies the lane.
+	Name string
+
+	// Mempool holds the transactions of the lane.
+	Mempool mempool.Mempool
+
+	// MaxBlockSpace is the maximum share, in (0, 1], of the max tx bytes and of
+	// the max gas of a block that the transactions of the lane may use.
+	MaxBlockSpace math.LegacyDec
+
+	// Match returns whether a transaction belongs to the lane. A transaction
+	// belongs to the first lane matching it, a nil Match matches every
+	// transaction, which is typically used for a default last lane.
+	Match func(tx sdk.Tx) bool
+}
+
+// MatchMsgTypes returns a Lane.Match function matching the transactions all of
+// whose messages have one of the given type URLs.
+func MatchMsgTypes(typeURLs ...string) func(tx sdk.Tx) bool {
+	types := make(map[string]bool, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		types[typeURL] = true
+	}
+
+	return func(tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+		for _, msg := range msgs {
+			if !types[sdk.MsgTypeURL(msg)] {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// limit returns the share of the given block limit the lane may use.
+func (l Lane) limit(blockLimit uint64) uint64 {
+	return math.LegacyNewDecFromInt(math.NewIntFromUint64(blockLimit)).Mul(l.MaxBlockSpace).TruncateInt().Uint64()
+}
+
+// LaneProposalHandler defines ABCI PrepareProposal and ProcessProposal handlers
+// partitioning the block space into lanes. Proposals contain the transactions
+// of each lane in turn, in the order of the lanes, each lane using at most its
+// share of the block space.
+type LaneProposalHandler struct {
+	lanes            []Lane
+	txVerifier       ProposalTxVerifier
+	signerExtAdapter mempool.SignerExtractionAdapter
+}
+
+// NewLaneProposalHandler returns a LaneProposalHandler for the given lanes,
+// ordered by decreasing priority. The mempool returned by Mempool must be set
+// as the mempool of the application, so that transactions are inserted in the
+// mempool of their lane.
+func NewLaneProposalHandler(txVerifier ProposalTxVerifier, lanes ...Lane) (*LaneProposalHandler, error) {
+	if len(lanes) == 0 {
+		return nil, errors.New("at least one lane is required")
+	}
+
+	names := make(map[string]bool, len(lanes))
+	for _, lane := range lanes {
+		if lane.Name == "" {
+			return nil, errors.New("lane name cannot be empty")
+		}
+		if names[lane.Name] {
+			return nil, fmt.Errorf("duplicate lane %s", lane.Name)
+		}
+		names[lane.Name] = true
+
+		if lane.Mempool == nil {
+			return nil, fmt.Errorf("lane %s has no mempool", lane.Name)
+		}
+		if lane.MaxBlockSpace.IsNil() || !lane.MaxBlockSpace.IsPositive() || lane.MaxBlockSpace.GT(math.LegacyOneDec()) {
+			return nil, fmt.Errorf("lane %s max block space must be in (0, 1], got %s", lane.Name, lane.MaxBlockSpace)
+		}
+	}
+
+	return &LaneProposalHandler{
+		lanes:            lanes,
+		txVerifier:       txVerifier,
+		signerExtAdapter: mempool.NewDefaultSignerExtractionAdapter(),
+	}, nil
+}
+
+// SetSignerExtractionAdapter sets the SignerExtractionAdapter used to check the
+// sequences of the selected transactions.
+func (h *LaneProposalHandler) SetSignerExtractionAdapter(adapter mempool.SignerExtractionAdapter) {
+	h.signerExtAdapter = adapter
+}
+
+// laneOf returns the index of the lane of a transaction, or -1 if no lane
+// matches it.
+func (h *LaneProposalHandler) laneOf(tx sdk.Tx) int {
+	for i, lane := range h.lanes {
+		if lane.Match == nil || lane.Match(tx) {
+			return i
+		}
+	}
+	return -1
+}
+
+// PrepareProposalHandler returns a PrepareProposal handler filling the block
+// with the transactions of each lane in turn. A lane selects transactions from
+// its mempool until its share of RequestPrepareProposal.MaxTxBytes or of the
+// block max gas is reached, or until the block is full. Transactions are
+// verified like in DefaultProposalHandler, and the ones failing verification
+// are removed from the mempool of their lane.
+//
+// Note, the space a lane doesn't use is available to the following lanes, up
+// to their own share.
+func (h *LaneProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		var maxBlockGas uint64
+		if b := ctx.ConsensusParams().Block; b != nil && b.MaxGas > 0 { // nolint:staticcheck // ignore linting error
+			maxBlockGas = uint64(b.MaxGas)
+		}
+		maxTxBytes := uint64(req.MaxTxBytes)
+
+		var (
+			selectedTxs            [][]byte
+			totalTxBytes           uint64
+			totalTxGas             uint64
+			selectedTxsSignersSeqs = make(signerSequences)
+		)
+		for i, lane := range h.lanes {
+			laneMaxTxBytes := min(lane.limit(maxTxBytes), maxTxBytes-totalTxBytes)
+			laneMaxGas := min(lane.limit(maxBlockGas), maxBlockGas-totalTxGas)
+
+			var laneTxBytes, laneTxGas uint64
+			for iterator := lane.Mempool.Select(ctx, req.Txs); iterator != nil; iterator = iterator.Next() {
+				if laneTxBytes >= laneMaxTxBytes || (maxBlockGas > 0 && laneTxGas >= laneMaxGas) {
+					break
+				}
+
+				memTx := iterator.Tx()
+				// skip txs inserted in the lane mempool without being routed
+				// through Mempool, as ProcessProposal would reject them
+				if h.laneOf(memTx) != i {
+					continue
+				}
+
+				signerData, err := h.signerExtAdapter.GetSigners(memTx)
+				if err != nil {
+					return nil, err
+				}
+
+				txSignersSeqs, ok := selectedTxsSignersSeqs.check(signerData)
+				if !ok {
+					continue
+				}
+
+				txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+				if err != nil {
+					err := lane.Mempool.Remove(memTx)
+					if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+						return nil, err
+					}
+					continue
+				}
+
+				txSize := uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+				txGas := txGasLimit(memTx)
+				selected := laneTxBytes+txSize <= laneMaxTxBytes && (maxBlockGas == 0 || laneTxGas+txGas <= laneMaxGas)
+				if selected {
+					selectedTxs = append(selectedTxs, txBz)
+					laneTxBytes += txSize
+					laneTxGas += txGas
+				}
+				selectedTxsSignersSeqs.update(txSignersSeqs, selected)
+			}
+
+			totalTxBytes += laneTxBytes
+			totalTxGas += laneTxGas
+		}
+
+		return &abci.ResponsePrepareProposal{Txs: selectedTxs}, nil
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposal handler rejecting proposals
+// which contain a transaction that fails verification or doesn't match any
+// lane, which don't group the transactions of each lane in the order of the
+// lanes, in which the transactions of a lane exceed its share of the block max
+// gas or max tx bytes, or in which the transactions of all the lanes exceed
+// the block max gas or max tx bytes.
+//
+// Note, RequestProcessProposal doesn't carry the max tx bytes of the block, so
+// it is derived from the block max bytes consensus parameter as an upper bound
+// of RequestPrepareProposal.MaxTxBytes, see maxProposalTxBytes.
+func (h *LaneProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		var (
+			maxBlockGas, maxTxBytes uint64
+			limitTxBytes            bool
+		)
+		if b := ctx.ConsensusParams().Block; b != nil { // nolint:staticcheck // ignore linting error
+			if b.MaxGas > 0 {
+				maxBlockGas = uint64(b.MaxGas)
+			}
+			if b.MaxBytes > 0 || b.MaxBytes == -1 {
+				maxTxBytes, limitTxBytes = maxProposalTxBytes(b.MaxBytes), true
+			}
+		}
+
+		reject := &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+
+		var (
+			current                  int
+			laneTxBytes, laneTxGas   uint64
+			totalTxBytes, totalTxGas uint64
+		)
+		for _, txBytes := range req.Txs {
+			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
+			if err != nil {
+				return reject, nil
+			}
+
+			i := h.laneOf(tx)
+			if i < current {
+				// either no lane matches the tx, or it comes after the txs of a
+				// lower priority lane
+				return reject, nil
+			}
+			if i != current {
+				current = i
+				laneTxBytes, laneTxGas = 0, 0
+			}
+			lane := h.lanes[i]
+
+			txSize := uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBytes}))
+			laneTxBytes += txSize
+			totalTxBytes += txSize
+			if limitTxBytes && (laneTxBytes > lane.limit(maxTxBytes) || totalTxBytes > maxTxBytes) {
+				return reject, nil
+			}
+
+			if maxBlockGas > 0 {
+				txGas := txGasLimit(tx)
+				laneTxGas += txGas
+				totalTxGas += txGas
+				if laneTxGas > lane.limit(maxBlockGas) || totalTxGas > maxBlockGas {
+					return reject, nil
+				}
+			}
+		}
+
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+}
+
+// maxProposalTxBytes returns the max tx bytes of a block given the block max
+// bytes consensus parameter, -1 meaning the maximum block size. It is the block
+// max bytes minus the overhead of a block without evidence nor last commit
+// signatures, thus an upper bound of the RequestPrepareProposal.MaxTxBytes
+// CometBFT computes for the block.
+func maxProposalTxBytes(maxBlockBytes int64) uint64 {
+	if maxBlockBytes == -1 {
+		maxBlockBytes = cmttypes.MaxBlockSizeBytes
+	}
+
+	overhead := cmttypes.MaxOverheadForBlock + cmttypes.MaxHeaderBytes + cmttypes.MaxCommitBytes(0)
+	return uint64(max(maxBlockBytes-overhead, 0))
+}
+
+func txGasLimit(tx sdk.Tx) uint64 {
+	if gasTx, ok := tx.(GasTx); ok {
+		return gasTx.GetGas()
+	}
+	return 0
+}
+
+// Mempool returns a mempool inserting and removing transactions in the mempool
+// of their lane, and selecting the transactions of each lane in turn. It
+// implements mempool.Inspector over the mempools of the lanes which implement
+// it.
+func (h *LaneProposalHandler) Mempool() mempool.Mempool {
+	return laneMempool{handler: h}
+}
+
+var (
+	_ mempool.Mempool   = laneMempool{}
+	_ mempool.Inspector = laneMempool{}
+)
+
+// laneMempool routes transactions to the mempool of their lane.
+type laneMempool struct {
+	handler *LaneProposalHandler
+}
+
+func (mp laneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	i := mp.handler.laneOf(tx)
+	if i < 0 {
+		return errors.New("tx doesn't match any lane")
+	}
+	return mp.handler.lanes[i].Mempool.Insert(ctx, tx)
+}
+
+func (mp laneMempool) Select(ctx context.Context, txs [][]byte) mempool.Iterator {
+	it := &laneIterator{ctx: ctx, txs: txs, lanes: mp.handler.lanes, lane: -1}
+	return it.nextLane()
+}
+
+func (mp laneMempool) CountTx() int {
+	count := 0
+	for _, lane := range mp.handler.lanes {
+		count += lane.Mempool.CountTx()
+	}
+	return count
+}
+
+func (mp laneMempool) Remove(tx sdk.Tx) error {
+	i := mp.handler.laneOf(tx)
+	if i < 0 {
+		return mempool.ErrTxNotFound
+	}
+	return mp.handler.lanes[i].Mempool.Remove(tx)
+}
+
+// WalkTxs implements the mempool.Inspector interface. The transactions of the
+// lanes are merged, so that a sender whose transactions are in several lanes
+// is still walked in nonce order.
+func (mp laneMempool) WalkTxs(fn func(mempool.TxInfo) bool) {
+	var infos []mempool.TxInfo
+	for _, inspector := range mp.inspectors() {
+		inspector.WalkTxs(func(info mempool.TxInfo) bool {
+			infos = append(infos, info)
+			return true
+		})
+	}
+	sort.SliceStable(infos, func(i, j int) bool {
+		if infos[i].Sender != infos[j].Sender {
+			return infos[i].Sender < infos[j].Sender
+		}
+		return infos[i].Nonce < infos[j].Nonce
+	})
+
+	for _, info := range infos {
+		if !fn(info) {
+			return
+		}
+	}
+}
+
+// Subscribe implements the mempool.Inspector interface. The events of the lanes
+// are forwarded to a single channel, and dropped if its buffer is full.
+func (mp laneMempool) Subscribe(bufferSize int) (<-chan mempool.Event, func()) {
+	var (
+		out     = make(chan mempool.Event, bufferSize)
+		cancels []func()
+		wg      sync.WaitGroup
+	)
+	for _, inspector := range mp.inspectors() {
+		events, cancel := inspector.Subscribe(bufferSize)
+		cancels = append(cancels, cancel)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for event := range events {
+				select {
+				case out <- event:
+				default:
+				}
+			}
+		}()
+	}
+
+	var once sync.Once
+	return out, func() {
+		once.Do(func() {
+			for _, cancel := range cancels {
+				cancel()
+			}
+			wg.Wait()
+			close(out)
+		})
+	}
+}
+
+// inspectors returns the mempools of the lanes implementing mempool.Inspector.
+func (mp laneMempool) inspectors() []mempool.Inspector {
+	var inspectors []mempool.Inspector
+	for _, lane := range mp.handler.lanes {
+		if inspector, ok := lane.Mempool.(mempool.Inspector); ok {
+			inspectors = append(inspectors, inspector)
+		}
+	}
+	return inspectors
+}
+
+// laneIterator iterates over the mempools of the lanes in turn.
+type laneIterator struct {
+	ctx     context.Context
+	txs     [][]byte
+	lanes   []Lane
+	lane    int
+	current mempool.Iterator
+}
+
+func (it *laneIterator) nextLane() mempool.Iterator {
+	for it.lane++; it.lane < len(it.lanes); it.lane++ {
+		if it.current = it.lanes[it.lane].Mempool.Select(it.ctx, it.txs); it.current != nil {
+			return it
+		}
+	}
+	return nil
+}
+
+func (it *laneIterator) Next() mempool.Iterator {
+	if it.current = it.current.Next(); it.current != nil {
+		return it
+	}
+	return it.nextLane()
+}
+
+func (it *laneIterator) Tx() sdk.Tx {
+	return it.current.Tx()
+}
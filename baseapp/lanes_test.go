@@ -0,0 +1,225 @@
+package baseapp_test
+
+import (
+	"bytes"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	authtx "cosmossdk.io/x/auth/tx"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// matchOracle matches the test txs whose value starts with 'o'.
+func matchOracle(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	return len(msgs) == 1 && bytes.HasPrefix(msgs[0].(*baseapptestutil.MsgKeyValue).Value, []byte("o"))
+}
+
+func (s *ABCIUtilsTestSuite) TestLaneProposalHandler() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	signingCtx := cdc.InterfaceRegistry().SigningContext()
+	txConfig := authtx.NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), authtx.DefaultSignModes)
+
+	var (
+		txs   []sdk.Tx
+		txBzs [][]byte
+	)
+	for i, value := range []string{"o1", "o2", "o3", "d1", "d2"} {
+		tx := buildMsg(s.T(), txConfig, []byte(value), [][]byte{{byte(i)}}, []uint64{1})
+		bz, err := txConfig.TxEncoder()(tx)
+		s.Require().NoError(err)
+		txs = append(txs, tx)
+		txBzs = append(txBzs, bz)
+	}
+	txSize := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBzs[0]})
+	for _, bz := range txBzs {
+		s.Require().Equal(txSize, cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{bz}))
+	}
+
+	ctrl := gomock.NewController(s.T())
+	verifier := mock.NewMockProposalTxVerifier(ctrl)
+	for i := range txs {
+		verifier.EXPECT().PrepareProposalVerifyTx(txs[i]).Return(txBzs[i], nil).AnyTimes()
+		verifier.EXPECT().ProcessProposalVerifyTx(txBzs[i]).Return(txs[i], nil).AnyTimes()
+	}
+
+	oracleMempool := mempool.DefaultPriorityMempool()
+	defaultMempool := mempool.DefaultPriorityMempool()
+	ph, err := baseapp.NewLaneProposalHandler(verifier,
+		baseapp.Lane{Name: "oracle", Mempool: oracleMempool, MaxBlockSpace: math.LegacyNewDecWithPrec(5, 1), Match: matchOracle},
+		baseapp.Lane{Name: "default", Mempool: defaultMempool, MaxBlockSpace: math.LegacyOneDec()},
+	)
+	s.Require().NoError(err)
+
+	// txs are routed to the mempool of their lane
+	mp := ph.Mempool()
+	for i := len(txs) - 1; i >= 0; i-- {
+		s.Require().NoError(mp.Insert(s.ctx.WithPriority(int64(i)), txs[i]))
+	}
+	s.Require().Equal(5, mp.CountTx())
+	s.Require().Equal(3, oracleMempool.CountTx())
+	s.Require().Equal(2, defaultMempool.CountTx())
+
+	var selected []sdk.Tx
+	for it := mp.Select(s.ctx, nil); it != nil; it = it.Next() {
+		selected = append(selected, it.Tx())
+	}
+	s.Require().Equal([]sdk.Tx{txs[2], txs[1], txs[0], txs[4], txs[3]}, selected)
+
+	// the oracle lane is limited to half of the block
+	maxTxBytes := 4 * txSize
+	resp, err := ph.PrepareProposalHandler()(s.ctx, &abci.RequestPrepareProposal{MaxTxBytes: maxTxBytes})
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{txBzs[2], txBzs[1], txBzs[4], txBzs[3]}, resp.Txs)
+
+	// the default lane may use the space the oracle lane doesn't use
+	s.Require().NoError(mp.Remove(txs[2]))
+	s.Require().NoError(mp.Remove(txs[1]))
+	resp, err = ph.PrepareProposalHandler()(s.ctx, &abci.RequestPrepareProposal{MaxTxBytes: maxTxBytes})
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{txBzs[0], txBzs[4], txBzs[3]}, resp.Txs)
+
+	// the max tx bytes of the block is its max bytes minus the overhead of a
+	// block, as in PrepareProposal
+	maxBlockBytes := maxTxBytes + cmttypes.MaxOverheadForBlock + cmttypes.MaxHeaderBytes + cmttypes.MaxCommitBytes(0)
+	ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: maxBlockBytes},
+	})
+	testCases := map[string]struct {
+		txs    [][]byte
+		status abci.ResponseProcessProposal_ProposalStatus
+	}{
+		"lanes in order": {
+			txs:    [][]byte{txBzs[0], txBzs[1], txBzs[3], txBzs[4]},
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+		"single lane": {
+			txs:    [][]byte{txBzs[3], txBzs[4]},
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+		"lanes out of order": {
+			txs:    [][]byte{txBzs[0], txBzs[3], txBzs[1]},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		"lane over its share": {
+			txs:    [][]byte{txBzs[0], txBzs[1], txBzs[2]},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		"lanes within their share over the block": {
+			txs:    [][]byte{txBzs[0], txBzs[1], txBzs[3], txBzs[4], txBzs[3]},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+	}
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			resp, err := ph.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: tc.txs})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.status, resp.Status)
+		})
+	}
+}
+
+func (s *ABCIUtilsTestSuite) TestLaneMempoolInspector() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	signingCtx := cdc.InterfaceRegistry().SigningContext()
+	txConfig := authtx.NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), authtx.DefaultSignModes)
+
+	ctrl := gomock.NewController(s.T())
+	ph, err := baseapp.NewLaneProposalHandler(mock.NewMockProposalTxVerifier(ctrl),
+		baseapp.Lane{Name: "oracle", Mempool: mempool.DefaultPriorityMempool(), MaxBlockSpace: math.LegacyNewDecWithPrec(5, 1), Match: matchOracle},
+		baseapp.Lane{Name: "default", Mempool: mempool.DefaultPriorityMempool(), MaxBlockSpace: math.LegacyOneDec()},
+	)
+	s.Require().NoError(err)
+	mp := ph.Mempool()
+	inspector, ok := mp.(mempool.Inspector)
+	s.Require().True(ok)
+
+	events, cancel := inspector.Subscribe(10)
+
+	// the txs of sender a are spread over both lanes
+	for _, tx := range []sdk.Tx{
+		buildMsg(s.T(), txConfig, []byte("o1"), [][]byte{[]byte("a")}, []uint64{1}),
+		buildMsg(s.T(), txConfig, []byte("d1"), [][]byte{[]byte("a")}, []uint64{2}),
+		buildMsg(s.T(), txConfig, []byte("o2"), [][]byte{[]byte("a")}, []uint64{3}),
+		buildMsg(s.T(), txConfig, []byte("d2"), [][]byte{[]byte("b")}, []uint64{1}),
+	} {
+		s.Require().NoError(mp.Insert(s.ctx, tx))
+	}
+
+	// the txs of all the lanes are walked by sender and then by nonce
+	var infos []mempool.TxInfo
+	inspector.WalkTxs(func(info mempool.TxInfo) bool {
+		infos = append(infos, info)
+		return true
+	})
+	s.Require().Len(infos, 4)
+	nonces := make(map[string][]uint64)
+	for i, info := range infos {
+		if i > 0 {
+			s.Require().LessOrEqual(infos[i-1].Sender, info.Sender)
+		}
+		nonces[info.Sender] = append(nonces[info.Sender], info.Nonce)
+	}
+	s.Require().ElementsMatch([][]uint64{{1, 2, 3}, {1}}, [][]uint64{nonces[infos[0].Sender], nonces[infos[3].Sender]})
+
+	walked := 0
+	inspector.WalkTxs(func(mempool.TxInfo) bool {
+		walked++
+		return walked < 2
+	})
+	s.Require().Equal(2, walked)
+
+	// the events of all the lanes are received until the subscription is
+	// cancelled
+	for i := 0; i < 4; i++ {
+		event := <-events
+		s.Require().Equal(mempool.EventInsert, event.Type)
+	}
+	cancel()
+	_, open := <-events
+	s.Require().False(open)
+}
+
+func (s *ABCIUtilsTestSuite) TestNewLaneProposalHandler() {
+	ctrl := gomock.NewController(s.T())
+	verifier := mock.NewMockProposalTxVerifier(ctrl)
+	mp := mempool.DefaultPriorityMempool()
+
+	testCases := map[string][]baseapp.Lane{
+		"no lanes":        nil,
+		"no name":         {{Mempool: mp, MaxBlockSpace: math.LegacyOneDec()}},
+		"no mempool":      {{Name: "a", MaxBlockSpace: math.LegacyOneDec()}},
+		"no block space":  {{Name: "a", Mempool: mp}},
+		"too much space":  {{Name: "a", Mempool: mp, MaxBlockSpace: math.LegacyNewDec(2)}},
+		"duplicate names": {{Name: "a", Mempool: mp, MaxBlockSpace: math.LegacyOneDec()}, {Name: "a", Mempool: mp, MaxBlockSpace: math.LegacyOneDec()}},
+	}
+	for name, lanes := range testCases {
+		s.Run(name, func() {
+			_, err := baseapp.NewLaneProposalHandler(verifier, lanes...)
+			s.Require().Error(err)
+		})
+	}
+}
+
+func (s *ABCIUtilsTestSuite) TestMatchMsgTypes() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	signingCtx := cdc.InterfaceRegistry().SigningContext()
+	txConfig := authtx.NewTxConfig(cdc, signingCtx.AddressCodec(), signingCtx.ValidatorAddressCodec(), authtx.DefaultSignModes)
+
+	tx := buildMsg(s.T(), txConfig, []byte("v"), [][]byte{[]byte("secret")}, []uint64{1})
+	s.Require().True(baseapp.MatchMsgTypes(sdk.MsgTypeURL(&baseapptestutil.MsgKeyValue{}))(tx))
+	s.Require().False(baseapp.MatchMsgTypes(sdk.MsgTypeURL(&baseapptestutil.MsgCounter{}))(tx))
+}
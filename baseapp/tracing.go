@@ -0,0 +1,55 @@
+package baseapp
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Attribute keys of the spans started by BaseApp.
+const (
+	spanAttrHeight   = "height"
+	spanAttrNumTxs   = "num_txs"
+	spanAttrExecMode = "exec_mode"
+	spanAttrTxHash   = "tx_hash"
+	spanAttrMsgType  = "msg_type"
+)
+
+// String implements the fmt.Stringer interface.
+func (m execMode) String() string {
+	switch m {
+	case execModeCheck:
+		return "check"
+	case execModeReCheck:
+		return "recheck"
+	case execModeSimulate:
+		return "simulate"
+	case execModePrepareProposal:
+		return "prepare_proposal"
+	case execModeProcessProposal:
+		return "process_proposal"
+	case execModeVoteExtension:
+		return "vote_extension"
+	case execModeVerifyVoteExtension:
+		return "verify_vote_extension"
+	case execModeFinalize:
+		return "finalize"
+	default:
+		return "unknown"
+	}
+}
+
+// startSpan starts a span child of the span of the given context, if any, and
+// returns a context holding the new span. See telemetry.StartSpan.
+func startSpan(ctx sdk.Context, name string, attrs ...attribute.KeyValue) (sdk.Context, trace.Span) {
+	goCtx, span := telemetry.StartSpan(ctx.Context(), name, attrs...)
+	return ctx.WithContext(goCtx), span
+}
+
+// withSpan returns a context holding the given span, the spans started from the
+// returned context are children of the given span.
+func withSpan(ctx sdk.Context, span trace.Span) sdk.Context {
+	return ctx.WithContext(trace.ContextWithSpan(ctx.Context(), span))
+}
@@ -0,0 +1,126 @@
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/depinject/appconfig"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagGraph       = "graph"
+	flagGraphFormat = "graph-format"
+
+	graphFormatDOT  = "dot"
+	graphFormatJSON = "json"
+)
+
+// ValidateAppConfigCmd returns a command validating an app config file, in the
+// YAML or JSON format, without starting the application. The modules of the
+// app config must be registered, i.e. imported by the binary.
+//
+// The given config is composed with the app config, typically to supply the
+// values the application supplies when building its container (e.g. its
+// logger and app options). The outputs are the values the application requests
+// from the container, see depinject.Validate.
+func ValidateAppConfigCmd(config depinject.Config, outputs ...interface{}) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-app-config [config-file]",
+		Short: "Validate an app config file and export its dependency graph",
+		Long: `Validate an app config file without starting the application.
+The missing and duplicate dependencies, the dependency cycles and the unused providers of the app config are reported.
+The graph of the providers of each module can be exported in the Graphviz DOT or JSON format.`,
+		Example: fmt.Sprintf("%s debug validate-app-config app.yaml --graph app.dot", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var appConfig depinject.Config
+			switch strings.ToLower(filepath.Ext(args[0])) {
+			case ".json":
+				appConfig = appconfig.LoadJSON(bz)
+			case ".yaml", ".yml":
+				appConfig = appconfig.LoadYAML(bz)
+			default:
+				return fmt.Errorf("unsupported app config file extension %s, expected .json, .yaml or .yml", filepath.Ext(args[0]))
+			}
+			if config != nil {
+				appConfig = depinject.Configs(appConfig, config)
+			}
+
+			report, err := depinject.Validate(appConfig, outputs...)
+			if err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+
+			if err := writeGraph(cmd, report.Graph); err != nil {
+				return err
+			}
+
+			output, _ := cmd.Flags().GetString(flags.FlagOutput)
+			if output == flags.OutputFormatJSON {
+				bz, err := json.MarshalIndent(report, "", "  ")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(bz))
+			} else {
+				for _, id := range report.Unused {
+					cmd.Printf("unused provider: %s\n", id)
+				}
+			}
+
+			if err := report.Err(); err != nil {
+				return err
+			}
+
+			if output != flags.OutputFormatJSON {
+				cmd.Println("app config is valid")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+	cmd.Flags().String(flagGraph, "", "File to export the dependency graph to")
+	cmd.Flags().String(flagGraphFormat, graphFormatDOT, "Format of the exported dependency graph (dot|json)")
+
+	return cmd
+}
+
+func writeGraph(cmd *cobra.Command, graph depinject.ProviderGraph) error {
+	path, _ := cmd.Flags().GetString(flagGraph)
+	if path == "" {
+		return nil
+	}
+
+	var bz []byte
+	format, _ := cmd.Flags().GetString(flagGraphFormat)
+	switch format {
+	case graphFormatDOT:
+		bz = []byte(graph.DOT())
+	case graphFormatJSON:
+		var err error
+		bz, err = json.MarshalIndent(graph, "", "  ")
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported graph format %s, expected %s or %s", format, graphFormatDOT, graphFormatJSON)
+	}
+
+	return os.WriteFile(path, bz, 0o600)
+}
@@ -0,0 +1,38 @@
+package collectionsservice
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	collectionsv1beta1 "cosmossdk.io/api/cosmos/base/collections/v1beta1"
+)
+
+var CollectionsAutoCLIDescriptor = &autocliv1.ServiceCommandDescriptor{
+	Service: collectionsv1beta1.Service_ServiceDesc.ServiceName,
+	RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+		{
+			RpcMethod: "Modules",
+			Use:       "modules",
+			Short:     "Query the modules exposing their collections",
+		},
+		{
+			RpcMethod:      "Collections",
+			Use:            "collections [module]",
+			Short:          "Query the collections of a module",
+			Example:        "collections bank",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "module"}},
+		},
+		{
+			RpcMethod:      "Entry",
+			Use:            "entry [module] [collection] [key]",
+			Short:          "Query the entry of a collection by its JSON encoded key",
+			Example:        `entry bank balances '["cosmos1...","stake"]'`,
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "module"}, {ProtoField: "collection"}, {ProtoField: "key"}},
+		},
+		{
+			RpcMethod:      "Entries",
+			Use:            "entries [module] [collection]",
+			Short:          "Query the entries of a collection",
+			Example:        "entries bank supply --limit 10",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "module"}, {ProtoField: "collection"}},
+		},
+	},
+}
@@ -0,0 +1,211 @@
+package collectionsservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+const (
+	// DefaultEntriesLimit is the number of entries returned by Entries when no
+	// limit is set.
+	DefaultEntriesLimit = query.DefaultLimit
+	// MaxEntriesLimit is the maximum number of entries returned by Entries.
+	MaxEntriesLimit = 1000
+)
+
+// RegisterCollectionsService registers the collections gRPC service on the
+// provided gRPC router, serving the collections of the given modules.
+func RegisterCollectionsService(server gogogrpc.Server, modules map[string]appmodule.AppModule) {
+	RegisterServiceServer(server, NewQueryServer(modules))
+}
+
+// RegisterGRPCGatewayRoutes mounts the collections gRPC service's GRPC-gateway
+// routes on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
+
+var _ ServiceServer = queryServer{}
+
+type queryServer struct {
+	schemas map[string]collections.Schema
+}
+
+// NewQueryServer returns a ServiceServer for the collections of the given
+// modules. Only the modules implementing module.HasCollectionsSchema and having
+// at least one collection are served.
+func NewQueryServer(modules map[string]appmodule.AppModule) ServiceServer {
+	schemas := make(map[string]collections.Schema)
+	for name, mod := range modules {
+		hasSchema, ok := mod.(module.HasCollectionsSchema)
+		if !ok {
+			continue
+		}
+		schema := hasSchema.CollectionsSchema()
+		if len(schema.ListCollections()) == 0 {
+			continue
+		}
+		schemas[name] = schema
+	}
+	return queryServer{schemas: schemas}
+}
+
+func (s queryServer) schema(name string) (collections.Schema, error) {
+	schema, ok := s.schemas[name]
+	if !ok {
+		return collections.Schema{}, status.Errorf(codes.NotFound, "module %s does not expose its collections", name)
+	}
+	return schema, nil
+}
+
+func (s queryServer) Modules(context.Context, *ModulesRequest) (*ModulesResponse, error) {
+	names := make([]string, 0, len(s.schemas))
+	for name := range s.schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return &ModulesResponse{Modules: names}, nil
+}
+
+func (s queryServer) Collections(_ context.Context, req *CollectionsRequest) (*CollectionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	schema, err := s.schema(req.Module)
+	if err != nil {
+		return nil, err
+	}
+
+	colls := schema.ListCollections()
+	descriptors := make([]*CollectionDescriptor, len(colls))
+	for i, coll := range colls {
+		descriptors[i] = &CollectionDescriptor{
+			Name:      coll.GetName(),
+			Prefix:    coll.GetPrefix(),
+			KeyType:   coll.KeyType(),
+			ValueType: coll.ValueCodec().ValueType(),
+		}
+	}
+	return &CollectionsResponse{Collections: descriptors}, nil
+}
+
+func (s queryServer) Entry(ctx context.Context, req *EntryRequest) (*EntryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	schema, err := s.schema(req.Module)
+	if err != nil {
+		return nil, err
+	}
+
+	key := json.RawMessage(req.Key)
+	value, err := schema.GetJSON(ctx, req.Collection, key)
+	if errors.Is(err, collections.ErrEncoding) {
+		// the key may be a bare string, or a number encoded as a JSON string
+		// such as uint64 keys.
+		key, _ = json.Marshal(req.Key)
+		value, err = schema.GetJSON(ctx, req.Collection, key)
+	}
+	if err != nil {
+		return nil, queryError(err)
+	}
+
+	return &EntryResponse{Entry: &CollectionEntry{Key: string(key), Value: string(value)}}, nil
+}
+
+func (s queryServer) Entries(ctx context.Context, req *EntriesRequest) (*EntriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	schema, err := s.schema(req.Module)
+	if err != nil {
+		return nil, err
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = DefaultEntriesLimit
+	}
+	limit = min(limit, MaxEntriesLimit)
+
+	var (
+		start, end []byte
+		order      = collections.OrderAscending
+	)
+	if pageReq.Reverse {
+		order = collections.OrderDescending
+		if pageReq.Key != nil {
+			// the range end is exclusive, the next key of the page key is used to
+			// include it
+			end = append(append([]byte{}, pageReq.Key...), 0)
+		}
+	} else {
+		start = pageReq.Key
+	}
+	// the total is only counted when paginating by offset
+	countTotal := pageReq.CountTotal && pageReq.Key == nil
+
+	var (
+		entries []*CollectionEntry
+		nextKey []byte
+		count   uint64
+	)
+	err = schema.WalkJSON(ctx, req.Collection, start, end, order, func(rawKey []byte, key, value json.RawMessage) (bool, error) {
+		count++
+		switch {
+		case count <= pageReq.Offset:
+		case uint64(len(entries)) < limit:
+			entries = append(entries, &CollectionEntry{Key: string(key), Value: string(value)})
+		case nextKey == nil:
+			nextKey = append([]byte{}, rawKey...)
+			return !countTotal, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, queryError(err)
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+	return &EntriesResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// queryError converts the errors returned by the collections to gRPC status
+// errors.
+func queryError(err error) error {
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, collections.ErrEncoding):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
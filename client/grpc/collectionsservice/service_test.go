@@ -0,0 +1,144 @@
+package collectionsservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+type testModule struct {
+	schema collections.Schema
+}
+
+func (testModule) IsAppModule()        {}
+func (testModule) IsOnePerModuleType() {}
+
+func (m testModule) CollectionsSchema() collections.Schema { return m.schema }
+
+type otherModule struct{}
+
+func (otherModule) IsAppModule()        {}
+func (otherModule) IsOnePerModuleType() {}
+
+func newTestServer(t *testing.T) (ServiceServer, context.Context) {
+	t.Helper()
+	sk, ctx := colltest.MockStore()
+
+	sb := collections.NewSchemaBuilder(sk)
+	counts := collections.NewMap(sb, collections.NewPrefix(0), "counts", collections.Uint64Key, collections.StringValue)
+	balances := collections.NewMap(sb, collections.NewPrefix(1), "balances", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Value)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	for i, v := range []string{"zero", "one", "two", "three", "four"} {
+		require.NoError(t, counts.Set(ctx, uint64(i), v))
+	}
+	require.NoError(t, balances.Set(ctx, collections.Join("alice", "atom"), 10))
+
+	return NewQueryServer(map[string]appmodule.AppModule{
+		"test":  testModule{schema: schema},
+		"empty": testModule{},
+		"other": otherModule{},
+	}), ctx
+}
+
+func requireCode(t *testing.T, code codes.Code, err error) {
+	t.Helper()
+	require.Error(t, err)
+	require.Equal(t, code, status.Code(err), err.Error())
+}
+
+func TestServiceServer_Collections(t *testing.T) {
+	s, ctx := newTestServer(t)
+
+	modules, err := s.Modules(ctx, &ModulesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"test"}, modules.Modules)
+
+	res, err := s.Collections(ctx, &CollectionsRequest{Module: "test"})
+	require.NoError(t, err)
+	require.Equal(t, []*CollectionDescriptor{
+		{Name: "balances", Prefix: []byte{1}, KeyType: "Pair[string, string]", ValueType: "uint64"},
+		{Name: "counts", Prefix: []byte{0}, KeyType: "uint64", ValueType: "string"},
+	}, res.Collections)
+
+	_, err = s.Collections(ctx, &CollectionsRequest{Module: "other"})
+	requireCode(t, codes.NotFound, err)
+}
+
+func TestServiceServer_Entry(t *testing.T) {
+	s, ctx := newTestServer(t)
+
+	res, err := s.Entry(ctx, &EntryRequest{Module: "test", Collection: "balances", Key: `["alice","atom"]`})
+	require.NoError(t, err)
+	require.Equal(t, &CollectionEntry{Key: `["alice","atom"]`, Value: `"10"`}, res.Entry)
+
+	// uint64 keys are encoded as JSON strings, but can be queried as numbers
+	for _, key := range []string{`"2"`, `2`} {
+		res, err = s.Entry(ctx, &EntryRequest{Module: "test", Collection: "counts", Key: key})
+		require.NoError(t, err)
+		require.Equal(t, &CollectionEntry{Key: `"2"`, Value: `"two"`}, res.Entry)
+	}
+
+	_, err = s.Entry(ctx, &EntryRequest{Module: "test", Collection: "counts", Key: `"10"`})
+	requireCode(t, codes.NotFound, err)
+	_, err = s.Entry(ctx, &EntryRequest{Module: "test", Collection: "unknown", Key: `"1"`})
+	requireCode(t, codes.NotFound, err)
+	_, err = s.Entry(ctx, &EntryRequest{Module: "test", Collection: "balances", Key: `alice`})
+	requireCode(t, codes.InvalidArgument, err)
+	_, err = s.Entry(ctx, &EntryRequest{Module: "unknown", Collection: "counts", Key: `"1"`})
+	requireCode(t, codes.NotFound, err)
+}
+
+func TestServiceServer_Entries(t *testing.T) {
+	s, ctx := newTestServer(t)
+
+	entries := func(pageReq *query.PageRequest) ([]string, *query.PageResponse) {
+		t.Helper()
+		res, err := s.Entries(ctx, &EntriesRequest{Module: "test", Collection: "counts", Pagination: pageReq})
+		require.NoError(t, err)
+		values := make([]string, len(res.Entries))
+		for i, entry := range res.Entries {
+			values[i] = entry.Value
+		}
+		return values, res.Pagination
+	}
+
+	values, pageRes := entries(nil)
+	require.Equal(t, []string{`"zero"`, `"one"`, `"two"`, `"three"`, `"four"`}, values)
+	require.Nil(t, pageRes.NextKey)
+
+	// paginating by key
+	values, pageRes = entries(&query.PageRequest{Limit: 2})
+	require.Equal(t, []string{`"zero"`, `"one"`}, values)
+	require.NotNil(t, pageRes.NextKey)
+	values, pageRes = entries(&query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.Equal(t, []string{`"two"`, `"three"`}, values)
+	values, pageRes = entries(&query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.Equal(t, []string{`"four"`}, values)
+	require.Nil(t, pageRes.NextKey)
+
+	// in reverse
+	values, pageRes = entries(&query.PageRequest{Limit: 2, Reverse: true})
+	require.Equal(t, []string{`"four"`, `"three"`}, values)
+	values, _ = entries(&query.PageRequest{Key: pageRes.NextKey, Limit: 2, Reverse: true})
+	require.Equal(t, []string{`"two"`, `"one"`}, values)
+
+	// paginating by offset
+	values, pageRes = entries(&query.PageRequest{Offset: 1, Limit: 2, CountTotal: true})
+	require.Equal(t, []string{`"one"`, `"two"`}, values)
+	require.Equal(t, uint64(5), pageRes.Total)
+	require.NotNil(t, pageRes.NextKey)
+
+	_, err := s.Entries(ctx, &EntriesRequest{Module: "test", Collection: "counts", Pagination: &query.PageRequest{Offset: 1, Key: []byte{1}}})
+	requireCode(t, codes.InvalidArgument, err)
+}
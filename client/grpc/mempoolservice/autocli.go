@@ -0,0 +1,23 @@
+package mempoolservice
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	mempoolv1beta1 "cosmossdk.io/api/cosmos/base/mempool/v1beta1"
+)
+
+var MempoolAutoCLIDescriptor = &autocliv1.ServiceCommandDescriptor{
+	Service: mempoolv1beta1.Service_ServiceDesc.ServiceName,
+	RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+		{
+			RpcMethod: "Txs",
+			Use:       "txs",
+			Short:     "Query the transactions pending in the mempool of the node",
+			Example:   "txs --sender cosmos1... --limit 10",
+		},
+		{
+			// server streaming methods aren't supported by autocli
+			RpcMethod: "Events",
+			Skip:      true,
+		},
+	},
+}
@@ -0,0 +1,174 @@
+package mempoolservice
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+const (
+	// DefaultTxsLimit is the number of transactions returned by Txs when no
+	// limit is set.
+	DefaultTxsLimit = 100
+	// MaxTxsLimit is the maximum number of transactions returned by Txs.
+	MaxTxsLimit = 1000
+
+	// eventsBufferSize is the number of events buffered per Events stream before
+	// events are dropped for it.
+	eventsBufferSize = 1000
+)
+
+// RegisterMempoolService registers the mempool gRPC service on the provided gRPC
+// server. As the mempool is local to the node, it is meant to be registered on
+// the node gRPC server only, not on the app gRPC router serving ABCI queries.
+func RegisterMempoolService(server gogogrpc.Server, mp mempool.Mempool, txEncoder sdk.TxEncoder) {
+	RegisterServiceServer(server, NewQueryServer(mp, txEncoder))
+}
+
+// RegisterGRPCGatewayRoutes mounts the mempool gRPC service's GRPC-gateway routes
+// on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
+
+var _ ServiceServer = queryServer{}
+
+type queryServer struct {
+	mempool   mempool.Mempool
+	txEncoder sdk.TxEncoder
+}
+
+// NewQueryServer returns a ServiceServer for the given mempool. The mempool
+// must implement mempool.Inspector, otherwise every query fails with
+// codes.Unimplemented.
+func NewQueryServer(mp mempool.Mempool, txEncoder sdk.TxEncoder) ServiceServer {
+	return queryServer{
+		mempool:   mp,
+		txEncoder: txEncoder,
+	}
+}
+
+func (s queryServer) inspector() (mempool.Inspector, error) {
+	inspector, ok := s.mempool.(mempool.Inspector)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "mempool %T does not support inspection", s.mempool)
+	}
+	return inspector, nil
+}
+
+func (s queryServer) Txs(_ context.Context, req *TxsRequest) (*TxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	inspector, err := s.inspector()
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultTxsLimit
+	}
+	limit = min(limit, MaxTxsLimit)
+
+	var (
+		infos []mempool.TxInfo
+		total uint64
+	)
+	inspector.WalkTxs(func(info mempool.TxInfo) bool {
+		if req.Sender != "" && info.Sender != req.Sender {
+			return true
+		}
+
+		if total >= req.Offset && uint64(len(infos)) < limit {
+			infos = append(infos, info)
+		}
+		total++
+		return true
+	})
+
+	// encode outside of WalkTxs, so that the mempool isn't locked meanwhile
+	txs := make([]*TxInfo, len(infos))
+	for i, info := range infos {
+		txs[i], err = s.txInfo(info)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &TxsResponse{Txs: txs, Total: total}, nil
+}
+
+func (s queryServer) Events(req *EventsRequest, stream Service_EventsServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	inspector, err := s.inspector()
+	if err != nil {
+		return err
+	}
+
+	events, cancel := inspector.Subscribe(eventsBufferSize)
+	defer cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if req.Sender != "" && event.Tx.Sender != req.Sender {
+				continue
+			}
+
+			info, err := s.txInfo(event.Tx)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(&EventsResponse{EventType: eventType(event.Type), Tx: info}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s queryServer) txInfo(info mempool.TxInfo) (*TxInfo, error) {
+	bz, err := s.txEncoder(info.Tx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to encode tx: %v", err))
+	}
+
+	hash := sha256.Sum256(bz)
+	return &TxInfo{
+		Sender:   info.Sender,
+		Nonce:    info.Nonce,
+		Priority: info.Priority,
+		Size_:    uint64(len(bz)),
+		Hash:     hash[:],
+	}, nil
+}
+
+func eventType(t mempool.EventType) EventType {
+	switch t {
+	case mempool.EventInsert:
+		return EventType_EVENT_TYPE_INSERT
+	case mempool.EventRemove:
+		return EventType_EVENT_TYPE_REMOVE
+	case mempool.EventEvict:
+		return EventType_EVENT_TYPE_EVICT
+	default:
+		return EventType_EVENT_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,121 @@
+package mempoolservice
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+type testTx struct {
+	sdk.Tx
+	bz []byte
+}
+
+func testTxEncoder(tx sdk.Tx) ([]byte, error) {
+	return tx.(testTx).bz, nil
+}
+
+type testMempool struct {
+	mempool.NoOpMempool
+	infos  []mempool.TxInfo
+	events chan mempool.Event
+}
+
+func (m *testMempool) WalkTxs(fn func(mempool.TxInfo) bool) {
+	for _, info := range m.infos {
+		if !fn(info) {
+			return
+		}
+	}
+}
+
+func (m *testMempool) Subscribe(int) (<-chan mempool.Event, func()) {
+	return m.events, func() {}
+}
+
+type testEventsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*EventsResponse
+}
+
+func (s *testEventsStream) Context() context.Context { return s.ctx }
+
+func (s *testEventsStream) Send(resp *EventsResponse) error {
+	s.sent = append(s.sent, resp)
+	return nil
+}
+
+func TestServiceServer_Txs(t *testing.T) {
+	mp := &testMempool{}
+	for i, sender := range []string{"a", "a", "b", "c"} {
+		mp.infos = append(mp.infos, mempool.TxInfo{
+			Tx:       testTx{bz: []byte{byte(i), 1, 2}},
+			Sender:   sender,
+			Nonce:    uint64(i),
+			Priority: "10",
+		})
+	}
+	svr := NewQueryServer(mp, testTxEncoder)
+
+	resp, err := svr.Txs(context.Background(), &TxsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), resp.Total)
+	require.Len(t, resp.Txs, 4)
+	hash := sha256.Sum256([]byte{0, 1, 2})
+	require.Equal(t, &TxInfo{Sender: "a", Nonce: 0, Priority: "10", Size_: 3, Hash: hash[:]}, resp.Txs[0])
+
+	resp, err = svr.Txs(context.Background(), &TxsRequest{Sender: "a", Offset: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Total)
+	require.Len(t, resp.Txs, 1)
+	require.Equal(t, uint64(1), resp.Txs[0].Nonce)
+
+	resp, err = svr.Txs(context.Background(), &TxsRequest{Offset: 1, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), resp.Total)
+	require.Len(t, resp.Txs, 2)
+	require.Equal(t, "a", resp.Txs[0].Sender)
+	require.Equal(t, "b", resp.Txs[1].Sender)
+
+	// mempools which don't implement Inspector aren't supported
+	svr = NewQueryServer(mempool.NoOpMempool{}, testTxEncoder)
+	_, err = svr.Txs(context.Background(), &TxsRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestServiceServer_Events(t *testing.T) {
+	mp := &testMempool{events: make(chan mempool.Event, 3)}
+	svr := NewQueryServer(mp, testTxEncoder)
+
+	mp.events <- mempool.Event{Type: mempool.EventInsert, Tx: mempool.TxInfo{Tx: testTx{bz: []byte{1}}, Sender: "a"}}
+	mp.events <- mempool.Event{Type: mempool.EventInsert, Tx: mempool.TxInfo{Tx: testTx{bz: []byte{2}}, Sender: "b"}}
+	mp.events <- mempool.Event{Type: mempool.EventEvict, Tx: mempool.TxInfo{Tx: testTx{bz: []byte{3}}, Sender: "a"}}
+	close(mp.events)
+
+	stream := &testEventsStream{ctx: context.Background()}
+	require.NoError(t, svr.Events(&EventsRequest{Sender: "a"}, stream))
+	require.Len(t, stream.sent, 2)
+	require.Equal(t, EventType_EVENT_TYPE_INSERT, stream.sent[0].EventType)
+	require.Equal(t, EventType_EVENT_TYPE_EVICT, stream.sent[1].EventType)
+	require.Equal(t, uint64(1), stream.sent[1].Tx.Size_)
+
+	// the stream ends with its context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mp.events = make(chan mempool.Event)
+	require.NoError(t, svr.Events(&EventsRequest{}, &testEventsStream{ctx: ctx}))
+
+	svr = NewQueryServer(mempool.NoOpMempool{}, func(sdk.Tx) ([]byte, error) { return nil, errors.New("unused") })
+	err := svr.Events(&EventsRequest{}, &testEventsStream{ctx: ctx})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -18,6 +18,8 @@ func Cmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra
 		ExportSnapshotCmd(appCreator),
 		DumpArchiveCmd(),
 		LoadArchiveCmd(),
+		ExportDirCmd(),
+		ImportDirCmd(appCreator),
 		DeleteSnapshotCmd(),
 	)
 	return cmd
@@ -0,0 +1,56 @@
+package snapshot
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// ExportDirCmd returns a command to export a snapshot to a directory of
+// content-addressed chunks, suitable for object storage
+func ExportDirCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-dir <height> <format> <dir>",
+		Short: "Export a local snapshot to a directory of content-addressed chunks",
+		Long: `Export a local snapshot to a directory holding a manifest under manifests/<height>-<format>.json
+and the snapshot chunks under chunks/<sha256>. Chunks already present in the directory are not
+written again. With --base, the export is differential against a previously exported manifest:
+the chunks it references are neither copied nor duplicated, and are resolved through it on import.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+			snapshotStore, err := server.GetSnapshotStore(ctx.Viper)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			format, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			base, err := cmd.Flags().GetString("base")
+			if err != nil {
+				return err
+			}
+
+			result, err := ExportToDir(snapshotStore, height, uint32(format), args[2], base)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot exported to %s, chunks written %d, reused %d\n", result.ManifestPath, result.Written, result.Reused)
+			return nil
+		},
+	}
+
+	cmd.Flags().String("base", "", "manifest of a previous export to make a differential export against")
+
+	return cmd
+}
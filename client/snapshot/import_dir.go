@@ -0,0 +1,41 @@
+package snapshot
+
+import (
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// ImportDirCmd returns a command to restore app state from a snapshot exported
+// with export-dir
+func ImportDirCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-dir <manifest>",
+		Short: "Restore app state from a snapshot exported to a directory",
+		Long: `Restore app state from a snapshot exported with export-dir. Every chunk is verified against
+its content hash before being restored, missing chunks are resolved through the base manifests.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+
+			home := ctx.Config.RootDir
+			db, err := openDB(home, server.GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			logger := log.NewLogger(cmd.OutOrStdout())
+			app := appCreator(logger, db, nil, ctx.Viper)
+
+			snapshot, err := ImportFromDir(app.SnapshotManager(), args[0])
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot restored at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+}
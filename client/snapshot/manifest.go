@@ -0,0 +1,362 @@
+package snapshot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+)
+
+const (
+	// ManifestsDir is the directory of an export root holding the snapshot manifests.
+	ManifestsDir = "manifests"
+	// ChunksDir is the directory of an export root holding the content-addressed chunk files.
+	ChunksDir = "chunks"
+)
+
+// Manifest describes a snapshot exported to a directory. The chunks themselves
+// are stored separately under their SHA-256 hash, so that identical chunks are
+// shared between snapshots and the directory can be mirrored as is to an
+// object storage bucket.
+type Manifest struct {
+	Height uint64          `json:"height"`
+	Format uint32          `json:"format"`
+	Hash   string          `json:"hash"`
+	Chunks []ManifestChunk `json:"chunks"`
+	// Base is the path of the manifest this one is differential against,
+	// relative to the directory of this manifest. Chunks which are not present
+	// in the export root of this manifest are looked up in the base one.
+	Base string `json:"base,omitempty"`
+}
+
+// ManifestChunk is a single chunk of an exported snapshot.
+type ManifestChunk struct {
+	Hash string `json:"hash"`
+	Size int64  `json:"size"`
+}
+
+// ManifestPath returns the path of the manifest of the given snapshot in an export root.
+func ManifestPath(root string, height uint64, format uint32) string {
+	return filepath.Join(root, ManifestsDir, fmt.Sprintf("%d-%d.json", height, format))
+}
+
+// ChunkPath returns the path of the chunk with the given hex encoded hash in an export root.
+func ChunkPath(root, hash string) string {
+	return filepath.Join(root, ChunksDir, hash)
+}
+
+// Snapshot returns the snapshot metadata described by the manifest.
+func (m *Manifest) Snapshot() (snapshottypes.Snapshot, error) {
+	hash, err := hex.DecodeString(m.Hash)
+	if err != nil {
+		return snapshottypes.Snapshot{}, fmt.Errorf("invalid snapshot hash %q: %w", m.Hash, err)
+	}
+
+	chunkHashes := make([][]byte, len(m.Chunks))
+	for i, chunk := range m.Chunks {
+		chunkHashes[i], err = decodeChunkHash(chunk.Hash)
+		if err != nil {
+			return snapshottypes.Snapshot{}, err
+		}
+	}
+
+	return snapshottypes.Snapshot{
+		Height:   m.Height,
+		Format:   m.Format,
+		Chunks:   uint32(len(m.Chunks)),
+		Hash:     hash,
+		Metadata: snapshottypes.Metadata{ChunkHashes: chunkHashes},
+	}, nil
+}
+
+// ReadManifest reads the manifest at the given path.
+func ReadManifest(path string) (*Manifest, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest %s: %w", path, err)
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest %s: %w", path, err)
+	}
+
+	return &manifest, nil
+}
+
+// SnapshotSource is the local snapshot store a snapshot is exported from.
+type SnapshotSource interface {
+	Get(height uint64, format uint32) (*snapshottypes.Snapshot, error)
+	LoadChunk(height uint64, format, chunk uint32) (io.ReadCloser, error)
+}
+
+// ExportResult summarizes an ExportToDir call.
+type ExportResult struct {
+	ManifestPath string
+	// Written is the number of chunks written to the export root, the other
+	// chunks were already present there or in the base manifest.
+	Written int
+	Reused  int
+}
+
+// ExportToDir exports the given snapshot of the source to the root directory.
+// If base is not empty, it is the path of a previously exported manifest: the
+// chunks it references are not copied again and the new manifest points to it
+// for them instead.
+func ExportToDir(source SnapshotSource, height uint64, format uint32, root, base string) (*ExportResult, error) {
+	snapshot, err := source.Get(height, format)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, errors.New("snapshot doesn't exist")
+	}
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return nil, fmt.Errorf("snapshot has %d chunk hashes, but %d chunks", len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+	}
+
+	for _, dir := range []string{ManifestsDir, ChunksDir} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create export directory: %w", err)
+		}
+	}
+
+	manifest := &Manifest{
+		Height: snapshot.Height,
+		Format: snapshot.Format,
+		Hash:   hex.EncodeToString(snapshot.Hash),
+		Chunks: make([]ManifestChunk, snapshot.Chunks),
+	}
+	manifestPath := ManifestPath(root, height, format)
+
+	// sizes of the chunks referenced by the base manifest, by hash
+	baseChunks := map[string]int64{}
+	if base != "" {
+		baseManifest, err := ReadManifest(base)
+		if err != nil {
+			return nil, err
+		}
+		for _, chunk := range baseManifest.Chunks {
+			baseChunks[chunk.Hash] = chunk.Size
+		}
+
+		manifest.Base, err = relativePath(filepath.Dir(manifestPath), base)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := &ExportResult{ManifestPath: manifestPath}
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		hash := hex.EncodeToString(snapshot.Metadata.ChunkHashes[i])
+
+		size, ok := baseChunks[hash]
+		if ok {
+			result.Reused++
+		} else {
+			var written bool
+			size, written, err = exportChunk(source, snapshot, i, root, hash)
+			if err != nil {
+				return nil, err
+			}
+			if written {
+				result.Written++
+			} else {
+				result.Reused++
+			}
+		}
+
+		manifest.Chunks[i] = ManifestChunk{Hash: hash, Size: size}
+	}
+
+	bz, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFileAtomic(manifestPath, bytes.NewReader(bz)); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// exportChunk copies a chunk to its content-addressed path unless it's already there.
+func exportChunk(source SnapshotSource, snapshot *snapshottypes.Snapshot, index uint32, root, hash string) (int64, bool, error) {
+	path := ChunkPath(root, hash)
+	if st, err := os.Stat(path); err == nil {
+		return st.Size(), false, nil
+	}
+
+	chunk, err := source.LoadChunk(snapshot.Height, snapshot.Format, index)
+	if err != nil {
+		return 0, false, err
+	}
+	if chunk == nil {
+		return 0, false, fmt.Errorf("chunk %d of snapshot %d is missing", index, snapshot.Height)
+	}
+	defer chunk.Close()
+
+	bz, err := io.ReadAll(chunk)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read chunk %d: %w", index, err)
+	}
+	if sum := sha256.Sum256(bz); hex.EncodeToString(sum[:]) != hash {
+		return 0, false, fmt.Errorf("%w: chunk %d, expected %s, got %X", snapshottypes.ErrChunkHashMismatch, index, hash, sum)
+	}
+
+	if err := writeFileAtomic(path, bytes.NewReader(bz)); err != nil {
+		return 0, false, err
+	}
+
+	return int64(len(bz)), true, nil
+}
+
+// ChunkRestorer restores a snapshot chunk by chunk, as done by the snapshot manager.
+type ChunkRestorer interface {
+	Restore(snapshot snapshottypes.Snapshot) error
+	RestoreChunk(chunk []byte) (bool, error)
+}
+
+// ImportFromDir restores the snapshot described by the manifest at the given
+// path. Every chunk is checked against the hash it is addressed by before
+// being handed to the restorer, and the snapshot hash is checked before the
+// final chunk completes the restore.
+func ImportFromDir(restorer ChunkRestorer, manifestPath string) (*snapshottypes.Snapshot, error) {
+	manifest, err := ReadManifest(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := manifest.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.Chunks == 0 {
+		return nil, fmt.Errorf("%w: no chunks", snapshottypes.ErrInvalidMetadata)
+	}
+
+	// resolve every chunk before starting the restore, so that an incomplete
+	// export doesn't leave a half restored state behind
+	paths := make([]string, len(manifest.Chunks))
+	for i, chunk := range manifest.Chunks {
+		paths[i], err = findChunk(manifestPath, chunk.Hash, map[string]bool{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := restorer.Restore(snapshot); err != nil {
+		return nil, err
+	}
+
+	snapshotHasher := sha256.New()
+	for i, path := range paths {
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read chunk %d: %w", i, err)
+		}
+
+		hash := sha256.Sum256(bz)
+		if !bytes.Equal(hash[:], snapshot.Metadata.ChunkHashes[i]) {
+			return nil, fmt.Errorf("%w: chunk %d, expected %X, got %X", snapshottypes.ErrChunkHashMismatch,
+				i, snapshot.Metadata.ChunkHashes[i], hash)
+		}
+
+		snapshotHasher.Write(bz)
+		if i == len(paths)-1 && !bytes.Equal(snapshotHasher.Sum(nil), snapshot.Hash) {
+			return nil, fmt.Errorf("%w: snapshot hash mismatch", snapshottypes.ErrInvalidMetadata)
+		}
+
+		done, err := restorer.RestoreChunk(bz)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore chunk %d: %w", i, err)
+		}
+		if done != (i == len(paths)-1) {
+			return nil, fmt.Errorf("restore completed after %d chunks, expected %d", i+1, len(paths))
+		}
+	}
+
+	return &snapshot, nil
+}
+
+// findChunk returns the path of the chunk with the given hash, looking it up in
+// the export root of the manifest and then along its chain of base manifests.
+func findChunk(manifestPath, hash string, visited map[string]bool) (string, error) {
+	if visited[manifestPath] {
+		return "", fmt.Errorf("manifest %s references itself as a base", manifestPath)
+	}
+	visited[manifestPath] = true
+
+	if _, err := decodeChunkHash(hash); err != nil {
+		return "", err
+	}
+
+	root := filepath.Dir(filepath.Dir(manifestPath))
+	path := ChunkPath(root, hash)
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	} else if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	manifest, err := ReadManifest(manifestPath)
+	if err != nil {
+		return "", err
+	}
+	if manifest.Base == "" {
+		return "", fmt.Errorf("chunk %s not found", hash)
+	}
+
+	base := manifest.Base
+	if !filepath.IsAbs(base) {
+		base = filepath.Join(filepath.Dir(manifestPath), base)
+	}
+	return findChunk(filepath.Clean(base), hash, visited)
+}
+
+func decodeChunkHash(hash string) ([]byte, error) {
+	bz, err := hex.DecodeString(hash)
+	if err != nil || len(bz) != sha256.Size {
+		return nil, fmt.Errorf("invalid chunk hash %q", hash)
+	}
+	return bz, nil
+}
+
+func relativePath(dir, path string) (string, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Rel(absDir, absPath)
+}
+
+// writeFileAtomic writes the content to a temporary file first and then renames
+// it, so that readers of the directory never observe a partially written file.
+func writeFileAtomic(path string, r io.Reader) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
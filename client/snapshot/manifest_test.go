@@ -0,0 +1,97 @@
+package snapshot_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/client/snapshot"
+)
+
+type mockRestorer struct {
+	snapshot snapshottypes.Snapshot
+	chunks   [][]byte
+}
+
+func (m *mockRestorer) Restore(snapshot snapshottypes.Snapshot) error {
+	m.snapshot = snapshot
+	return nil
+}
+
+func (m *mockRestorer) RestoreChunk(chunk []byte) (bool, error) {
+	m.chunks = append(m.chunks, chunk)
+	return len(m.chunks) == int(m.snapshot.Chunks), nil
+}
+
+func saveSnapshot(t *testing.T, store *snapshots.Store, height uint64, chunks ...[]byte) {
+	t.Helper()
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	_, err := store.Save(height, snapshottypes.CurrentFormat, ch)
+	require.NoError(t, err)
+}
+
+func TestExportImportDir(t *testing.T) {
+	store, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	saveSnapshot(t, store, 1, []byte("a"), []byte("b"), []byte("c"))
+	saveSnapshot(t, store, 2, []byte("a"), []byte("b"), []byte("d"))
+
+	// full export
+	root := t.TempDir()
+	result, err := snapshot.ExportToDir(store, 1, snapshottypes.CurrentFormat, root, "")
+	require.NoError(t, err)
+	require.Equal(t, 3, result.Written)
+	require.Equal(t, snapshot.ManifestPath(root, 1, snapshottypes.CurrentFormat), result.ManifestPath)
+
+	// exporting again to the same root doesn't rewrite any chunk
+	result, err = snapshot.ExportToDir(store, 1, snapshottypes.CurrentFormat, root, "")
+	require.NoError(t, err)
+	require.Equal(t, 0, result.Written)
+	require.Equal(t, 3, result.Reused)
+
+	// differential export to another root only copies the new chunk
+	diffRoot := t.TempDir()
+	diff, err := snapshot.ExportToDir(store, 2, snapshottypes.CurrentFormat, diffRoot, result.ManifestPath)
+	require.NoError(t, err)
+	require.Equal(t, 1, diff.Written)
+	require.Equal(t, 2, diff.Reused)
+	entries, err := os.ReadDir(filepath.Join(diffRoot, snapshot.ChunksDir))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	expected, err := store.Get(2, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+
+	restorer := &mockRestorer{}
+	restored, err := snapshot.ImportFromDir(restorer, diff.ManifestPath)
+	require.NoError(t, err)
+	require.Equal(t, expected, restored)
+	require.Equal(t, *expected, restorer.snapshot)
+	require.Equal(t, [][]byte{[]byte("a"), []byte("b"), []byte("d")}, restorer.chunks)
+
+	// a missing base chunk is detected before the restore starts
+	manifest, err := snapshot.ReadManifest(result.ManifestPath)
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(snapshot.ChunkPath(root, manifest.Chunks[0].Hash)))
+	restorer = &mockRestorer{}
+	_, err = snapshot.ImportFromDir(restorer, diff.ManifestPath)
+	require.ErrorContains(t, err, "not found")
+	require.Zero(t, restorer.snapshot.Height)
+
+	// a corrupted chunk is rejected
+	require.NoError(t, os.WriteFile(snapshot.ChunkPath(root, manifest.Chunks[0].Hash), []byte("x"), 0o600))
+	_, err = snapshot.ImportFromDir(&mockRestorer{}, diff.ManifestPath)
+	require.ErrorIs(t, err, snapshottypes.ErrChunkHashMismatch)
+}
@@ -2,6 +2,7 @@ package collections
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
 	"math"
@@ -89,7 +90,12 @@ type Collection interface {
 	// ValueCodec returns the codec used to encode/decode values of the collection.
 	ValueCodec() codec.UntypedValueCodec
 
+	// KeyType returns the type of the keys of the collection, as named by its
+	// key codec.
+	KeyType() string
+
 	genesisHandler
+	queryHandler
 }
 
 // Prefix defines a segregation bytes namespace for specific collections objects.
@@ -144,6 +150,8 @@ func (c collectionImpl[K, V]) ValueCodec() codec.UntypedValueCodec {
 
 func (c collectionImpl[K, V]) GetName() string { return c.m.name }
 
+func (c collectionImpl[K, V]) KeyType() string { return c.m.kc.KeyType() }
+
 func (c collectionImpl[K, V]) GetPrefix() []byte { return NewPrefix(c.m.prefix) }
 
 func (c collectionImpl[K, V]) validateGenesis(r io.Reader) error { return c.m.validateGenesis(r) }
@@ -157,3 +165,11 @@ func (c collectionImpl[K, V]) exportGenesis(ctx context.Context, w io.Writer) er
 }
 
 func (c collectionImpl[K, V]) defaultGenesis(w io.Writer) error { return c.m.defaultGenesis(w) }
+
+func (c collectionImpl[K, V]) getJSON(ctx context.Context, key json.RawMessage) (json.RawMessage, error) {
+	return c.m.getJSON(ctx, key)
+}
+
+func (c collectionImpl[K, V]) walkJSON(ctx context.Context, start, end []byte, order Order, walkFunc JSONWalkFunc) error {
+	return c.m.walkJSON(ctx, start, end, order, walkFunc)
+}
@@ -0,0 +1,316 @@
+package collections
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// ErrIndexInconsistency is returned when an index does not match the values of
+// its IndexedMap.
+var ErrIndexInconsistency = errors.New("collections: index inconsistency")
+
+// maxReportedInconsistencies is the maximum number of inconsistencies detailed
+// in the error returned by CheckIndex.
+const maxReportedInconsistencies = 10
+
+// VerifiableIndex is an Index whose references can be verified against the
+// values of its IndexedMap. It is required to backfill an index with an
+// IndexBackfiller and to check its integrity with IndexedMap.CheckIndex.
+type VerifiableIndex[PrimaryKey, Value any] interface {
+	Index[PrimaryKey, Value]
+	// HasReference reports whether the index contains the reference it creates
+	// for the provided primary key and value.
+	HasReference(ctx context.Context, pk PrimaryKey, value Value) (bool, error)
+	// WalkReferences walks over all the references of the index. The walk function
+	// is called with the referenced primary key and a function reporting whether
+	// the reference is the one the index creates for a value.
+	WalkReferences(ctx context.Context, walkFunc func(pk PrimaryKey, matches func(value Value) (bool, error)) (stop bool, err error)) error
+}
+
+// CheckIndex verifies the consistency of the index with the IndexedMap: every
+// value of the map must be referenced by the index, and every reference of the
+// index must match the value of the primary key it references. An error
+// wrapping ErrIndexInconsistency is returned with the number of missing and
+// dangling references if the index is not consistent.
+// NOTE: the whole map and index are iterated, this is meant to be used in
+// tests, invariants or offline tooling.
+func (m *IndexedMap[PrimaryKey, Value, Idx]) CheckIndex(ctx context.Context, index VerifiableIndex[PrimaryKey, Value]) error {
+	var (
+		missing, dangling int
+		details           []string
+	)
+	report := func(format string, pk PrimaryKey) {
+		if len(details) < maxReportedInconsistencies {
+			details = append(details, fmt.Sprintf(format, m.KeyCodec().Stringify(pk)))
+		}
+	}
+
+	err := m.Walk(ctx, nil, func(pk PrimaryKey, value Value) (bool, error) {
+		has, err := index.HasReference(ctx, pk, value)
+		if err != nil {
+			return true, err
+		}
+		if !has {
+			missing++
+			report("missing reference of %s", pk)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = index.WalkReferences(ctx, func(pk PrimaryKey, matches func(Value) (bool, error)) (bool, error) {
+		value, err := m.Get(ctx, pk)
+		switch {
+		case errors.Is(err, ErrNotFound):
+			dangling++
+			report("reference of removed %s", pk)
+			return false, nil
+		case err != nil:
+			return true, err
+		}
+
+		ok, err := matches(value)
+		if err != nil {
+			return true, err
+		}
+		if !ok {
+			dangling++
+			report("stale reference of %s", pk)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if missing != 0 || dangling != 0 {
+		return fmt.Errorf("%w: %d missing and %d dangling references: %s", ErrIndexInconsistency, missing, dangling, strings.Join(details, ", "))
+	}
+	return nil
+}
+
+// CheckIndexes runs CheckIndex on every index of the IndexedMap implementing
+// VerifiableIndex.
+func (m *IndexedMap[PrimaryKey, Value, Idx]) CheckIndexes(ctx context.Context) error {
+	for _, index := range m.computedIndexes {
+		verifiable, ok := index.(VerifiableIndex[PrimaryKey, Value])
+		if !ok {
+			continue
+		}
+		if err := m.CheckIndex(ctx, verifiable); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexBackfiller backfills the indexes added to an IndexedMap which already
+// contains values. The IndexedMap maintains the references of the values set
+// or removed after the index was added, the backfiller creates the references
+// of the values set before.
+//
+// Indexes are registered with a version: an index is backfilled once per
+// version, bumping the version of an index backfills it again. The progress of
+// the backfills is saved in the state of the backfiller, so that the indexes
+// can be backfilled in batches across multiple blocks with Backfill.
+type IndexBackfiller[PrimaryKey, Value, Idx any] struct {
+	m       *IndexedMap[PrimaryKey, Value, Idx]
+	indexes []versionedIndex[PrimaryKey, Value]
+	// states maps the name of an index to the version of its last backfill,
+	// whether it is complete and the last primary key processed.
+	states Map[string, Triple[uint64, bool, []byte]]
+}
+
+type versionedIndex[PrimaryKey, Value any] struct {
+	name    string
+	version uint64
+	index   VerifiableIndex[PrimaryKey, Value]
+}
+
+// NewIndexBackfiller instantiates a new IndexBackfiller of the IndexedMap,
+// given a Prefix and a humanized name for the collection storing the progress
+// of the backfills.
+func NewIndexBackfiller[PrimaryKey, Value, Idx any](
+	schema *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	m *IndexedMap[PrimaryKey, Value, Idx],
+) *IndexBackfiller[PrimaryKey, Value, Idx] {
+	return &IndexBackfiller[PrimaryKey, Value, Idx]{
+		m:      m,
+		states: NewMap(schema, prefix, name, StringKey, backfillStateValue),
+	}
+}
+
+// backfillStateValue encodes the state of a backfill.
+var backfillStateValue = codec.KeyToValueCodec(TripleKeyCodec(Uint64Key, BoolKey, BytesKey))
+
+// RegisterIndex registers an index of the IndexedMap with its name and
+// version. The index is pending until it is backfilled at this version.
+// NOTE: if the references of an index change between two versions, e.g.
+// because the reference key is computed differently, the references of the
+// previous version are not removed: the index should use a new prefix.
+func (b *IndexBackfiller[PrimaryKey, Value, Idx]) RegisterIndex(name string, version uint64, index VerifiableIndex[PrimaryKey, Value]) error {
+	if !nameRegex.MatchString(name) {
+		return fmt.Errorf("name must match regex %s, got %s", NameRegex, name)
+	}
+	for _, vi := range b.indexes {
+		if vi.name == name {
+			return fmt.Errorf("index %s is already registered", name)
+		}
+	}
+
+	b.indexes = append(b.indexes, versionedIndex[PrimaryKey, Value]{name: name, version: version, index: index})
+	return nil
+}
+
+// IsBackfilled reports whether the registered index with the given name is
+// backfilled at its version.
+func (b *IndexBackfiller[PrimaryKey, Value, Idx]) IsBackfilled(ctx context.Context, name string) (bool, error) {
+	for _, vi := range b.indexes {
+		if vi.name == name {
+			done, _, err := b.state(ctx, vi)
+			return done, err
+		}
+	}
+	return false, fmt.Errorf("%w: index %s is not registered", ErrNotFound, name)
+}
+
+// PendingIndexes returns the names of the registered indexes which are not
+// backfilled at their version yet.
+func (b *IndexBackfiller[PrimaryKey, Value, Idx]) PendingIndexes(ctx context.Context) ([]string, error) {
+	var pending []string
+	for _, vi := range b.indexes {
+		done, _, err := b.state(ctx, vi)
+		if err != nil {
+			return nil, err
+		}
+		if !done {
+			pending = append(pending, vi.name)
+		}
+	}
+	return pending, nil
+}
+
+// Backfill backfills the pending indexes, in the order of their registration,
+// processing at most limit values of the IndexedMap. It returns whether all the
+// indexes are backfilled, if not it must be called again, e.g. in the next
+// block, to resume the backfill where it stopped.
+func (b *IndexBackfiller[PrimaryKey, Value, Idx]) Backfill(ctx context.Context, limit uint64) (done bool, err error) {
+	for _, vi := range b.indexes {
+		indexDone, cursor, err := b.state(ctx, vi)
+		if err != nil {
+			return false, err
+		}
+		if indexDone {
+			continue
+		}
+		if limit == 0 {
+			return false, nil
+		}
+
+		processed, cursor, indexDone, err := b.backfill(ctx, vi.index, cursor, limit)
+		if err != nil {
+			return false, fmt.Errorf("failed to backfill index %s: %w", vi.name, err)
+		}
+		err = b.states.Set(ctx, vi.name, Join3(vi.version, indexDone, cursor))
+		if err != nil {
+			return false, err
+		}
+		if !indexDone {
+			return false, nil
+		}
+		limit -= processed
+	}
+	return true, nil
+}
+
+// state returns whether the index is backfilled at its version and, if not,
+// the last primary key processed by the backfill, if any.
+func (b *IndexBackfiller[PrimaryKey, Value, Idx]) state(ctx context.Context, vi versionedIndex[PrimaryKey, Value]) (done bool, cursor []byte, err error) {
+	state, err := b.states.Get(ctx, vi.name)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return false, nil, nil
+	case err != nil:
+		return false, nil, err
+	}
+
+	switch version := state.K1(); {
+	case version > vi.version:
+		// the index was backfilled at a later version already
+		return true, nil, nil
+	case version < vi.version:
+		return false, nil, nil
+	default:
+		return state.K2(), state.K3(), nil
+	}
+}
+
+// backfill references at most limit values of the IndexedMap following the
+// cursor in the index, and returns the number of values processed, the new
+// cursor and whether all the values are processed.
+func (b *IndexBackfiller[PrimaryKey, Value, Idx]) backfill(
+	ctx context.Context,
+	index VerifiableIndex[PrimaryKey, Value],
+	cursor []byte,
+	limit uint64,
+) (processed uint64, newCursor []byte, done bool, err error) {
+	var start []byte
+	if cursor != nil {
+		// the next key following the cursor
+		start = append(append([]byte{}, cursor...), 0)
+	}
+
+	kvs, done, err := b.nextValues(ctx, start, limit)
+	if err != nil {
+		return 0, nil, false, err
+	}
+
+	newCursor = cursor
+	for _, kv := range kvs {
+		// the value may have been set after the index was added
+		has, err := index.HasReference(ctx, kv.Key, kv.Value)
+		if err != nil {
+			return 0, nil, false, err
+		}
+		if !has {
+			err = index.Reference(ctx, kv.Key, kv.Value, func() (v Value, err error) { return v, ErrNotFound })
+			if err != nil {
+				return 0, nil, false, err
+			}
+		}
+
+		newCursor, err = EncodeKeyWithPrefix(nil, b.m.KeyCodec(), kv.Key)
+		if err != nil {
+			return 0, nil, false, err
+		}
+	}
+	return uint64(len(kvs)), newCursor, done, nil
+}
+
+// nextValues returns at most limit values of the IndexedMap starting from the
+// raw key start, and whether there are no values left. The values are read
+// before being referenced to not write to the store while iterating over it.
+func (b *IndexBackfiller[PrimaryKey, Value, Idx]) nextValues(ctx context.Context, start []byte, limit uint64) (kvs []KeyValue[PrimaryKey, Value], done bool, err error) {
+	iter, err := b.m.IterateRaw(ctx, start, nil, OrderAscending)
+	if err != nil {
+		return nil, false, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid() && uint64(len(kvs)) < limit; iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, false, err
+		}
+		kvs = append(kvs, kv)
+	}
+	return kvs, !iter.Valid(), nil
+}
@@ -0,0 +1,142 @@
+package collections_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+	"cosmossdk.io/collections/indexes"
+)
+
+var (
+	_ collections.VerifiableIndex[string, company]                           = (*indexes.Multi[string, string, company])(nil)
+	_ collections.VerifiableIndex[string, company]                           = (*indexes.Unique[uint64, string, company])(nil)
+	_ collections.VerifiableIndex[collections.Pair[string, string], company] = (*indexes.ReversePair[string, string, company])(nil)
+)
+
+type cityIndexes struct {
+	City *indexes.Multi[string, string, company]
+}
+
+func (c cityIndexes) IndexesList() []collections.Index[string, company] {
+	return []collections.Index[string, company]{c.City}
+}
+
+func TestIndexBackfiller(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+
+	// the companies are first indexed by city only
+	oldSchema := collections.NewSchemaBuilder(sk)
+	oldMap := collections.NewIndexedMap(oldSchema, collections.NewPrefix(0), "companies", collections.StringKey, colltest.MockValueCodec[company](),
+		cityIndexes{
+			City: indexes.NewMulti(oldSchema, collections.NewPrefix(1), "companies_by_city", collections.StringKey, collections.StringKey, func(pk string, value company) (string, error) {
+				return value.City, nil
+			}),
+		},
+	)
+	require.NoError(t, oldMap.Set(ctx, "1", company{City: "milan", Vat: 1}))
+	require.NoError(t, oldMap.Set(ctx, "2", company{City: "milan", Vat: 2}))
+	require.NoError(t, oldMap.Set(ctx, "3", company{City: "rome", Vat: 3}))
+
+	// then the vat index is added
+	schema := collections.NewSchemaBuilder(sk)
+	im := newTestIndexedMap(schema)
+	backfiller := collections.NewIndexBackfiller(schema, collections.NewPrefix(3), "companies_backfill", im)
+	require.NoError(t, backfiller.RegisterIndex("companies_by_vat", 1, im.Indexes.Vat))
+	require.Error(t, backfiller.RegisterIndex("companies_by_vat", 1, im.Indexes.Vat))
+	require.Error(t, backfiller.RegisterIndex("invalid name", 1, im.Indexes.Vat))
+	_, err := schema.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, im.CheckIndex(ctx, im.Indexes.City))
+	err = im.CheckIndex(ctx, im.Indexes.Vat)
+	require.ErrorIs(t, err, collections.ErrIndexInconsistency)
+	require.ErrorContains(t, err, "3 missing and 0 dangling references")
+
+	pending, err := backfiller.PendingIndexes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"companies_by_vat"}, pending)
+
+	// values set after the index was added are indexed
+	require.NoError(t, im.Set(ctx, "2", company{City: "milan", Vat: 20}))
+	require.NoError(t, im.Set(ctx, "4", company{City: "turin", Vat: 4}))
+
+	// the backfill is resumed where it stopped
+	done, err := backfiller.Backfill(ctx, 2)
+	require.NoError(t, err)
+	require.False(t, done)
+	_, err = im.Indexes.Vat.MatchExact(ctx, 1)
+	require.NoError(t, err)
+	_, err = im.Indexes.Vat.MatchExact(ctx, 3)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	done, err = backfiller.Backfill(ctx, 3)
+	require.NoError(t, err)
+	require.True(t, done)
+
+	backfilled, err := backfiller.IsBackfilled(ctx, "companies_by_vat")
+	require.NoError(t, err)
+	require.True(t, backfilled)
+	_, err = backfiller.IsBackfilled(ctx, "unknown")
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	require.NoError(t, im.CheckIndexes(ctx))
+	for vat, expected := range map[uint64]string{1: "1", 20: "2", 3: "3", 4: "4"} {
+		pk, err := im.Indexes.Vat.MatchExact(ctx, vat)
+		require.NoError(t, err)
+		require.Equal(t, expected, pk)
+	}
+
+	// backfilled indexes are not backfilled again
+	done, err = backfiller.Backfill(ctx, 0)
+	require.NoError(t, err)
+	require.True(t, done)
+
+	// removing a value without maintaining the vat index leaves a dangling reference
+	require.NoError(t, oldMap.Remove(ctx, "4"))
+	err = im.CheckIndex(ctx, im.Indexes.Vat)
+	require.ErrorIs(t, err, collections.ErrIndexInconsistency)
+	require.ErrorContains(t, err, "0 missing and 1 dangling references: reference of removed 4")
+
+	// updating a value without maintaining the vat index leaves a stale reference
+	// and a missing one
+	require.NoError(t, oldMap.Set(ctx, "3", company{City: "rome", Vat: 30}))
+	err = im.CheckIndexes(ctx)
+	require.ErrorIs(t, err, collections.ErrIndexInconsistency)
+	require.ErrorContains(t, err, "1 missing and 2 dangling references")
+}
+
+func TestIndexBackfiller_Version(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+
+	schema := collections.NewSchemaBuilder(sk)
+	im := newTestIndexedMap(schema)
+	backfiller := collections.NewIndexBackfiller(schema, collections.NewPrefix(3), "companies_backfill", im)
+	require.NoError(t, backfiller.RegisterIndex("companies_by_vat", 1, im.Indexes.Vat))
+
+	// an empty map is backfilled at once
+	done, err := backfiller.Backfill(ctx, 10)
+	require.NoError(t, err)
+	require.True(t, done)
+
+	require.NoError(t, im.Set(ctx, "1", company{City: "milan", Vat: 1}))
+
+	// bumping the version backfills the index again
+	bumped := collections.NewIndexBackfiller(collections.NewSchemaBuilder(sk), collections.NewPrefix(3), "companies_backfill", im)
+	require.NoError(t, bumped.RegisterIndex("companies_by_vat", 2, im.Indexes.Vat))
+	pending, err := bumped.PendingIndexes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"companies_by_vat"}, pending)
+
+	done, err = bumped.Backfill(ctx, 10)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.NoError(t, im.CheckIndexes(ctx))
+
+	// the previous version is backfilled already
+	pending, err = backfiller.PendingIndexes(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
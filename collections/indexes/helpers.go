@@ -1,9 +1,11 @@
 package indexes
 
 import (
+	"bytes"
 	"context"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
 )
 
 // iterator defines the minimum set of methods of an index iterator
@@ -111,3 +113,16 @@ func ScanValues[K, V any, I iterator[K], Idx collections.Indexes[K, V]](
 
 	return nil
 }
+
+// keysEqual reports whether two keys have the same encoding.
+func keysEqual[K any](kc codec.KeyCodec[K], a, b K) (bool, error) {
+	aBytes, err := collections.EncodeKeyWithPrefix(nil, kc, a)
+	if err != nil {
+		return false, err
+	}
+	bBytes, err := collections.EncodeKeyWithPrefix(nil, kc, b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(aBytes, bBytes), nil
+}
@@ -101,6 +101,32 @@ func (m *Multi[ReferenceKey, PrimaryKey, Value]) unreference(ctx context.Context
 	return m.refKeys.Remove(ctx, collections.Join(refKey, pk))
 }
 
+// HasReference implements collections.VerifiableIndex.
+func (m *Multi[ReferenceKey, PrimaryKey, Value]) HasReference(ctx context.Context, pk PrimaryKey, value Value) (bool, error) {
+	refKey, err := m.getRefKey(pk, value)
+	if err != nil {
+		return false, err
+	}
+	return m.refKeys.Has(ctx, collections.Join(refKey, pk))
+}
+
+// WalkReferences implements collections.VerifiableIndex.
+func (m *Multi[ReferenceKey, PrimaryKey, Value]) WalkReferences(
+	ctx context.Context,
+	walkFunc func(pk PrimaryKey, matches func(value Value) (bool, error)) (stop bool, err error),
+) error {
+	refCodec := m.refKeys.KeyCodec().(pairKeyCodec[ReferenceKey, PrimaryKey]).KeyCodec1()
+	return m.refKeys.Walk(ctx, nil, func(key collections.Pair[ReferenceKey, PrimaryKey]) (bool, error) {
+		return walkFunc(key.K2(), func(value Value) (bool, error) {
+			refKey, err := m.getRefKey(key.K2(), value)
+			if err != nil {
+				return false, err
+			}
+			return keysEqual(refCodec, refKey, key.K1())
+		})
+	})
+}
+
 func (m *Multi[ReferenceKey, PrimaryKey, Value]) Iterate(ctx context.Context, ranger collections.Ranger[collections.Pair[ReferenceKey, PrimaryKey]]) (MultiIterator[ReferenceKey, PrimaryKey], error) {
 	iter, err := m.refKeys.Iterate(ctx, ranger)
 	return (MultiIterator[ReferenceKey, PrimaryKey])(iter), err
@@ -90,6 +90,22 @@ func (i *ReversePair[K1, K2, Value]) Unreference(ctx context.Context, pk collect
 	return i.refKeys.Remove(ctx, collections.Join(pk.K2(), pk.K1()))
 }
 
+// HasReference implements collections.VerifiableIndex.
+func (i *ReversePair[K1, K2, Value]) HasReference(ctx context.Context, pk collections.Pair[K1, K2], _ Value) (bool, error) {
+	return i.refKeys.Has(ctx, collections.Join(pk.K2(), pk.K1()))
+}
+
+// WalkReferences implements collections.VerifiableIndex. The references only
+// depend on the primary keys, so they match any value.
+func (i *ReversePair[K1, K2, Value]) WalkReferences(
+	ctx context.Context,
+	walkFunc func(pk collections.Pair[K1, K2], matches func(value Value) (bool, error)) (stop bool, err error),
+) error {
+	return i.refKeys.Walk(ctx, nil, func(key collections.Pair[K2, K1]) (bool, error) {
+		return walkFunc(collections.Join(key.K2(), key.K1()), func(Value) (bool, error) { return true, nil })
+	})
+}
+
 func (i *ReversePair[K1, K2, Value]) Walk(
 	ctx context.Context,
 	ranger collections.Ranger[collections.Pair[K2, K1]],
@@ -14,6 +14,7 @@ import (
 type Unique[ReferenceKey, PrimaryKey, Value any] struct {
 	getRefKey func(PrimaryKey, Value) (ReferenceKey, error)
 	refKeys   collections.Map[ReferenceKey, PrimaryKey]
+	pkCodec   codec.KeyCodec[PrimaryKey]
 }
 
 // NewUnique instantiates a new Unique index.
@@ -28,6 +29,7 @@ func NewUnique[ReferenceKey, PrimaryKey, Value any](
 	return &Unique[ReferenceKey, PrimaryKey, Value]{
 		getRefKey: getRefKeyFunc,
 		refKeys:   collections.NewMap(schema, prefix, name, refCodec, codec.KeyToValueCodec(pkCodec)),
+		pkCodec:   pkCodec,
 	}
 }
 
@@ -78,6 +80,38 @@ func (i *Unique[ReferenceKey, PrimaryKey, Value]) unreference(ctx context.Contex
 	return i.refKeys.Remove(ctx, refKey)
 }
 
+// HasReference implements collections.VerifiableIndex.
+func (i *Unique[ReferenceKey, PrimaryKey, Value]) HasReference(ctx context.Context, pk PrimaryKey, value Value) (bool, error) {
+	refKey, err := i.getRefKey(pk, value)
+	if err != nil {
+		return false, err
+	}
+	referenced, err := i.refKeys.Get(ctx, refKey)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+	return keysEqual(i.pkCodec, referenced, pk)
+}
+
+// WalkReferences implements collections.VerifiableIndex.
+func (i *Unique[ReferenceKey, PrimaryKey, Value]) WalkReferences(
+	ctx context.Context,
+	walkFunc func(pk PrimaryKey, matches func(value Value) (bool, error)) (stop bool, err error),
+) error {
+	return i.refKeys.Walk(ctx, nil, func(refKey ReferenceKey, pk PrimaryKey) (bool, error) {
+		return walkFunc(pk, func(value Value) (bool, error) {
+			expected, err := i.getRefKey(pk, value)
+			if err != nil {
+				return false, err
+			}
+			return keysEqual(i.refKeys.KeyCodec(), expected, refKey)
+		})
+	})
+}
+
 func (i *Unique[ReferenceKey, PrimaryKey, Value]) MatchExact(ctx context.Context, ref ReferenceKey) (PrimaryKey, error) {
 	return i.refKeys.Get(ctx, ref)
 }
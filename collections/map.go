@@ -151,6 +151,8 @@ func (m Map[K, V]) Walk(ctx context.Context, ranger Ranger[K], walkFunc func(key
 
 // Clear clears the collection contained within the provided key range.
 // A nil ranger equals to clearing the whole collection.
+// If the underlying store supports range deletions the range is removed in a
+// single operation, otherwise the keys are deleted one by one.
 // NOTE: this API needs to be used with care, considering that as of today
 // cosmos-sdk stores the deletion records to be committed in a memory cache,
 // clearing a lot of data might make the node go OOM.
@@ -159,7 +161,11 @@ func (m Map[K, V]) Clear(ctx context.Context, ranger Ranger[K]) error {
 	if err != nil {
 		return err
 	}
-	return deleteDomain(m.sa(ctx), startBytes, endBytes)
+	kvStore := m.sa(ctx)
+	if rd, ok := kvStore.(store.RangeDeleter); ok && startBytes != nil && endBytes != nil {
+		return rd.DeleteRange(startBytes, endBytes)
+	}
+	return deleteDomain(kvStore, startBytes, endBytes)
 }
 
 const clearBatchSize = 10000
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
 )
 
 func TestMap(t *testing.T) {
@@ -72,6 +74,46 @@ func TestMap_Clear(t *testing.T) {
 		require.Equal(t, keys[0], uint64(101))
 		require.Equal(t, keys[len(keys)-1], uint64(clearBatchSize*2-1))
 	})
+
+	t.Run("range deleter", func(t *testing.T) {
+		sk, ctx := deps()
+		rd := &rangeDeleterStore{testStore: *sk.(*testStore)}
+		m := NewMap(NewSchemaBuilder(rd), NewPrefix(0), "test", Uint64Key, Uint64Value)
+		for i := uint64(0); i < 10; i++ {
+			require.NoError(t, m.Set(ctx, i, i))
+		}
+
+		require.NoError(t, m.Clear(ctx, new(Range[uint64]).StartInclusive(2).EndExclusive(5)))
+		require.Equal(t, 1, rd.calls)
+		iter, err := m.Iterate(ctx, nil)
+		require.NoError(t, err)
+		keys, err := iter.Keys()
+		require.NoError(t, err)
+		require.Equal(t, []uint64{0, 1, 5, 6, 7, 8, 9}, keys)
+
+		require.NoError(t, m.Clear(ctx, nil))
+		require.Equal(t, 2, rd.calls)
+		iter, err = m.Iterate(ctx, nil)
+		require.NoError(t, err)
+		keys, err = iter.Keys()
+		require.NoError(t, err)
+		require.Empty(t, keys)
+	})
+}
+
+// rangeDeleterStore is a testStore supporting range deletions.
+type rangeDeleterStore struct {
+	testStore
+	calls int
+}
+
+func (r *rangeDeleterStore) OpenKVStore(context.Context) store.KVStore {
+	return r
+}
+
+func (r *rangeDeleterStore) DeleteRange(start, end []byte) error {
+	r.calls++
+	return deleteDomain(r.testStore, start, end)
 }
 
 func TestMap_IterateRaw(t *testing.T) {
@@ -0,0 +1,93 @@
+package collections
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// JSONWalkFunc is called by Schema.WalkJSON for each entry of a collection with
+// the raw bytes of the key, without the prefix of the collection, and the JSON
+// encoding of the key and of the value. Returning true stops the walk.
+type JSONWalkFunc func(rawKey []byte, key, value json.RawMessage) (stop bool, err error)
+
+// queryHandler is implemented by collections which can be queried without
+// knowing the types of their keys and values, using their JSON encoding.
+type queryHandler interface {
+	getJSON(ctx context.Context, key json.RawMessage) (json.RawMessage, error)
+	walkJSON(ctx context.Context, start, end []byte, order Order, walkFunc JSONWalkFunc) error
+}
+
+func (m Map[K, V]) getJSON(ctx context.Context, keyJSON json.RawMessage) (json.RawMessage, error) {
+	key, err := m.kc.DecodeJSON(keyJSON)
+	if err != nil {
+		return nil, fmt.Errorf("%w: key decode json: %w", ErrEncoding, err)
+	}
+
+	value, err := m.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.vc.EncodeJSON(value)
+}
+
+func (m Map[K, V]) walkJSON(ctx context.Context, start, end []byte, order Order, walkFunc JSONWalkFunc) error {
+	iter, err := m.IterateRaw(ctx, start, end, order)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return err
+		}
+
+		keyJSON, err := m.kc.EncodeJSON(kv.Key)
+		if err != nil {
+			return err
+		}
+		valueJSON, err := m.vc.EncodeJSON(kv.Value)
+		if err != nil {
+			return err
+		}
+
+		stop, err := walkFunc(iter.iter.Key()[iter.prefixLength:], keyJSON, valueJSON)
+		if err != nil || stop {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetJSON returns the JSON encoding of the value of the collection with the
+// given name whose key has the provided JSON encoding, e.g. `"cosmos1..."` for
+// a string key or `["cosmos1...","stake"]` for a Pair key. ErrNotFound is
+// returned if the collection has no such key.
+func (s Schema) GetJSON(ctx context.Context, collection string, key json.RawMessage) (json.RawMessage, error) {
+	coll, err := s.getCollection(collection)
+	if err != nil {
+		return nil, err
+	}
+	return coll.getJSON(ctx, key)
+}
+
+// WalkJSON walks over the entries of the collection with the given name whose
+// raw keys, without the prefix of the collection, are in the range [start, end),
+// in the given order. A nil start or end leaves the range open on that side.
+// The walk function is called with the JSON encoding of the keys and values.
+func (s Schema) WalkJSON(ctx context.Context, collection string, start, end []byte, order Order, walkFunc JSONWalkFunc) error {
+	coll, err := s.getCollection(collection)
+	if err != nil {
+		return err
+	}
+	return coll.walkJSON(ctx, start, end, order, walkFunc)
+}
+
+// GetCollection returns the collection with the given name.
+func (s Schema) GetCollection(name string) (Collection, error) {
+	return s.getCollection(name)
+}
@@ -0,0 +1,69 @@
+package collections
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaJSONQueries(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	balances := NewMap(schemaBuilder, NewPrefix(1), "balances", PairKeyCodec(StringKey, StringKey), Uint64Value)
+	item := NewItem(schemaBuilder, NewPrefix(2), "item", StringValue)
+	keys := NewKeySet(schemaBuilder, NewPrefix(3), "keys", Uint64Key)
+	schema, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, balances.Set(ctx, Join("alice", "atom"), 10))
+	require.NoError(t, balances.Set(ctx, Join("alice", "stake"), 20))
+	require.NoError(t, balances.Set(ctx, Join("bob", "atom"), 30))
+	require.NoError(t, item.Set(ctx, "value"))
+	require.NoError(t, keys.Set(ctx, 7))
+
+	coll, err := schema.GetCollection("balances")
+	require.NoError(t, err)
+	require.Equal(t, "Pair[string, string]", coll.KeyType())
+	require.Equal(t, "uint64", coll.ValueCodec().ValueType())
+
+	value, err := schema.GetJSON(ctx, "balances", json.RawMessage(`["alice","stake"]`))
+	require.NoError(t, err)
+	require.JSONEq(t, `"20"`, string(value))
+
+	value, err = schema.GetJSON(ctx, "item", json.RawMessage(`"item"`))
+	require.NoError(t, err)
+	require.JSONEq(t, `"value"`, string(value))
+
+	_, err = schema.GetJSON(ctx, "balances", json.RawMessage(`["carol","atom"]`))
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = schema.GetJSON(ctx, "balances", json.RawMessage(`"alice"`))
+	require.ErrorIs(t, err, ErrEncoding)
+	_, err = schema.GetJSON(ctx, "unknown", json.RawMessage(`"alice"`))
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// walk from the second entry, in descending order
+	start, err := EncodeKeyWithPrefix(nil, balances.KeyCodec(), Join("alice", "stake"))
+	require.NoError(t, err)
+	var entries []string
+	err = schema.WalkJSON(ctx, "balances", start, nil, OrderDescending, func(rawKey []byte, key, value json.RawMessage) (bool, error) {
+		decoded, err := balances.KeyCodec().DecodeJSON(key)
+		require.NoError(t, err)
+		encoded, err := EncodeKeyWithPrefix(nil, balances.KeyCodec(), decoded)
+		require.NoError(t, err)
+		require.Equal(t, encoded, rawKey)
+
+		entries = append(entries, string(key)+"="+string(value))
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{`["bob","atom"]="30"`, `["alice","stake"]="20"`}, entries)
+
+	// key sets have no values
+	err = schema.WalkJSON(ctx, "keys", nil, nil, OrderAscending, func(_ []byte, key, value json.RawMessage) (bool, error) {
+		require.JSONEq(t, `"7"`, string(key))
+		require.Empty(t, value)
+		return true, nil
+	})
+	require.NoError(t, err)
+}
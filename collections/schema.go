@@ -287,7 +287,7 @@ func (s Schema) exportGenesis(ctx context.Context, target appmodule.GenesisTarge
 func (s Schema) getCollection(name string) (Collection, error) {
 	coll, ok := s.collectionsByName[name]
 	if !ok {
-		return nil, fmt.Errorf("unknown collection: %s", name)
+		return nil, fmt.Errorf("%w: unknown collection: %s", ErrNotFound, name)
 	}
 	return coll, nil
 }
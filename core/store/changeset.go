@@ -20,13 +20,22 @@ type KVPairs = []KVPair
 
 // KVPair represents a change in a key and value of state.
 // Remove being true signals the key must be removed from state.
+// Remove being true along with a non-nil RangeEnd signals all the keys in the
+// range [Key, RangeEnd) must be removed from state, i.e. a range tombstone.
 type KVPair struct {
-	// Key defines the key being updated.
+	// Key defines the key being updated, or the start of the removed range.
 	Key []byte
 	// Value defines the value associated with the updated key.
 	Value []byte
 	// Remove is true when the key must be removed from state.
 	Remove bool
+	// RangeEnd defines the exclusive end of the removed range, if any.
+	RangeEnd []byte
+}
+
+// IsRangeDelete returns true if the pair removes a range of keys.
+func (kv KVPair) IsRangeDelete() bool {
+	return kv.Remove && kv.RangeEnd != nil
 }
 
 func NewChangeset() *Changeset {
@@ -82,6 +91,13 @@ func (cs *Changeset) Add(storeKey, key, value []byte, remove bool) {
 	}
 }
 
+// AddDeleteRange adds the removal of all the keys in the range [start, end) to
+// the ChangeSet. The removal applies to the state written so far, including the
+// pairs previously added to the ChangeSet, but not to the pairs added after it.
+func (cs *Changeset) AddDeleteRange(storeKey, start, end []byte) {
+	cs.AddKVPair(storeKey, KVPair{Key: start, Remove: true, RangeEnd: end})
+}
+
 // AddKVPair adds a KVPair to the ChangeSet.
 func (cs *Changeset) AddKVPair(storeKey []byte, pair KVPair) {
 	found := false
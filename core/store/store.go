@@ -30,6 +30,14 @@ type KVStore interface {
 	ReverseIterator(start, end []byte) (Iterator, error)
 }
 
+// RangeDeleter is an optional interface a KVStore can implement to remove a
+// range of keys in a single operation, i.e. without iterating and deleting the
+// keys one by one. Start and end must not be nil.
+type RangeDeleter interface {
+	// DeleteRange removes all the keys in the range [start, end).
+	DeleteRange(start, end []byte) error
+}
+
 // Iterator represents an iterator over a domain of keys. Callers must call
 // Close when done. No writes can happen to a domain while there exists an
 // iterator over it. Some backends may take out database locks to ensure this
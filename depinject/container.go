@@ -23,6 +23,9 @@ type container struct {
 	resolveStack []resolveFrame
 	callerStack  []Location
 	callerMap    map[Location]bool
+
+	// validation is set when statically validating a configuration, see Validate.
+	validation *validation
 }
 
 type invoker struct {
@@ -251,6 +254,10 @@ func (c *container) addNode(provider *providerDescriptor, key *moduleKey) (inter
 			moduleKey: key,
 		}
 
+		if c.validation != nil {
+			c.validation.addProvider(provider, key, false)
+		}
+
 		for i, out := range provider.Outputs {
 			typ := out.Type
 
@@ -273,7 +280,7 @@ func (c *container) addNode(provider *providerDescriptor, key *moduleKey) (inter
 			if vr != nil {
 				c.logf("Found resolver for %v: %T", typ, vr)
 				err := vr.addNode(sp, i)
-				if err != nil {
+				if err = c.reportDuplicate(err); err != nil {
 					return nil, err
 				}
 			} else {
@@ -310,6 +317,10 @@ func (c *container) addNode(provider *providerDescriptor, key *moduleKey) (inter
 		valueMap:        map[*moduleKey][]reflect.Value{},
 	}
 
+	if c.validation != nil {
+		c.validation.addProvider(provider, key, true)
+	}
+
 	for i, out := range provider.Outputs {
 		typ := out.Type
 
@@ -317,8 +328,16 @@ func (c *container) addNode(provider *providerDescriptor, key *moduleKey) (inter
 
 		existing, ok := c.resolverByType(typ)
 		if ok {
-			return nil, errors.Errorf("duplicate provision of type %v by module-scoped provider %s\n\talready provided by %s",
-				typ, provider.Location, existing.describeLocation())
+			err := errors.WithStack(duplicateProvisionError{
+				typ:       typ,
+				locations: []string{existing.describeLocation(), provider.Location.String()},
+				msg: fmt.Sprintf("duplicate provision of type %v by module-scoped provider %s\n\talready provided by %s",
+					typ, provider.Location, existing.describeLocation()),
+			})
+			if err = c.reportDuplicate(err); err != nil {
+				return nil, err
+			}
+			continue
 		}
 
 		typeGraphNode := c.typeGraphNode(typ)
@@ -344,7 +363,11 @@ func (c *container) supply(value reflect.Value, location Location) error {
 	c.addGraphEdge(locGrapNode, typeGraphNode)
 
 	if existing, ok := c.resolverByType(typ); ok {
-		return duplicateDefinitionError(typ, location, existing.describeLocation())
+		return c.reportDuplicate(duplicateDefinitionError(typ, location, existing.describeLocation()))
+	}
+
+	if c.validation != nil {
+		c.validation.addSupply(location, typ)
 	}
 
 	c.addResolver(typ, &supplyResolver{
@@ -62,7 +62,23 @@ func (err ErrNoTypeForExplicitBindingFound) Error() string {
 		err.Interface, err.Implementation)
 }
 
+// duplicateProvisionError defines an error condition where a type is provided more than once in the same scope.
+type duplicateProvisionError struct {
+	typ        reflect.Type
+	moduleName string
+	locations  []string
+	msg        string
+}
+
+func (err duplicateProvisionError) Error() string {
+	return err.msg
+}
+
 func duplicateDefinitionError(typ reflect.Type, duplicateLoc Location, existingLoc string) error {
-	return errors.Errorf("duplicate provision of type %v by %s\n\talready provided by %s",
-		typ, duplicateLoc, existingLoc)
+	return errors.WithStack(duplicateProvisionError{
+		typ:       typ,
+		locations: []string{existingLoc, duplicateLoc.String()},
+		msg: fmt.Sprintf("duplicate provision of type %v by %s\n\talready provided by %s",
+			typ, duplicateLoc, existingLoc),
+	})
 }
@@ -91,8 +91,13 @@ func (o *onePerModuleResolver) addNode(n *simpleProvider, i int) error {
 	}
 
 	if existing, ok := o.providers[n.moduleKey]; ok {
-		return errors.Errorf("duplicate provision for one-per-module type %v in module %s: %s\n\talready provided by %s",
-			o.typ, n.moduleKey.name, n.provider.Location, existing.provider.Location)
+		return errors.WithStack(duplicateProvisionError{
+			typ:        o.typ,
+			moduleName: n.moduleKey.name,
+			locations:  []string{existing.provider.Location.String(), n.provider.Location.String()},
+			msg: fmt.Sprintf("duplicate provision for one-per-module type %v in module %s: %s\n\talready provided by %s",
+				o.typ, n.moduleKey.name, n.provider.Location, existing.provider.Location),
+		})
 	}
 
 	o.providers[n.moduleKey] = n
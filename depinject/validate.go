@@ -0,0 +1,527 @@
+package depinject
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"cosmossdk.io/depinject/internal/graphviz"
+)
+
+// NodeKind is the kind of a node of a ProviderGraph.
+type NodeKind string
+
+const (
+	// NodeProvider is a provider function registered with Provide or ProvideInModule.
+	NodeProvider NodeKind = "provider"
+	// NodeInvoker is an invoker function registered with Invoke or InvokeInModule.
+	NodeInvoker NodeKind = "invoker"
+	// NodeSupply is a call to Supply.
+	NodeSupply NodeKind = "supply"
+	// NodeOutput stands for the outputs requested from the container.
+	NodeOutput NodeKind = "output"
+)
+
+// ValidationReport is the result of the static validation of a container
+// configuration by Validate.
+type ValidationReport struct {
+	// Missing lists the required dependencies which can't be resolved.
+	Missing []MissingDependency `json:"missing,omitempty"`
+
+	// Duplicates lists the types provided more than once in the same scope,
+	// and the interfaces implicitly bound to more than one implementation.
+	Duplicates []DuplicateProvision `json:"duplicates,omitempty"`
+
+	// Cycles lists the dependency cycles, as the IDs of the nodes of Graph
+	// forming each of them.
+	Cycles [][]string `json:"cycles,omitempty"`
+
+	// Unused lists the IDs of the providers which neither an invoker nor the
+	// requested outputs depend on, even indirectly.
+	Unused []string `json:"unused,omitempty"`
+
+	// Graph is the dependency graph of the container.
+	Graph ProviderGraph `json:"graph"`
+}
+
+// MissingDependency is a dependency of a node of the graph which no provider,
+// supplied value or interface binding resolves.
+type MissingDependency struct {
+	Type      string `json:"type"`
+	Module    string `json:"module,omitempty"`
+	Dependent string `json:"dependent"`
+}
+
+// DuplicateProvision is a type provided by more than one provider in the same
+// scope.
+type DuplicateProvision struct {
+	Type      string   `json:"type"`
+	Module    string   `json:"module,omitempty"`
+	Providers []string `json:"providers"`
+}
+
+// ProviderGraph is the graph of the providers, invokers and supplied values of
+// a container, and of the dependencies between them.
+type ProviderGraph struct {
+	Nodes []ProviderNode `json:"nodes"`
+}
+
+// ProviderNode is a node of a ProviderGraph.
+type ProviderNode struct {
+	// ID uniquely identifies the node in the graph. It is the fully qualified
+	// name of the function, prefixed by the module name for nodes running in a
+	// module.
+	ID       string   `json:"id"`
+	Kind     NodeKind `json:"kind"`
+	Location string   `json:"location"`
+	Module   string   `json:"module,omitempty"`
+	Inputs   []string `json:"inputs,omitempty"`
+	Outputs  []string `json:"outputs,omitempty"`
+
+	// Dependencies are the IDs of the nodes providing the inputs of the node.
+	Dependencies []string `json:"dependencies,omitempty"`
+
+	// Missing are the required inputs of the node which can't be resolved.
+	Missing []string `json:"missing,omitempty"`
+
+	// Unused is true for the providers which neither an invoker nor the
+	// requested outputs depend on, even indirectly.
+	Unused bool `json:"unused,omitempty"`
+}
+
+// Err returns an error describing the missing dependencies, duplicate
+// provisions and cycles of the report, or nil if there are none. Unused
+// providers aren't considered as errors.
+func (r *ValidationReport) Err() error {
+	if len(r.Missing) == 0 && len(r.Duplicates) == 0 && len(r.Cycles) == 0 {
+		return nil
+	}
+
+	buf := &bytes.Buffer{}
+	_, _ = fmt.Fprintf(buf, "invalid container configuration:")
+	for _, m := range r.Missing {
+		_, _ = fmt.Fprintf(buf, "\n\tcan't resolve type %s for %s", m.Type, m.Dependent)
+	}
+	for _, d := range r.Duplicates {
+		_, _ = fmt.Fprintf(buf, "\n\tduplicate provision of type %s", d.Type)
+		if d.Module != "" {
+			_, _ = fmt.Fprintf(buf, " in module %s", d.Module)
+		}
+		_, _ = fmt.Fprintf(buf, " by %s", strings.Join(d.Providers, ", "))
+	}
+	for _, c := range r.Cycles {
+		_, _ = fmt.Fprintf(buf, "\n\tcyclic dependency: %s", strings.Join(c, " -> "))
+	}
+	return errors.New(buf.String())
+}
+
+// DOT renders the graph in the Graphviz DOT format, grouping the nodes by
+// module. Unused providers are grayed out and missing dependencies are shown
+// in red.
+func (g ProviderGraph) DOT() string {
+	graph := graphviz.NewGraph()
+	nodes := make(map[string]*graphviz.Node, len(g.Nodes))
+	for _, n := range g.Nodes {
+		sub := graph
+		if n.Module != "" {
+			var found bool
+			sub, found = graph.FindOrCreateSubGraph(fmt.Sprintf("cluster_%s", n.Module))
+			if !found {
+				sub.SetLabel(fmt.Sprintf("Module: %s", n.Module))
+				sub.SetPenWidth("0.5")
+				sub.SetFontSize("12.0")
+				sub.SetStyle("rounded")
+			}
+		}
+
+		node, _ := sub.FindOrCreateNode(n.ID)
+		switch n.Kind {
+		case NodeInvoker, NodeOutput:
+			node.SetShape("hexagon")
+		case NodeSupply:
+			node.SetShape("note")
+		default:
+			node.SetShape("box")
+		}
+		if n.Unused {
+			setUnusedStyle(node.Attributes)
+		}
+		nodes[n.ID] = node
+	}
+
+	for _, n := range g.Nodes {
+		for _, dep := range n.Dependencies {
+			graph.CreateEdge(nodes[dep], nodes[n.ID])
+		}
+		for _, typ := range n.Missing {
+			missing, _ := graph.FindOrCreateNode(typ)
+			markGraphNodeAsFailed(missing)
+			graph.CreateEdge(missing, nodes[n.ID])
+		}
+	}
+
+	return graph.String()
+}
+
+// Validate statically validates a container configuration. It registers the
+// providers, invokers, supplied values and interface bindings of
+// containerConfig like Inject, and then checks that the inputs of the invokers,
+// of the requested outputs and of the providers they depend on can be
+// resolved, without calling any provider or invoker. Like for Inject, each of
+// the outputs must be a pointer to a type the container is expected to
+// provide, only its type is used.
+//
+// An error is returned only if containerConfig can't be registered, problems
+// of the dependency graph are listed in the returned ValidationReport.
+func Validate(containerConfig Config, outputs ...interface{}) (*ValidationReport, error) {
+	loc := LocationFromCaller(1)
+
+	cfg, err := newDebugConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	ctr := newContainer(cfg)
+	ctr.validation = newValidation()
+	if err := containerConfig.apply(ctr); err != nil {
+		return nil, err
+	}
+
+	var inputs []providerInput
+	for _, output := range outputs {
+		typ := reflect.TypeOf(output)
+		if typ.Kind() != reflect.Pointer {
+			return nil, fmt.Errorf("output type must be a pointer, %s is invalid", typ)
+		}
+
+		inputs = append(inputs, providerInput{Type: typ.Elem()})
+	}
+
+	desc, err := expandStructArgsProvider(providerDescriptor{Inputs: inputs, Location: loc})
+	if err != nil {
+		return nil, err
+	}
+
+	return ctr.validation.run(ctr, &desc)
+}
+
+// validation holds the state of the static validation of a container
+// configuration.
+type validation struct {
+	report ValidationReport
+
+	nodes       []*validationNode
+	providers   map[*providerDescriptor]*validationNode
+	supplies    map[string]*validationNode
+	moduleDeps  map[moduleDepInstance]*validationNode
+	moduleScope []registeredProvider
+}
+
+// validationNode is a node of the dependency graph built by validation.
+type validationNode struct {
+	kind    NodeKind
+	loc     Location
+	key     *moduleKey
+	inputs  []providerInput
+	outputs []reflect.Type
+	deps    []*validationNode
+	missing []string
+
+	id      string
+	reached bool
+}
+
+// moduleDepInstance identifies a module-scoped provider called for a module.
+type moduleDepInstance struct {
+	provider *providerDescriptor
+	key      *moduleKey
+}
+
+type registeredProvider struct {
+	provider *providerDescriptor
+	key      *moduleKey
+}
+
+func newValidation() *validation {
+	return &validation{
+		providers:  map[*providerDescriptor]*validationNode{},
+		supplies:   map[string]*validationNode{},
+		moduleDeps: map[moduleDepInstance]*validationNode{},
+	}
+}
+
+func (v *validation) addNode(kind NodeKind, provider *providerDescriptor, key *moduleKey) *validationNode {
+	n := &validationNode{
+		kind:   kind,
+		loc:    provider.Location,
+		key:    key,
+		inputs: provider.Inputs,
+	}
+	for _, out := range provider.Outputs {
+		n.outputs = append(n.outputs, out.Type)
+	}
+	v.nodes = append(v.nodes, n)
+	return n
+}
+
+// addProvider registers a provider. Module-scoped providers are only added to
+// the graph once resolved for a module, as they are called once per module.
+func (v *validation) addProvider(provider *providerDescriptor, key *moduleKey, moduleScoped bool) {
+	if moduleScoped {
+		v.moduleScope = append(v.moduleScope, registeredProvider{provider: provider, key: key})
+		return
+	}
+
+	v.providers[provider] = v.addNode(NodeProvider, provider, key)
+}
+
+func (v *validation) addSupply(loc Location, typ reflect.Type) {
+	n, ok := v.supplies[loc.String()]
+	if !ok {
+		n = &validationNode{kind: NodeSupply, loc: loc}
+		v.supplies[loc.String()] = n
+		v.nodes = append(v.nodes, n)
+	}
+	n.outputs = append(n.outputs, typ)
+}
+
+func (v *validation) moduleDepNode(provider *providerDescriptor, key *moduleKey) *validationNode {
+	instance := moduleDepInstance{provider: provider, key: key}
+	n, ok := v.moduleDeps[instance]
+	if !ok {
+		n = v.addNode(NodeProvider, provider, key)
+		v.moduleDeps[instance] = n
+	}
+	return n
+}
+
+func (v *validation) run(ctr *container, outputs *providerDescriptor) (*ValidationReport, error) {
+	for _, inv := range ctr.invokers {
+		v.addNode(NodeInvoker, inv.fn, inv.modKey)
+	}
+	if len(outputs.Inputs) > 0 {
+		v.addNode(NodeOutput, outputs, nil)
+	}
+
+	// resolving the inputs of a node may add module-scoped provider nodes
+	for i := 0; i < len(v.nodes); i++ {
+		if err := v.resolveInputs(ctr, v.nodes[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	// module-scoped providers which no node depends on
+	for _, p := range v.moduleScope {
+		used := false
+		for instance := range v.moduleDeps {
+			if instance.provider == p.provider {
+				used = true
+				break
+			}
+		}
+		if !used {
+			v.addNode(NodeProvider, p.provider, p.key)
+		}
+	}
+
+	for _, n := range v.nodes {
+		if n.kind == NodeInvoker || n.kind == NodeOutput {
+			markReached(n)
+		}
+	}
+
+	v.assignIDs()
+	v.findCycles()
+
+	for _, n := range v.nodes {
+		node := ProviderNode{
+			ID:       n.id,
+			Kind:     n.kind,
+			Location: n.loc.String(),
+			Missing:  n.missing,
+			Unused:   n.kind == NodeProvider && !n.reached,
+		}
+		if n.key != nil {
+			node.Module = n.key.name
+		}
+		for _, in := range n.inputs {
+			node.Inputs = append(node.Inputs, fullyQualifiedTypeName(in.Type))
+		}
+		for _, out := range n.outputs {
+			node.Outputs = append(node.Outputs, fullyQualifiedTypeName(out))
+		}
+		for _, dep := range n.deps {
+			node.Dependencies = append(node.Dependencies, dep.id)
+		}
+		for _, typ := range n.missing {
+			v.report.Missing = append(v.report.Missing, MissingDependency{Type: typ, Module: node.Module, Dependent: n.id})
+		}
+		if node.Unused {
+			v.report.Unused = append(v.report.Unused, n.id)
+		}
+
+		v.report.Graph.Nodes = append(v.report.Graph.Nodes, node)
+	}
+
+	return &v.report, nil
+}
+
+// resolveInputs finds the nodes providing the inputs of n, like
+// container.resolve would without calling them.
+func (v *validation) resolveInputs(ctr *container, n *validationNode) error {
+	for _, in := range n.inputs {
+		if in.Type == moduleKeyType || in.Type == ownModuleKeyType {
+			if n.key == nil {
+				n.missing = append(n.missing, fullyQualifiedTypeName(in.Type))
+			}
+			continue
+		}
+
+		vr, err := ctr.getResolver(in.Type, n.key)
+		if err != nil {
+			var multipleErr ErrMultipleImplicitInterfaceBindings
+			var noTypeErr ErrNoTypeForExplicitBindingFound
+			switch {
+			case errors.As(err, &multipleErr):
+				dup := DuplicateProvision{Type: fullyQualifiedTypeName(multipleErr.Interface)}
+				if n.key != nil {
+					dup.Module = n.key.name
+				}
+				for _, match := range multipleErr.Matches {
+					r, _ := ctr.resolverByType(match)
+					dup.Providers = append(dup.Providers, r.describeLocation())
+				}
+				v.report.Duplicates = append(v.report.Duplicates, dup)
+			case errors.As(err, &noTypeErr):
+				n.missing = append(n.missing, noTypeErr.Implementation)
+			default:
+				return err
+			}
+			continue
+		}
+
+		switch r := vr.(type) {
+		case nil:
+			if !in.Optional {
+				n.missing = append(n.missing, fullyQualifiedTypeName(in.Type))
+			}
+		case *simpleResolver:
+			n.addDep(v.providers[r.node.provider])
+		case *moduleDepResolver:
+			n.addDep(v.moduleDepNode(r.node.provider, n.key))
+		case *supplyResolver:
+			n.addDep(v.supplies[r.loc.String()])
+		case *sliceGroupResolver:
+			for _, p := range r.providers {
+				n.addDep(v.providers[p.provider])
+			}
+		case *mapOfOnePerModuleResolver:
+			for _, p := range r.providers {
+				n.addDep(v.providers[p.provider])
+			}
+		default:
+			// many-per-container and one-per-module types can't be inputs,
+			// only their slice and map types can
+			n.missing = append(n.missing, fullyQualifiedTypeName(in.Type))
+		}
+	}
+
+	return nil
+}
+
+func (n *validationNode) addDep(dep *validationNode) {
+	if dep == nil {
+		return
+	}
+	for _, d := range n.deps {
+		if d == dep {
+			return
+		}
+	}
+	n.deps = append(n.deps, dep)
+}
+
+func markReached(n *validationNode) {
+	if n.reached {
+		return
+	}
+	n.reached = true
+	for _, dep := range n.deps {
+		markReached(dep)
+	}
+}
+
+// assignIDs assigns unique IDs to the nodes, in the order they were added.
+func (v *validation) assignIDs() {
+	seen := map[string]int{}
+	for _, n := range v.nodes {
+		id := n.loc.Name()
+		if n.key != nil {
+			id = fmt.Sprintf("%s:%s", n.key.name, id)
+		}
+		seen[id]++
+		if count := seen[id]; count > 1 {
+			id = fmt.Sprintf("%s#%d", id, count)
+		}
+		n.id = id
+	}
+}
+
+// findCycles reports the dependency cycles of the graph.
+func (v *validation) findCycles() {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := map[*validationNode]int{}
+	var stack []*validationNode
+	var visit func(n *validationNode)
+	visit = func(n *validationNode) {
+		state[n] = visiting
+		stack = append(stack, n)
+		for _, dep := range n.deps {
+			switch state[dep] {
+			case unvisited:
+				visit(dep)
+			case visiting:
+				var cycle []string
+				for i := len(stack) - 1; i >= 0; i-- {
+					cycle = append([]string{stack[i].id}, cycle...)
+					if stack[i] == dep {
+						break
+					}
+				}
+				v.report.Cycles = append(v.report.Cycles, append(cycle, dep.id))
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[n] = visited
+	}
+
+	for _, n := range v.nodes {
+		if state[n] == unvisited {
+			visit(n)
+		}
+	}
+}
+
+// reportDuplicate records a duplicate provision in the validation report when
+// validating a configuration, instead of failing. Any other error is returned.
+func (c *container) reportDuplicate(err error) error {
+	var dupErr duplicateProvisionError
+	if c.validation == nil || !errors.As(err, &dupErr) {
+		return err
+	}
+
+	c.validation.report.Duplicates = append(c.validation.report.Duplicates, DuplicateProvision{
+		Type:      fullyQualifiedTypeName(dupErr.typ),
+		Module:    dupErr.moduleName,
+		Providers: dupErr.locations,
+	})
+	return nil
+}
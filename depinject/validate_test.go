@@ -0,0 +1,120 @@
+package depinject_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+)
+
+func ProvideFloat(x int) float64 { return float64(x) }
+
+func ProvideIntAndString(x float64) (int, string) { return int(x), "hi" }
+
+func ProvideString() string { return "hi" }
+
+func ProvideUnusedFloat() float32 { return 1 }
+
+func ProvideHandler() Handler { return Handler{} }
+
+func InvokeString(string) {}
+
+func TestValidateScenario(t *testing.T) {
+	var (
+		handlers map[string]Handler
+		commands []Command
+		a        KeeperA
+		b        KeeperB
+	)
+	report, err := depinject.Validate(scenarioConfig, &handlers, &commands, &a, &b)
+	require.NoError(t, err)
+	require.NoError(t, report.Err())
+	require.Empty(t, report.Unused)
+
+	nodes := map[string]depinject.ProviderNode{}
+	for _, node := range report.Graph.Nodes {
+		nodes[node.ID] = node
+	}
+
+	// module-scoped providers are resolved once per module
+	keyA := nodes["a:cosmossdk.io/depinject_test.ProvideKVStoreKey"]
+	require.Equal(t, depinject.NodeProvider, keyA.Kind)
+	require.Equal(t, "a", keyA.Module)
+	require.Contains(t, nodes, "b:cosmossdk.io/depinject_test.ProvideKVStoreKey")
+	require.NotContains(t, nodes, "runtime:cosmossdk.io/depinject_test.ProvideKVStoreKey")
+
+	moduleA := nodes["a:cosmossdk.io/depinject_test.ModuleA.Provide"]
+	require.Equal(t, []string{"cosmossdk.io/depinject_test/depinject_test.KeeperA", "cosmossdk.io/depinject_test/depinject_test.Handler", "cosmossdk.io/depinject_test/depinject_test.Command"}, moduleA.Outputs)
+	require.Len(t, moduleA.Dependencies, 2)
+	require.Contains(t, moduleA.Dependencies, keyA.ID)
+
+	output := nodes["cosmossdk.io/depinject_test.TestValidateScenario"]
+	require.Equal(t, depinject.NodeOutput, output.Kind)
+	require.Contains(t, output.Dependencies, moduleA.ID)
+	require.Contains(t, output.Dependencies, "b:cosmossdk.io/depinject_test.ModuleB.Provide")
+
+	dot := report.Graph.DOT()
+	require.Contains(t, dot, "cluster_a")
+	require.Contains(t, dot, "cluster_b")
+}
+
+func TestValidateMissingAndCycles(t *testing.T) {
+	var x string
+	report, err := depinject.Validate(depinject.Provide(ProvideFloat, ProvideIntAndString), &x)
+	require.NoError(t, err)
+	require.Error(t, report.Err())
+	require.Empty(t, report.Missing)
+	require.Len(t, report.Cycles, 1)
+	require.Equal(t, []string{
+		"cosmossdk.io/depinject_test.ProvideFloat",
+		"cosmossdk.io/depinject_test.ProvideIntAndString",
+		"cosmossdk.io/depinject_test.ProvideFloat",
+	}, report.Cycles[0])
+
+	report, err = depinject.Validate(depinject.Provide(ProvideFloat), &x)
+	require.NoError(t, err)
+	require.Equal(t, []depinject.MissingDependency{
+		{Type: "int", Dependent: "cosmossdk.io/depinject_test.ProvideFloat"},
+		{Type: "string", Dependent: "cosmossdk.io/depinject_test.TestValidateMissingAndCycles"},
+	}, report.Missing)
+	require.Equal(t, []string{"cosmossdk.io/depinject_test.ProvideFloat"}, report.Unused)
+	require.ErrorContains(t, report.Err(), "can't resolve type string for cosmossdk.io/depinject_test.TestValidateMissingAndCycles")
+
+	// invoker inputs are optional
+	report, err = depinject.Validate(depinject.Invoke(InvokeString))
+	require.NoError(t, err)
+	require.NoError(t, report.Err())
+}
+
+func TestValidateDuplicates(t *testing.T) {
+	report, err := depinject.Validate(
+		depinject.Configs(
+			depinject.Provide(ProvideString, ProvideUnusedFloat),
+			depinject.Supply("hello"),
+			depinject.Invoke(InvokeString),
+		),
+	)
+	require.NoError(t, err)
+	require.Len(t, report.Duplicates, 1)
+	require.Equal(t, "string", report.Duplicates[0].Type)
+	require.Len(t, report.Duplicates[0].Providers, 2)
+	require.Equal(t, []string{"cosmossdk.io/depinject_test.ProvideUnusedFloat"}, report.Unused)
+	require.ErrorContains(t, report.Err(), "duplicate provision of type string")
+
+	// one-per-module types may be provided once per module
+	report, err = depinject.Validate(
+		depinject.Configs(
+			depinject.ProvideInModule("a", ProvideHandler),
+			depinject.ProvideInModule("a", ProvideHandler),
+		),
+	)
+	require.NoError(t, err)
+	require.Len(t, report.Duplicates, 1)
+	require.Equal(t, "a", report.Duplicates[0].Module)
+
+	// configuration errors aren't reported but returned
+	_, err = depinject.Validate(depinject.Error(fmt.Errorf("an error")))
+	require.ErrorContains(t, err, "an error")
+}
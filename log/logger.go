@@ -95,19 +95,55 @@ func NewLogger(dst io.Writer, options ...Option) Logger {
 		opt(&logCfg)
 	}
 
-	output := dst
-	if !logCfg.OutputJSON {
-		output = zerolog.ConsoleWriter{
-			Out:        dst,
-			NoColor:    !logCfg.Color,
-			TimeFormat: logCfg.TimeFormat,
+	newOutput := func(dst io.Writer, color bool) io.Writer {
+		output := dst
+		if !logCfg.OutputJSON {
+			output = zerolog.ConsoleWriter{
+				Out:        dst,
+				NoColor:    !color,
+				TimeFormat: logCfg.TimeFormat,
+			}
 		}
+
+		if logCfg.RateLimit != nil {
+			output = NewRateLimitWriter(output, logCfg.RateLimit.Rate, logCfg.RateLimit.Burst)
+		}
+
+		if logCfg.Sampling != nil {
+			output = NewSamplingWriter(output, *logCfg.Sampling)
+		}
+
+		return output
 	}
 
+	output := newOutput(dst, logCfg.Color)
 	if logCfg.Filter != nil {
 		output = NewFilterWriter(output, logCfg.Filter)
 	}
 
+	level := logCfg.Level
+	if len(logCfg.Routes) > 0 {
+		routes := make([]Route, len(logCfg.Routes))
+		for i, route := range logCfg.Routes {
+			routes[i] = Route{
+				Module: route.Module,
+				Level:  route.Level,
+				Output: newOutput(route.Output, false),
+			}
+
+			// the routed events must not be discarded before being routed
+			routeLevel := route.Level
+			if routeLevel == zerolog.NoLevel {
+				routeLevel = zerolog.TraceLevel
+			}
+			if level != zerolog.NoLevel && routeLevel < level {
+				level = routeLevel
+			}
+		}
+
+		output = NewRouteWriter(output, logCfg.Level, routes...)
+	}
+
 	logger := zerolog.New(output)
 	if logCfg.StackTrace {
 		zerolog.ErrorStackMarshaler = func(err error) interface{} {
@@ -121,8 +157,8 @@ func NewLogger(dst io.Writer, options ...Option) Logger {
 		logger = logger.With().Timestamp().Logger()
 	}
 
-	if logCfg.Level != zerolog.NoLevel {
-		logger = logger.Level(logCfg.Level)
+	if level != zerolog.NoLevel {
+		logger = logger.Level(level)
 	}
 
 	logger = logger.Hook(logCfg.Hooks...)
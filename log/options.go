@@ -15,6 +15,9 @@ var defaultConfig = Config{
 	StackTrace: false,
 	TimeFormat: time.Kitchen,
 	Hooks:      nil,
+	Sampling:   nil,
+	RateLimit:  nil,
+	Routes:     nil,
 }
 
 // Config defines configuration for the logger.
@@ -26,6 +29,17 @@ type Config struct {
 	StackTrace bool
 	TimeFormat string
 	Hooks      []zerolog.Hook
+	Sampling   *SamplingConfig
+	RateLimit  *RateLimitConfig
+	Routes     []Route
+}
+
+// RateLimitConfig defines the token bucket rate limiting of the log events.
+type RateLimitConfig struct {
+	// Rate is the number of events per second refilling the bucket.
+	Rate float64
+	// Burst is the size of the bucket.
+	Burst int
 }
 
 type Option func(*Config)
@@ -96,3 +110,30 @@ func HooksOption(hooks ...zerolog.Hook) Option {
 		cfg.Hooks = append(cfg.Hooks, hooks...)
 	}
 }
+
+// SamplingOption samples the log events of the Logger, see SamplingConfig.
+// Sampling applies to each output of the Logger separately.
+func SamplingOption(sampling SamplingConfig) Option {
+	return func(cfg *Config) {
+		cfg.Sampling = &sampling
+	}
+}
+
+// RateLimitOption rate limits the log events of the Logger to rate events per
+// second, with bursts of up to burst events. Events exceeding the rate limit
+// are discarded. The rate limit applies to each output of the Logger
+// separately.
+func RateLimitOption(rate float64, burst int) Option {
+	return func(cfg *Config) {
+		cfg.RateLimit = &RateLimitConfig{Rate: rate, Burst: burst}
+	}
+}
+
+// RoutesOption routes the log events of modules to distinct outputs and levels.
+// The level and filter of the Logger don't apply to the routed modules, and the
+// routed events are written without colors.
+func RoutesOption(routes ...Route) Option {
+	return func(cfg *Config) {
+		cfg.Routes = append(cfg.Routes, routes...)
+	}
+}
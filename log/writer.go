@@ -4,6 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
 )
 
 // NewFilterWriter returns a writer that filters out all key/value pairs that do not match the filter.
@@ -23,13 +28,9 @@ func (fw *filterWriter) Write(p []byte) (n int, err error) {
 		return fw.parent.Write(p)
 	}
 
-	var event struct {
-		Level  string `json:"level"`
-		Module string `json:"module"`
-	}
-
-	if err := json.Unmarshal(p, &event); err != nil {
-		return 0, fmt.Errorf("failed to unmarshal event: %w", err)
+	event, err := parseEvent(p)
+	if err != nil {
+		return 0, err
 	}
 
 	// only filter module keys
@@ -39,3 +40,173 @@ func (fw *filterWriter) Write(p []byte) (n int, err error) {
 
 	return fw.parent.Write(p)
 }
+
+// SamplingConfig defines the sampling of the log events by NewSamplingWriter.
+// Within each period, the first Initial events with a given level, module and
+// message are written, and then only every Thereafter-th of them. The other
+// events are discarded.
+type SamplingConfig struct {
+	// Initial is the number of events with a given key written in each period
+	// before sampling them.
+	Initial int
+	// Thereafter is the sampling rate of the events with a given key once
+	// Initial of them have been written in the period. Zero discards them all.
+	Thereafter int
+	// Period is the duration after which the event counters are reset. Zero
+	// never resets them.
+	Period time.Duration
+}
+
+// logEvent holds the fields of an encoded log event the writers look at.
+type logEvent struct {
+	Level   string `json:"level"`
+	Module  string `json:"module"`
+	Message string `json:"message"`
+}
+
+func parseEvent(p []byte) (logEvent, error) {
+	var event logEvent
+	if err := json.Unmarshal(p, &event); err != nil {
+		return event, fmt.Errorf("failed to unmarshal event: %w", err)
+	}
+	return event, nil
+}
+
+// NewSamplingWriter returns a writer sampling the events written to parent,
+// keyed by their level, module and message, see SamplingConfig.
+func NewSamplingWriter(parent io.Writer, cfg SamplingConfig) io.Writer {
+	return &samplingWriter{
+		parent: parent,
+		cfg:    cfg,
+		counts: make(map[logEvent]int),
+	}
+}
+
+type samplingWriter struct {
+	parent io.Writer
+	cfg    SamplingConfig
+
+	mtx     sync.Mutex
+	counts  map[logEvent]int
+	resetAt time.Time
+}
+
+func (sw *samplingWriter) Write(p []byte) (n int, err error) {
+	event, err := parseEvent(p)
+	if err != nil {
+		return 0, err
+	}
+
+	sw.mtx.Lock()
+	if now := time.Now(); sw.cfg.Period > 0 && !now.Before(sw.resetAt) {
+		sw.counts = make(map[logEvent]int)
+		sw.resetAt = now.Add(sw.cfg.Period)
+	}
+	sw.counts[event]++
+	count := sw.counts[event]
+	sw.mtx.Unlock()
+
+	if count > sw.cfg.Initial && (sw.cfg.Thereafter <= 0 || (count-sw.cfg.Initial)%sw.cfg.Thereafter != 0) {
+		return len(p), nil
+	}
+
+	return sw.parent.Write(p)
+}
+
+// NewRateLimitWriter returns a writer rate limiting the events written to
+// parent with a token bucket: up to burst events can be written at once, and
+// the bucket refills at rate events per second. The events exceeding the rate
+// limit are discarded.
+func NewRateLimitWriter(parent io.Writer, rate float64, burst int) io.Writer {
+	return &rateLimitWriter{
+		parent: parent,
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+type rateLimitWriter struct {
+	parent io.Writer
+	rate   float64
+	burst  float64
+
+	mtx    sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func (rw *rateLimitWriter) Write(p []byte) (n int, err error) {
+	rw.mtx.Lock()
+	now := time.Now()
+	if !rw.last.IsZero() {
+		rw.tokens = math.Min(rw.burst, rw.tokens+now.Sub(rw.last).Seconds()*rw.rate)
+	}
+	rw.last = now
+
+	if rw.tokens < 1 {
+		rw.mtx.Unlock()
+		return len(p), nil
+	}
+	rw.tokens--
+	rw.mtx.Unlock()
+
+	return rw.parent.Write(p)
+}
+
+// Route routes the log events of a module to a distinct output and level.
+type Route struct {
+	// Module is the value of the ModuleKey field of the routed events.
+	Module string
+	// Level is the minimum level of the routed events, the other events of
+	// the module are discarded. zerolog.NoLevel routes all the events.
+	Level zerolog.Level
+	// Output is the destination of the routed events.
+	Output io.Writer
+}
+
+// NewRouteWriter returns a writer writing the events of the modules of the
+// routes to the output of their route, and the other events to parent.
+// The events written to parent are discarded if their level is lower than
+// level, unless it is zerolog.NoLevel.
+func NewRouteWriter(parent io.Writer, level zerolog.Level, routes ...Route) io.Writer {
+	rw := &routeWriter{
+		parent: parent,
+		level:  level,
+		routes: make(map[string]Route, len(routes)),
+	}
+	for _, route := range routes {
+		rw.routes[route.Module] = route
+	}
+	return rw
+}
+
+type routeWriter struct {
+	parent io.Writer
+	level  zerolog.Level
+	routes map[string]Route
+}
+
+func (rw *routeWriter) Write(p []byte) (n int, err error) {
+	event, err := parseEvent(p)
+	if err != nil {
+		return 0, err
+	}
+
+	output, level := rw.parent, rw.level
+	if route, ok := rw.routes[event.Module]; ok {
+		output, level = route.Output, route.Level
+	}
+
+	if level != zerolog.NoLevel {
+		eventLevel, err := zerolog.ParseLevel(event.Level)
+		if err != nil {
+			return 0, err
+		}
+		if eventLevel < level {
+			return len(p), nil
+		}
+	}
+
+	return output.Write(p)
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/rs/zerolog"
 	"gotest.tools/v3/assert"
 
 	"cosmossdk.io/log"
@@ -25,3 +27,70 @@ func TestFilteredWriter(t *testing.T) {
 	logger.Debug("this log line should be filtered", log.ModuleKey, "server")
 	assert.Check(t, buf.Len() == 0)
 }
+
+func TestSamplingWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := log.NewLogger(buf, log.OutputJSONOption(), log.SamplingOption(log.SamplingConfig{Initial: 2, Thereafter: 3}))
+
+	for i := 0; i < 10; i++ {
+		logger.Info("repeated", log.ModuleKey, "p2p", "i", i)
+	}
+	logger.Info("other", log.ModuleKey, "p2p")
+	logger.Info("repeated", log.ModuleKey, "consensus")
+
+	// the first 2 events, then every 3rd one, plus the one of the other module
+	assert.Equal(t, strings.Count(buf.String(), `"message":"repeated"`), 5)
+	for _, i := range []string{`"i":0`, `"i":1`, `"i":4`, `"i":7`} {
+		assert.Check(t, strings.Contains(buf.String(), i), i)
+	}
+	assert.Check(t, strings.Contains(buf.String(), `"message":"other"`))
+	assert.Check(t, strings.Contains(buf.String(), `"module":"consensus"`))
+
+	// counters are reset after each period
+	buf.Reset()
+	logger = log.NewLogger(buf, log.OutputJSONOption(), log.SamplingOption(log.SamplingConfig{Initial: 1, Period: time.Millisecond}))
+	logger.Info("repeated")
+	logger.Info("repeated")
+	time.Sleep(2 * time.Millisecond)
+	logger.Info("repeated")
+	assert.Equal(t, strings.Count(buf.String(), "repeated"), 2)
+}
+
+func TestRateLimitWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := log.NewLogger(buf, log.OutputJSONOption(), log.RateLimitOption(100, 3))
+
+	for i := 0; i < 10; i++ {
+		logger.Info("spam")
+	}
+	assert.Equal(t, strings.Count(buf.String(), "spam"), 3)
+
+	// the bucket refills over time
+	time.Sleep(20 * time.Millisecond)
+	logger.Info("spam")
+	assert.Equal(t, strings.Count(buf.String(), "spam"), 4)
+}
+
+func TestRouteWriter(t *testing.T) {
+	buf, stakingBuf := new(bytes.Buffer), new(bytes.Buffer)
+	logger := log.NewLogger(buf,
+		log.ColorOption(false),
+		log.LevelOption(zerolog.InfoLevel),
+		log.RoutesOption(log.Route{Module: "x/staking", Level: zerolog.DebugLevel, Output: stakingBuf}),
+	)
+
+	logger.Debug("staking debug", log.ModuleKey, "x/staking")
+	logger.Info("staking info", log.ModuleKey, "x/staking")
+	logger.Debug("bank debug", log.ModuleKey, "x/bank")
+	logger.Info("bank info", log.ModuleKey, "x/bank")
+	logger.With(log.ModuleKey, "x/staking").Info("staking with module")
+
+	assert.Check(t, strings.Contains(stakingBuf.String(), "staking debug"))
+	assert.Check(t, strings.Contains(stakingBuf.String(), "staking info"))
+	assert.Check(t, strings.Contains(stakingBuf.String(), "staking with module"))
+	assert.Check(t, !strings.Contains(stakingBuf.String(), "bank"))
+
+	assert.Check(t, strings.Contains(buf.String(), "bank info"))
+	assert.Check(t, !strings.Contains(buf.String(), "bank debug"))
+	assert.Check(t, !strings.Contains(buf.String(), "staking"))
+}
@@ -0,0 +1,420 @@
+package math
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/cockroachdb/apd/v3"
+)
+
+// Dec is an arbitrary-precision decimal number. Unlike LegacyDec, which is a
+// fixed-point number with 18 decimal places, a Dec is a floating-point decimal
+// whose results are rounded to DecPrecision significant digits, with rounding
+// modes chosen by the caller where it matters.
+//
+// The zero value of Dec is 0. Dec values are immutable: all the operations
+// return a new value and never modify their operands.
+type Dec struct {
+	dec apd.Decimal
+}
+
+const (
+	// DecPrecision is the number of significant digits of the results of the
+	// arithmetic operations of Dec. It matches the decimal128 format of IEEE 754.
+	DecPrecision = 34
+
+	// DecMaxExponent is the largest adjusted exponent of a Dec.
+	DecMaxExponent = 100_000
+
+	// DecMinExponent is the smallest adjusted exponent of a Dec.
+	DecMinExponent = -100_000
+
+	// maxIntDigits is the maximum number of decimal digits of an Int, i.e. of a
+	// number of MaxBitLen bits.
+	maxIntDigits = 78
+)
+
+// Dec errors
+var (
+	ErrInvalidDec = errors.New("invalid decimal")
+	ErrInexactDec = errors.New("decimal cannot be represented exactly")
+)
+
+// RoundingMode defines how the result of an operation is rounded when it
+// cannot be represented with DecPrecision significant digits.
+type RoundingMode uint8
+
+const (
+	// RoundHalfEven rounds to the nearest value, ties to the even neighbour
+	// (banker's rounding). It is the default rounding mode of Dec.
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest value, ties away from zero.
+	RoundHalfUp
+	// RoundHalfDown rounds to the nearest value, ties toward zero.
+	RoundHalfDown
+	// RoundDown rounds toward zero (truncation).
+	RoundDown
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+	// RoundCeiling rounds toward positive infinity.
+	RoundCeiling
+)
+
+var roundingModes = map[RoundingMode]struct {
+	name    string
+	rounder apd.Rounder
+}{
+	RoundHalfEven: {"half_even", apd.RoundHalfEven},
+	RoundHalfUp:   {"half_up", apd.RoundHalfUp},
+	RoundHalfDown: {"half_down", apd.RoundHalfDown},
+	RoundDown:     {"down", apd.RoundDown},
+	RoundUp:       {"up", apd.RoundUp},
+	RoundFloor:    {"floor", apd.RoundFloor},
+	RoundCeiling:  {"ceiling", apd.RoundCeiling},
+}
+
+// decContexts holds the apd context of each rounding mode.
+var decContexts = func() map[RoundingMode]*apd.Context {
+	contexts := make(map[RoundingMode]*apd.Context, len(roundingModes))
+	for mode, m := range roundingModes {
+		contexts[mode] = &apd.Context{
+			Precision:   DecPrecision,
+			MaxExponent: DecMaxExponent,
+			MinExponent: DecMinExponent,
+			Traps:       apd.DefaultTraps,
+			Rounding:    m.rounder,
+		}
+	}
+	return contexts
+}()
+
+// String implements fmt.Stringer.
+func (m RoundingMode) String() string {
+	if rm, ok := roundingModes[m]; ok {
+		return rm.name
+	}
+	return fmt.Sprintf("RoundingMode(%d)", m)
+}
+
+// decContext returns the apd context of a rounding mode.
+func decContext(mode RoundingMode) (*apd.Context, error) {
+	c, ok := decContexts[mode]
+	if !ok {
+		return nil, fmt.Errorf("unknown rounding mode %d", mode)
+	}
+	return c, nil
+}
+
+// NewDecFromString parses a decimal string, e.g. "1.5", "-0.001" or "1.5e-7".
+// The value is kept as is, without rounding: an error is returned if it is
+// not finite or if its exponent is out of the range of Dec.
+func NewDecFromString(s string) (Dec, error) {
+	d, _, err := apd.NewFromString(s)
+	if err != nil {
+		return Dec{}, fmt.Errorf("%w: %s", ErrInvalidDec, err)
+	}
+	if d.Form != apd.Finite {
+		return Dec{}, fmt.Errorf("%w: %s is not finite", ErrInvalidDec, s)
+	}
+	if adj := d.Exponent + int32(d.NumDigits()) - 1; adj > DecMaxExponent || adj < DecMinExponent {
+		return Dec{}, fmt.Errorf("%w: exponent of %s out of range", ErrInvalidDec, s)
+	}
+	return Dec{dec: *d}, nil
+}
+
+// NewDecFromInt64 returns the Dec of an int64.
+func NewDecFromInt64(x int64) Dec {
+	var z Dec
+	z.dec.SetInt64(x)
+	return z
+}
+
+// NewDecWithExp returns the Dec coeff × 10^exp, e.g. NewDecWithExp(15, -1)
+// returns 1.5.
+func NewDecWithExp(coeff int64, exp int32) Dec {
+	var z Dec
+	z.dec.SetFinite(coeff, exp)
+	return z
+}
+
+// NewDecFromInt returns the Dec of an Int. The conversion is exact.
+func NewDecFromInt(i Int) Dec {
+	return newDecFromBigInt(i.BigInt(), 0)
+}
+
+// NewDecFromLegacyDec returns the Dec of a LegacyDec. The conversion is exact.
+func NewDecFromLegacyDec(d LegacyDec) Dec {
+	return newDecFromBigInt(d.BigInt(), -LegacyPrecision)
+}
+
+func newDecFromBigInt(coeff *big.Int, exp int32) Dec {
+	var z Dec
+	if coeff == nil {
+		return z
+	}
+	z.dec.Coeff.SetMathBigInt(new(big.Int).Abs(coeff))
+	z.dec.Negative = coeff.Sign() < 0
+	z.dec.Exponent = exp
+	return z
+}
+
+// Add returns x + y, rounded half to even to DecPrecision significant digits.
+func (x Dec) Add(y Dec) (Dec, error) {
+	return x.apply(RoundHalfEven, func(c *apd.Context, z *apd.Decimal) (apd.Condition, error) {
+		return c.Add(z, &x.dec, &y.dec)
+	})
+}
+
+// Sub returns x - y, rounded half to even to DecPrecision significant digits.
+func (x Dec) Sub(y Dec) (Dec, error) {
+	return x.apply(RoundHalfEven, func(c *apd.Context, z *apd.Decimal) (apd.Condition, error) {
+		return c.Sub(z, &x.dec, &y.dec)
+	})
+}
+
+// Mul returns x * y, rounded half to even to DecPrecision significant digits.
+func (x Dec) Mul(y Dec) (Dec, error) {
+	return x.MulWithMode(y, RoundHalfEven)
+}
+
+// MulWithMode returns x * y, rounded to DecPrecision significant digits with
+// the given rounding mode.
+func (x Dec) MulWithMode(y Dec, mode RoundingMode) (Dec, error) {
+	return x.apply(mode, func(c *apd.Context, z *apd.Decimal) (apd.Condition, error) {
+		return c.Mul(z, &x.dec, &y.dec)
+	})
+}
+
+// Quo returns x / y, rounded half to even to DecPrecision significant digits.
+func (x Dec) Quo(y Dec) (Dec, error) {
+	return x.QuoWithMode(y, RoundHalfEven)
+}
+
+// QuoWithMode returns x / y, rounded to DecPrecision significant digits with
+// the given rounding mode, e.g. RoundFloor or RoundCeiling to round a division
+// in favour of one of the parties. ErrDivideByZero is returned if y is zero.
+//
+// Exact quotients keep the decimal places of x minus the ones of y, if any,
+// e.g. 10 / 4 returns 2.5 and 1.50 / 1 returns 1.50.
+func (x Dec) QuoWithMode(y Dec, mode RoundingMode) (Dec, error) {
+	if y.IsZero() {
+		return Dec{}, ErrDivideByZero
+	}
+	return x.apply(mode, func(c *apd.Context, z *apd.Decimal) (apd.Condition, error) {
+		res, err := c.Quo(z, &x.dec, &y.dec)
+		if err != nil || res.Inexact() {
+			return res, err
+		}
+
+		// the quotient is computed with all the digits of the precision, the
+		// trailing zeros are removed down to the ideal exponent
+		z.Reduce(z)
+		if z.IsZero() {
+			return res, nil
+		}
+		shift := int64(z.Exponent) - int64(x.dec.Exponent) + int64(y.dec.Exponent)
+		if shift > DecPrecision-z.NumDigits() {
+			shift = DecPrecision - z.NumDigits()
+		}
+		if shift > 0 {
+			z.Coeff.Mul(&z.Coeff, new(apd.BigInt).Exp(apd.NewBigInt(10), apd.NewBigInt(shift), nil))
+			z.Exponent -= int32(shift)
+		}
+		return res, nil
+	})
+}
+
+// Round returns x rounded to the given number of decimal places with the given
+// rounding mode, e.g. x.Round(2, RoundFloor) rounds 1.239 to 1.23. An error is
+// returned if the result has more than DecPrecision significant digits.
+func (x Dec) Round(places int32, mode RoundingMode) (Dec, error) {
+	return x.apply(mode, func(c *apd.Context, z *apd.Decimal) (apd.Condition, error) {
+		return c.Quantize(z, &x.dec, -places)
+	})
+}
+
+// Sqrt returns the square root of x. The result is correctly rounded half to
+// even to DecPrecision significant digits, i.e. its error is at most half a
+// unit in the last place. An error is returned if x is negative.
+func (x Dec) Sqrt() (Dec, error) {
+	return x.apply(RoundHalfEven, func(c *apd.Context, z *apd.Decimal) (apd.Condition, error) {
+		return c.Sqrt(z, &x.dec)
+	})
+}
+
+// Exp returns e^x. The result is computed with extra working digits and
+// rounded half to even to DecPrecision significant digits, its error is less
+// than one unit in the last place. An error is returned if the result is out
+// of the exponent range of Dec.
+func (x Dec) Exp() (Dec, error) {
+	return x.apply(RoundHalfEven, func(c *apd.Context, z *apd.Decimal) (apd.Condition, error) {
+		return c.Exp(z, &x.dec)
+	})
+}
+
+// Ln returns the natural logarithm of x. The result is computed with extra
+// working digits and rounded half to even to DecPrecision significant digits,
+// its error is less than one unit in the last place. An error is returned if x
+// is not positive.
+func (x Dec) Ln() (Dec, error) {
+	if !x.IsPositive() {
+		return Dec{}, fmt.Errorf("%w: logarithm of non-positive number %s", ErrInvalidDec, x)
+	}
+	return x.apply(RoundHalfEven, func(c *apd.Context, z *apd.Decimal) (apd.Condition, error) {
+		return c.Ln(z, &x.dec)
+	})
+}
+
+// apply returns the result of an apd operation run with the context of the
+// given rounding mode.
+func (x Dec) apply(mode RoundingMode, op func(c *apd.Context, z *apd.Decimal) (apd.Condition, error)) (Dec, error) {
+	c, err := decContext(mode)
+	if err != nil {
+		return Dec{}, err
+	}
+
+	var z Dec
+	if _, err := op(c, &z.dec); err != nil {
+		return Dec{}, fmt.Errorf("%w: %s", ErrInvalidDec, err)
+	}
+	if z.dec.Form != apd.Finite {
+		return Dec{}, fmt.Errorf("%w: result is not finite", ErrInvalidDec)
+	}
+	return z, nil
+}
+
+// Neg returns -x.
+func (x Dec) Neg() Dec {
+	var z Dec
+	z.dec.Neg(&x.dec)
+	return z
+}
+
+// Abs returns |x|.
+func (x Dec) Abs() Dec {
+	var z Dec
+	z.dec.Abs(&x.dec)
+	return z
+}
+
+// Cmp compares x and y and returns -1 if x < y, 0 if x == y and +1 if x > y.
+func (x Dec) Cmp(y Dec) int {
+	return x.dec.Cmp(&y.dec)
+}
+
+// Equal returns whether x and y have the same value, e.g. 1.5 and 1.50 are
+// equal.
+func (x Dec) Equal(y Dec) bool {
+	return x.Cmp(y) == 0
+}
+
+// IsZero returns whether x is zero.
+func (x Dec) IsZero() bool {
+	return x.dec.IsZero()
+}
+
+// IsNegative returns whether x is negative.
+func (x Dec) IsNegative() bool {
+	return x.dec.Sign() < 0
+}
+
+// IsPositive returns whether x is positive.
+func (x Dec) IsPositive() bool {
+	return x.dec.Sign() > 0
+}
+
+// ToInt returns the Int of x. ErrInexactDec is returned if x is not an
+// integer and ErrIntOverflow if x is out of the range of Int.
+func (x Dec) ToInt() (Int, error) {
+	i, err := x.scaledBigInt(0)
+	if err != nil {
+		return Int{}, err
+	}
+	if i.BitLen() > MaxBitLen {
+		return Int{}, ErrIntOverflow
+	}
+	return NewIntFromBigInt(i), nil
+}
+
+// ToInt64 returns the int64 of x. ErrInexactDec is returned if x is not an
+// integer and ErrIntOverflow if x is out of the range of int64.
+func (x Dec) ToInt64() (int64, error) {
+	i, err := x.scaledBigInt(0)
+	if err != nil {
+		return 0, err
+	}
+	if !i.IsInt64() {
+		return 0, ErrIntOverflow
+	}
+	return i.Int64(), nil
+}
+
+// ToLegacyDec returns the LegacyDec of x. ErrInexactDec is returned if x has
+// more than LegacyPrecision decimal places and ErrIntOverflow if x is out of
+// the range of LegacyDec.
+func (x Dec) ToLegacyDec() (LegacyDec, error) {
+	i, err := x.scaledBigInt(LegacyPrecision)
+	if err != nil {
+		return LegacyDec{}, err
+	}
+	if i.BitLen() > maxDecBitLen {
+		return LegacyDec{}, ErrIntOverflow
+	}
+	return LegacyDec{i}, nil
+}
+
+// scaledBigInt returns x × 10^places as a big.Int, or ErrInexactDec if it is
+// not an integer.
+func (x Dec) scaledBigInt(places int32) (*big.Int, error) {
+	var reduced apd.Decimal
+	reduced.Reduce(&x.dec)
+	if reduced.IsZero() {
+		return new(big.Int), nil
+	}
+
+	exp := int64(reduced.Exponent) + int64(places)
+	if exp < 0 {
+		return nil, ErrInexactDec
+	}
+	// avoid computing huge powers of ten which cannot fit in an Int anyway
+	if reduced.NumDigits()+exp > maxIntDigits+LegacyPrecision {
+		return nil, ErrIntOverflow
+	}
+
+	i := reduced.Coeff.MathBigInt()
+	i.Mul(i, new(big.Int).Exp(tenInt, big.NewInt(exp), nil))
+	if reduced.Negative {
+		i.Neg(i)
+	}
+	return i, nil
+}
+
+// String returns the decimal notation of x, without exponent, e.g. "0.000015"
+// for 1.5e-5.
+func (x Dec) String() string {
+	return x.dec.Text('f')
+}
+
+// MarshalJSON implements json.Marshaler, x is encoded as a JSON string.
+func (x Dec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(x.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (x *Dec) UnmarshalJSON(bz []byte) error {
+	var s string
+	if err := json.Unmarshal(bz, &s); err != nil {
+		return err
+	}
+
+	d, err := NewDecFromString(s)
+	if err != nil {
+		return err
+	}
+	*x = d
+	return nil
+}
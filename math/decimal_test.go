@@ -0,0 +1,265 @@
+package math_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func mustNewDecFromString(t *testing.T, s string) math.Dec {
+	t.Helper()
+	d, err := math.NewDecFromString(s)
+	require.NoError(t, err)
+	return d
+}
+
+func TestNewDecFromString(t *testing.T) {
+	testCases := []struct {
+		input  string
+		expStr string
+		expErr bool
+	}{
+		{"0", "0", false},
+		{"-0.5", "-0.5", false},
+		{"1.50", "1.50", false},
+		{"1.5e-7", "0.00000015", false},
+		{"12E3", "12000", false},
+		{"123456789012345678901234567890123456789.123456789", "123456789012345678901234567890123456789.123456789", false},
+		{"", "", true},
+		{"abc", "", true},
+		{"1.2.3", "", true},
+		{"NaN", "", true},
+		{"Infinity", "", true},
+		{"1e100001", "", true},
+		{"1e-100001", "", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			d, err := math.NewDecFromString(tc.input)
+			if tc.expErr {
+				require.ErrorIs(t, err, math.ErrInvalidDec)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expStr, d.String())
+		})
+	}
+}
+
+func TestDecRoundingModes(t *testing.T) {
+	testCases := []struct {
+		input string
+		exp   map[math.RoundingMode]string
+	}{
+		{"2.5", map[math.RoundingMode]string{
+			math.RoundHalfEven: "2", math.RoundHalfUp: "3", math.RoundHalfDown: "2",
+			math.RoundDown: "2", math.RoundUp: "3", math.RoundFloor: "2", math.RoundCeiling: "3",
+		}},
+		{"3.5", map[math.RoundingMode]string{
+			math.RoundHalfEven: "4", math.RoundHalfUp: "4", math.RoundHalfDown: "3",
+			math.RoundDown: "3", math.RoundUp: "4", math.RoundFloor: "3", math.RoundCeiling: "4",
+		}},
+		{"-2.5", map[math.RoundingMode]string{
+			math.RoundHalfEven: "-2", math.RoundHalfUp: "-3", math.RoundHalfDown: "-2",
+			math.RoundDown: "-2", math.RoundUp: "-3", math.RoundFloor: "-3", math.RoundCeiling: "-2",
+		}},
+		{"-2.1", map[math.RoundingMode]string{
+			math.RoundHalfEven: "-2", math.RoundHalfUp: "-2", math.RoundHalfDown: "-2",
+			math.RoundDown: "-2", math.RoundUp: "-3", math.RoundFloor: "-3", math.RoundCeiling: "-2",
+		}},
+	}
+	for _, tc := range testCases {
+		x := mustNewDecFromString(t, tc.input)
+		for mode, exp := range tc.exp {
+			t.Run(tc.input+"/"+mode.String(), func(t *testing.T) {
+				got, err := x.Round(0, mode)
+				require.NoError(t, err)
+				require.Equal(t, exp, got.String())
+			})
+		}
+	}
+
+	// rounding to decimal places
+	got, err := mustNewDecFromString(t, "1.239").Round(2, math.RoundFloor)
+	require.NoError(t, err)
+	require.Equal(t, "1.23", got.String())
+
+	got, err = mustNewDecFromString(t, "1.231").Round(2, math.RoundCeiling)
+	require.NoError(t, err)
+	require.Equal(t, "1.24", got.String())
+
+	// the result cannot have more than DecPrecision digits
+	_, err = mustNewDecFromString(t, "1").Round(40, math.RoundHalfEven)
+	require.Error(t, err)
+
+	_, err = mustNewDecFromString(t, "1").Round(0, math.RoundingMode(100))
+	require.Error(t, err)
+}
+
+func TestDecQuoWithMode(t *testing.T) {
+	one, three := math.NewDecFromInt64(1), math.NewDecFromInt64(3)
+	minusTwo := mustNewDecFromString(t, "2").Neg()
+
+	testCases := []struct {
+		x, y math.Dec
+		mode math.RoundingMode
+		exp  string
+	}{
+		{one, three, math.RoundHalfEven, "0.3333333333333333333333333333333333"},
+		{one, three, math.RoundCeiling, "0.3333333333333333333333333333333334"},
+		{one, three, math.RoundFloor, "0.3333333333333333333333333333333333"},
+		{minusTwo, three, math.RoundHalfEven, "-0.6666666666666666666666666666666667"},
+		{minusTwo, three, math.RoundDown, "-0.6666666666666666666666666666666666"},
+		{minusTwo, three, math.RoundFloor, "-0.6666666666666666666666666666666667"},
+		{minusTwo, three, math.RoundCeiling, "-0.6666666666666666666666666666666666"},
+		{math.NewDecFromInt64(10), math.NewDecFromInt64(4), math.RoundDown, "2.5"},
+		{math.NewDecFromInt64(100), math.NewDecFromInt64(4), math.RoundDown, "25"},
+		{mustNewDecFromString(t, "1.50"), one, math.RoundDown, "1.50"},
+		{math.Dec{}, three, math.RoundDown, "0"},
+		{mustNewDecFromString(t, "1.0000000000000000000000000000000000000000"), one, math.RoundDown, "1.000000000000000000000000000000000"},
+	}
+	for _, tc := range testCases {
+		got, err := tc.x.QuoWithMode(tc.y, tc.mode)
+		require.NoError(t, err)
+		require.Equal(t, tc.exp, got.String(), "%s / %s (%s)", tc.x, tc.y, tc.mode)
+	}
+
+	_, err := one.Quo(math.Dec{})
+	require.ErrorIs(t, err, math.ErrDivideByZero)
+}
+
+func TestDecArithmetic(t *testing.T) {
+	x, y := mustNewDecFromString(t, "1.5"), mustNewDecFromString(t, "-0.25")
+
+	sum, err := x.Add(y)
+	require.NoError(t, err)
+	require.Equal(t, "1.25", sum.String())
+
+	diff, err := x.Sub(y)
+	require.NoError(t, err)
+	require.Equal(t, "1.75", diff.String())
+
+	prod, err := x.Mul(y)
+	require.NoError(t, err)
+	require.Equal(t, "-0.375", prod.String())
+
+	// results are rounded to DecPrecision significant digits
+	large := mustNewDecFromString(t, "1234567890123456789012345678901234")
+	prod, err = large.MulWithMode(mustNewDecFromString(t, "0.11"), math.RoundDown)
+	require.NoError(t, err)
+	require.Equal(t, "135802467913580246791358024679135.7", prod.String())
+
+	// the operands are not modified
+	require.Equal(t, "1.5", x.String())
+	require.Equal(t, "-0.25", y.String())
+
+	require.True(t, mustNewDecFromString(t, "1.50").Equal(x))
+	require.Equal(t, 1, x.Cmp(y))
+	require.Equal(t, -1, y.Cmp(x))
+	require.True(t, y.IsNegative())
+	require.True(t, x.IsPositive())
+	require.Equal(t, "0.25", y.Abs().String())
+	require.Equal(t, "-1.5", x.Neg().String())
+	require.True(t, math.Dec{}.IsZero())
+	require.Equal(t, "1.5", math.NewDecWithExp(15, -1).String())
+}
+
+func TestDecTranscendental(t *testing.T) {
+	one := math.NewDecFromInt64(1)
+	two := math.NewDecFromInt64(2)
+
+	e, err := one.Exp()
+	require.NoError(t, err)
+	require.Equal(t, "2.718281828459045235360287471352662", e.String())
+
+	ln2, err := two.Ln()
+	require.NoError(t, err)
+	require.Equal(t, "0.6931471805599453094172321214581766", ln2.String())
+
+	sqrt2, err := two.Sqrt()
+	require.NoError(t, err)
+	require.Equal(t, "1.414213562373095048801688724209698", sqrt2.String())
+
+	sqrt, err := math.NewDecFromInt64(144).Sqrt()
+	require.NoError(t, err)
+	require.True(t, sqrt.Equal(math.NewDecFromInt64(12)))
+
+	zero, err := one.Ln()
+	require.NoError(t, err)
+	require.True(t, zero.IsZero())
+
+	_, err = math.Dec{}.Ln()
+	require.ErrorIs(t, err, math.ErrInvalidDec)
+	_, err = one.Neg().Ln()
+	require.ErrorIs(t, err, math.ErrInvalidDec)
+	_, err = one.Neg().Sqrt()
+	require.ErrorIs(t, err, math.ErrInvalidDec)
+	_, err = math.NewDecFromInt64(1_000_000).Exp()
+	require.ErrorIs(t, err, math.ErrInvalidDec)
+}
+
+func TestDecConversions(t *testing.T) {
+	// Int
+	i, err := mustNewDecFromString(t, "-12.000").ToInt()
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(-12), i)
+
+	i, err = mustNewDecFromString(t, "1.2e3").ToInt()
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1200), i)
+
+	_, err = mustNewDecFromString(t, "1.5").ToInt()
+	require.ErrorIs(t, err, math.ErrInexactDec)
+
+	_, err = mustNewDecFromString(t, "1e80").ToInt()
+	require.ErrorIs(t, err, math.ErrIntOverflow)
+
+	_, err = mustNewDecFromString(t, "1e100000").ToInt()
+	require.ErrorIs(t, err, math.ErrIntOverflow)
+
+	maxInt := math.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), math.MaxBitLen), big.NewInt(1)))
+	i, err = math.NewDecFromInt(maxInt).ToInt()
+	require.NoError(t, err)
+	require.Equal(t, maxInt, i)
+
+	// int64
+	i64, err := mustNewDecFromString(t, "-9223372036854775808").ToInt64()
+	require.NoError(t, err)
+	require.Equal(t, int64(-9223372036854775808), i64)
+
+	_, err = mustNewDecFromString(t, "9223372036854775808").ToInt64()
+	require.ErrorIs(t, err, math.ErrIntOverflow)
+
+	// LegacyDec
+	ld, err := mustNewDecFromString(t, "-1.000000000000000001").ToLegacyDec()
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("-1.000000000000000001"), ld)
+
+	_, err = mustNewDecFromString(t, "1.0000000000000000001").ToLegacyDec()
+	require.ErrorIs(t, err, math.ErrInexactDec)
+
+	_, err = mustNewDecFromString(t, "1e80").ToLegacyDec()
+	require.ErrorIs(t, err, math.ErrIntOverflow)
+
+	d := math.NewDecFromLegacyDec(math.LegacyMustNewDecFromStr("123.450000000000000000"))
+	require.True(t, d.Equal(mustNewDecFromString(t, "123.45")))
+	require.Equal(t, "0", math.NewDecFromLegacyDec(math.LegacyDec{}).String())
+}
+
+func TestDecJSON(t *testing.T) {
+	d := mustNewDecFromString(t, "-1.5e-3")
+	bz, err := json.Marshal(d)
+	require.NoError(t, err)
+	require.Equal(t, `"-0.0015"`, string(bz))
+
+	var got math.Dec
+	require.NoError(t, json.Unmarshal(bz, &got))
+	require.True(t, got.Equal(d))
+
+	require.Error(t, json.Unmarshal([]byte(`"abc"`), &got))
+	require.Error(t, json.Unmarshal([]byte(`1.5`), &got))
+}
@@ -1,7 +1,10 @@
 package math
 
 import (
+	"math"
 	"testing"
+
+	"github.com/cockroachdb/apd/v3"
 )
 
 func FuzzLegacyNewDecFromStr(f *testing.F) {
@@ -22,3 +25,197 @@ func FuzzLegacyNewDecFromStr(f *testing.F) {
 		}
 	})
 }
+
+func FuzzNewDecFromString(f *testing.F) {
+	if testing.Short() {
+		f.Skip("running in -short mode")
+	}
+
+	f.Add("-123.456")
+	f.Add("1.5e-7")
+	f.Add("12E3")
+	f.Add("0.000")
+	f.Add("NaN")
+
+	f.Fuzz(func(t *testing.T, input string) {
+		d, err := NewDecFromString(input)
+		if err != nil {
+			return
+		}
+
+		// the string representation parses back to the same value
+		got, err := NewDecFromString(d.String())
+		if err != nil || !got.Equal(d) {
+			t.Fatalf("%q: %s does not round trip: %v", input, d, err)
+		}
+	})
+}
+
+func FuzzDecLegacyDecRoundTrip(f *testing.F) {
+	if testing.Short() {
+		f.Skip("running in -short mode")
+	}
+
+	f.Add(int64(-123456), int64(3))
+	f.Add(int64(1), int64(18))
+	f.Add(int64(math.MaxInt64), int64(0))
+
+	f.Fuzz(func(t *testing.T, i, prec int64) {
+		if prec < 0 || prec > LegacyPrecision {
+			return
+		}
+		legacy := LegacyNewDecWithPrec(i, prec)
+
+		got, err := NewDecFromLegacyDec(legacy).ToLegacyDec()
+		if err != nil || !got.Equal(legacy) {
+			t.Fatalf("%s does not round trip: %s, %v", legacy, got, err)
+		}
+	})
+}
+
+func FuzzDecQuoWithMode(f *testing.F) {
+	if testing.Short() {
+		f.Skip("running in -short mode")
+	}
+
+	f.Add(int64(1), int64(3))
+	f.Add(int64(-2), int64(3))
+	f.Add(int64(10), int64(4))
+	f.Add(int64(math.MaxInt64), int64(-7))
+
+	f.Fuzz(func(t *testing.T, a, b int64) {
+		if b == 0 {
+			return
+		}
+		x, y := NewDecFromInt64(a), NewDecFromInt64(b)
+
+		floor, err := x.QuoWithMode(y, RoundFloor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ceil, err := x.QuoWithMode(y, RoundCeiling)
+		if err != nil {
+			t.Fatal(err)
+		}
+		halfEven, err := x.Quo(y)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// floor <= x / y <= ceil, with at most one unit in the last place
+		// between floor and ceil, and the half even quotient is one of them
+		exact := referenceQuo(t, x, y)
+		if floor.dec.Cmp(exact) > 0 || ceil.dec.Cmp(exact) < 0 {
+			t.Fatalf("%d / %d: %s is not between %s and %s", a, b, exact, floor, ceil)
+		}
+		if ulps := ulpDistance(t, floor, &ceil.dec); ulps.Cmp(apd.New(1, 0)) > 0 {
+			t.Fatalf("%d / %d: %s and %s are %s ulps apart", a, b, floor, ceil, ulps)
+		}
+		if !halfEven.Equal(floor) && !halfEven.Equal(ceil) {
+			t.Fatalf("%d / %d: %s is neither %s nor %s", a, b, halfEven, floor, ceil)
+		}
+	})
+}
+
+func FuzzDecTranscendental(f *testing.F) {
+	if testing.Short() {
+		f.Skip("running in -short mode")
+	}
+
+	f.Add(int64(1), int32(0))
+	f.Add(int64(2), int32(0))
+	f.Add(int64(-12345), int32(-3))
+	f.Add(int64(987654321), int32(-20))
+
+	f.Fuzz(func(t *testing.T, coeff int64, exp int32) {
+		if exp < -40 || exp > 5 {
+			return
+		}
+		x := NewDecWithExp(coeff, exp)
+		ref := referenceContext()
+
+		// sqrt is correctly rounded
+		if sqrt, err := x.Abs().Sqrt(); err != nil {
+			t.Fatal(err)
+		} else {
+			var want apd.Decimal
+			xAbs := x.Abs()
+			if _, err := ref.Sqrt(&want, &xAbs.dec); err != nil {
+				t.Fatal(err)
+			}
+			if ulps := ulpDistance(t, sqrt, &want); ulps.Cmp(apd.New(5, -1)) > 0 {
+				t.Fatalf("sqrt(%s) = %s is %s ulps away from %s", x, sqrt, ulps, &want)
+			}
+		}
+
+		// exp is within one unit in the last place, when in range
+		var want apd.Decimal
+		if _, err := ref.Exp(&want, &x.dec); err == nil && want.Exponent+int32(want.NumDigits()) > DecMinExponent+DecPrecision &&
+			want.Exponent+int32(want.NumDigits()) < DecMaxExponent {
+			got, err := x.Exp()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ulps := ulpDistance(t, got, &want); ulps.Cmp(apd.New(1, 0)) > 0 {
+				t.Fatalf("exp(%s) = %s is %s ulps away from %s", x, got, ulps, &want)
+			}
+		}
+
+		// ln is within one unit in the last place
+		if x.IsPositive() {
+			if _, err := ref.Ln(&want, &x.dec); err != nil {
+				t.Fatal(err)
+			}
+			got, err := x.Ln()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ulps := ulpDistance(t, got, &want); ulps.Cmp(apd.New(1, 0)) > 0 {
+				t.Fatalf("ln(%s) = %s is %s ulps away from %s", x, got, ulps, &want)
+			}
+		}
+	})
+}
+
+// referenceContext returns an apd context with twice the precision of Dec, used
+// to compute the reference results of the property tests.
+func referenceContext() *apd.Context {
+	return &apd.Context{
+		Precision:   2 * DecPrecision,
+		MaxExponent: apd.MaxExponent,
+		MinExponent: apd.MinExponent,
+		Traps:       apd.DefaultTraps,
+		Rounding:    apd.RoundHalfEven,
+	}
+}
+
+func referenceQuo(t *testing.T, x, y Dec) *apd.Decimal {
+	t.Helper()
+	var z apd.Decimal
+	if _, err := referenceContext().Quo(&z, &x.dec, &y.dec); err != nil {
+		t.Fatal(err)
+	}
+	return &z
+}
+
+// ulpDistance returns |got - want| in units in the last place of got, i.e. of
+// the DecPrecision-th significant digit of got.
+func ulpDistance(t *testing.T, got Dec, want *apd.Decimal) *apd.Decimal {
+	t.Helper()
+	ref := referenceContext()
+
+	var diff, ulps apd.Decimal
+	if _, err := ref.Sub(&diff, &got.dec, want); err != nil {
+		t.Fatal(err)
+	}
+	diff.Abs(&diff)
+	if got.IsZero() {
+		return &diff
+	}
+
+	ulpExp := got.dec.Exponent + int32(got.dec.NumDigits()) - DecPrecision
+	if _, err := ref.Quo(&ulps, &diff, apd.New(1, ulpExp)); err != nil {
+		t.Fatal(err)
+	}
+	return &ulps
+}
@@ -6,6 +6,7 @@ import (
 	"slices"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 
 	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
 	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
@@ -17,6 +18,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"github.com/cosmos/cosmos-sdk/client/grpc/collectionsservice"
+	"github.com/cosmos/cosmos-sdk/client/grpc/mempoolservice"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -207,6 +210,12 @@ func (a *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 	// Register node gRPC service for grpc-gateway.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register mempool gRPC service for grpc-gateway.
+	mempoolservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
+	// Register collections gRPC service for grpc-gateway.
+	collectionsservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
 	// Register grpc-gateway routes for all modules.
 	a.ModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 }
@@ -230,6 +239,15 @@ func (a *App) RegisterTendermintService(clientCtx client.Context) {
 // RegisterNodeService registers the node gRPC service on the app gRPC router.
 func (a *App) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
 	nodeservice.RegisterNodeService(clientCtx, a.GRPCQueryRouter(), cfg)
+	collectionsservice.RegisterCollectionsService(a.GRPCQueryRouter(), a.ModuleManager.Modules)
+}
+
+// RegisterGRPCServer registers the app gRPC services on the node gRPC server,
+// along with the mempool gRPC service. The latter is registered on the server
+// only, s.t. the local mempool is not exposed through ABCI queries.
+func (a *App) RegisterGRPCServer(server gogogrpc.Server) {
+	a.BaseApp.RegisterGRPCServer(server)
+	mempoolservice.RegisterMempoolService(server, a.Mempool(), a.TxEncode)
 }
 
 // Configurator returns the app's configurator.
@@ -25,6 +25,8 @@ import (
 	"cosmossdk.io/x/tx/signing"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/grpc/collectionsservice"
+	"github.com/cosmos/cosmos-sdk/client/grpc/mempoolservice"
 	"github.com/cosmos/cosmos-sdk/codec"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -70,6 +72,8 @@ func (m appModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						},
 					},
 				},
+				"mempool":     mempoolservice.MempoolAutoCLIDescriptor,
+				"collections": collectionsservice.CollectionsAutoCLIDescriptor,
 			},
 		},
 	}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -162,6 +163,47 @@ type MempoolConfig struct {
 	MaxTxs int `mapstructure:"max-txs"`
 }
 
+// LogConfig defines the sampling, rate limiting and per-module routing of the
+// node logs.
+type LogConfig struct {
+	// SamplingInitial is the number of log events with a given level, module and
+	// message written in each sampling period before sampling them. Zero
+	// disables sampling.
+	SamplingInitial int `mapstructure:"sampling-initial"`
+
+	// SamplingThereafter is the sampling rate of the log events once
+	// SamplingInitial of them have been written in the sampling period. Zero
+	// discards them all.
+	SamplingThereafter int `mapstructure:"sampling-thereafter"`
+
+	// SamplingPeriod is the duration of a sampling period. Zero never resets the
+	// sampling counters.
+	SamplingPeriod time.Duration `mapstructure:"sampling-period"`
+
+	// RateLimit is the maximum number of log events written per second. Zero
+	// disables rate limiting.
+	RateLimit float64 `mapstructure:"rate-limit"`
+
+	// RateLimitBurst is the maximum number of log events written at once.
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+
+	// Routes route the log events of modules to distinct outputs and levels.
+	Routes []LogRouteConfig `mapstructure:"routes"`
+}
+
+// LogRouteConfig defines the output and level of the log events of a module.
+type LogRouteConfig struct {
+	// Module is the module of the routed log events, e.g. x/staking.
+	Module string `mapstructure:"module"`
+
+	// Level is the minimum level of the routed log events.
+	Level string `mapstructure:"level"`
+
+	// Output is either stdout, stderr or the path of a file, relative to the
+	// node home directory.
+	Output string `mapstructure:"output"`
+}
+
 // State Streaming configuration
 type (
 	// StreamingConfig defines application configuration for external streaming services
@@ -187,6 +229,7 @@ type Config struct {
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
 	Streaming StreamingConfig  `mapstructure:"streaming"`
 	Mempool   MempoolConfig    `mapstructure:"mempool"`
+	Log       LogConfig        `mapstructure:"log"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -225,8 +268,10 @@ func DefaultConfig() *Config {
 			AppDBBackend:        "",
 		},
 		Telemetry: telemetry.Config{
-			Enabled:      false,
-			GlobalLabels: [][]string{},
+			Enabled:          false,
+			GlobalLabels:     [][]string{},
+			HistogramBuckets: []float64{},
+			TraceSampleRatio: 1,
 		},
 		API: APIConfig{
 			Enable:             false,
@@ -255,6 +300,10 @@ func DefaultConfig() *Config {
 		Mempool: MempoolConfig{
 			MaxTxs: -1,
 		},
+		Log: LogConfig{
+			SamplingPeriod: time.Second,
+			RateLimitBurst: 100,
+		},
 	}
 }
 
@@ -132,6 +132,46 @@ statsd-addr = "{{ .Telemetry.StatsdAddr }}"
 # Datadog. Only utilized if MetricsSink is set to "dogstatsd".
 datadog-hostname = "{{ .Telemetry.DatadogHostname }}"
 
+# OTLPMetricsEndpoint, when set, enables an OpenTelemetry metrics sink exporting
+# the metrics to this OTLP gRPC endpoint, e.g. "localhost:4317". It is used in
+# addition to the metrics sink and the Prometheus sink, if enabled.
+otlp-metrics-endpoint = "{{ .Telemetry.OTLPMetricsEndpoint }}"
+
+# OTLPMetricsInsecure disables the transport security of the OTLP gRPC connection.
+otlp-metrics-insecure = {{ .Telemetry.OTLPMetricsInsecure }}
+
+# OTLPMetricsInterval defines the interval in seconds of the exports of the
+# OpenTelemetry metrics sink. Defaults to 10 seconds.
+otlp-metrics-interval = {{ .Telemetry.OTLPMetricsInterval }}
+
+# HistogramBuckets defines the bucket boundaries of the histograms of the
+# OpenTelemetry metrics sink, e.g. of the durations measured in milliseconds.
+# The default boundaries of OpenTelemetry are used if empty.
+#
+# Example:
+# [1, 5, 10, 50, 100, 500, 1000]
+histogram-buckets = [{{ range $i, $v := .Telemetry.HistogramBuckets }}{{ if $i }}, {{ end }}{{ $v }}{{ end }}]
+
+# TraceExporter defines the OpenTelemetry exporter of the traces of the
+# application. Tracing is disabled when empty.
+# Supported exporters are "otlp" and "file".
+trace-exporter = "{{ .Telemetry.TraceExporter }}"
+
+# TraceEndpoint defines the OTLP gRPC endpoint to export traces to, e.g. "localhost:4317".
+# Only utilized if TraceExporter is set to "otlp".
+trace-endpoint = "{{ .Telemetry.TraceEndpoint }}"
+
+# TraceInsecure disables the transport security of the OTLP gRPC connection.
+# Only utilized if TraceExporter is set to "otlp".
+trace-insecure = {{ .Telemetry.TraceInsecure }}
+
+# TraceFile defines the file the traces are written to, as JSON, for offline analysis.
+# Only utilized if TraceExporter is set to "file".
+trace-file = "{{ .Telemetry.TraceFile }}"
+
+# TraceSampleRatio defines the ratio of the traces that are sampled, between 0 and 1.
+trace-sample-ratio = {{ .Telemetry.TraceSampleRatio }}
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -235,6 +275,49 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = {{ .Mempool.MaxTxs }}
+
+###############################################################################
+###                                 Log                                     ###
+###############################################################################
+
+# The log level and format are set by log_level and log_format in config.toml.
+[log]
+
+# sampling-initial is the number of log events with a given level, module and message
+# written in each sampling period before sampling them (0 to disable sampling).
+sampling-initial = {{ .Log.SamplingInitial }}
+
+# sampling-thereafter is the sampling rate of the log events once sampling-initial of them
+# have been written in the sampling period, e.g. 100 writes every 100th (0 to discard them all).
+sampling-thereafter = {{ .Log.SamplingThereafter }}
+
+# sampling-period is the duration after which the sampling counters are reset (0s to never reset them).
+sampling-period = "{{ .Log.SamplingPeriod }}"
+
+# rate-limit is the maximum number of log events written per second (0 to disable rate limiting).
+rate-limit = {{ .Log.RateLimit }}
+
+# rate-limit-burst is the maximum number of log events written at once when rate limiting.
+rate-limit-burst = {{ .Log.RateLimitBurst }}
+
+# Sampling and rate limiting apply to each log output separately.
+#
+# log.routes route the log events of modules to distinct outputs and levels. The output
+# is either stdout, stderr or the path of a file, relative to the node home directory.
+# log_level doesn't apply to the routed modules.
+#
+# Example:
+# [[log.routes]]
+# module = "x/staking"
+# level = "debug"
+# output = "staking.log"
+{{- range .Log.Routes }}
+
+[[log.routes]]
+module = "{{ .Module }}"
+level = "{{ .Level }}"
+output = "{{ .Output }}"
+{{- end }}
 `
 
 var configTemplate *template.Template
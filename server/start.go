@@ -7,6 +7,7 @@ import (
 	"io"
 	"net"
 	"os"
+	"path/filepath"
 	"runtime/pprof"
 	"strings"
 	"time"
@@ -227,6 +228,15 @@ func start[T types.Application](svrCtx *Context, clientCtx client.Context, appCr
 	if err != nil {
 		return err
 	}
+	if metrics != nil {
+		defer metrics.Shutdown()
+	}
+
+	shutdownTracing, err := startTracing(svrCtx, svrCfg)
+	if err != nil {
+		return err
+	}
+	defer shutdownTracing()
 
 	emitServerInfoMetrics()
 
@@ -535,6 +545,29 @@ func startTelemetry(cfg serverconfig.Config) (*telemetry.Metrics, error) {
 	return telemetry.New(cfg.Telemetry)
 }
 
+// startTracing enables the OpenTelemetry tracing of the application if a trace
+// exporter is configured. The returned function flushes the pending traces.
+func startTracing(svrCtx *Context, cfg serverconfig.Config) (func(), error) {
+	telemetryCfg := cfg.Telemetry
+	if telemetryCfg.TraceFile != "" && !filepath.IsAbs(telemetryCfg.TraceFile) {
+		telemetryCfg.TraceFile = filepath.Join(svrCtx.Config.RootDir, telemetryCfg.TraceFile)
+	}
+
+	shutdown, err := telemetry.EnableTracing(context.Background(), telemetryCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enable tracing: %w", err)
+	}
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := shutdown(ctx); err != nil {
+			svrCtx.Logger.Error("failed to shutdown tracing", "error", err)
+		}
+	}, nil
+}
+
 // wrapCPUProfile starts CPU profiling, if enabled, and executes the provided
 // callbackFn in a separate goroutine, then will wait for that callback to
 // return.
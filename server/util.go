@@ -178,6 +178,12 @@ func CreateSDKLogger(ctx *Context, out io.Writer) (log.Logger, error) {
 		// We use CometBFT flag (cmtcli.TraceFlag) for trace logging.
 		log.TraceOption(ctx.Viper.GetBool(FlagTrace)))
 
+	logOpts, err := logConfigOptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, logOpts...)
+
 	// check and set filter level or keys for the logger if any
 	logLvlStr := ctx.Viper.GetString(flags.FlagLogLevel)
 	if logLvlStr == "" {
@@ -201,6 +207,71 @@ func CreateSDKLogger(ctx *Context, out io.Writer) (log.Logger, error) {
 	return log.NewLogger(out, opts...), nil
 }
 
+// logConfigOptions returns the logger options for the sampling, rate limiting
+// and routes of the log section of app.toml.
+func logConfigOptions(ctx *Context) ([]log.Option, error) {
+	var logCfg config.LogConfig
+	if err := ctx.Viper.UnmarshalKey("log", &logCfg); err != nil {
+		return nil, fmt.Errorf("failed to parse log config: %w", err)
+	}
+
+	var opts []log.Option
+	if logCfg.SamplingInitial > 0 {
+		opts = append(opts, log.SamplingOption(log.SamplingConfig{
+			Initial:    logCfg.SamplingInitial,
+			Thereafter: logCfg.SamplingThereafter,
+			Period:     logCfg.SamplingPeriod,
+		}))
+	}
+
+	if logCfg.RateLimit > 0 {
+		opts = append(opts, log.RateLimitOption(logCfg.RateLimit, logCfg.RateLimitBurst))
+	}
+
+	routes := make([]log.Route, 0, len(logCfg.Routes))
+	for _, route := range logCfg.Routes {
+		if route.Module == "" {
+			return nil, errors.New("log route module cannot be empty")
+		}
+
+		level, err := zerolog.ParseLevel(route.Level)
+		if err != nil {
+			return nil, fmt.Errorf("invalid level of log route %s: %w", route.Module, err)
+		}
+
+		var output io.Writer
+		switch route.Output {
+		case "stdout":
+			output = os.Stdout
+		case "stderr":
+			output = os.Stderr
+		case "":
+			return nil, fmt.Errorf("log route %s output cannot be empty", route.Module)
+		default:
+			path := route.Output
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(ctx.Viper.GetString(flags.FlagHome), path)
+			}
+			if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+				return nil, err
+			}
+
+			// the file is closed when the process exits
+			output, err = os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open log route %s output: %w", route.Module, err)
+			}
+		}
+
+		routes = append(routes, log.Route{Module: route.Module, Level: level, Output: output})
+	}
+	if len(routes) > 0 {
+		opts = append(opts, log.RoutesOption(routes...))
+	}
+
+	return opts, nil
+}
+
 // GetServerContextFromCmd returns a Context from a command or an empty Context
 // if it has not been set.
 func GetServerContextFromCmd(cmd *cobra.Command) *Context {
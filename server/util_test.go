@@ -1,6 +1,7 @@
 package server_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -486,6 +487,35 @@ func TestEmptyMinGasPrices(t *testing.T) {
 	require.Errorf(t, err, sdkerrors.ErrAppConfig.Error())
 }
 
+func TestCreateSDKLoggerRoutes(t *testing.T) {
+	tempDir := t.TempDir()
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Viper.Set(flags.FlagHome, tempDir)
+	serverCtx.Viper.Set(flags.FlagLogLevel, "info")
+	serverCtx.Viper.Set("log.routes", []map[string]interface{}{
+		{"module": "consensus", "level": "debug", "output": "consensus.log"},
+	})
+
+	var out bytes.Buffer
+	logger, err := server.CreateSDKLogger(serverCtx, &out)
+	require.NoError(t, err)
+
+	logger.Debug("block proposed", "module", "consensus")
+	logger.Debug("tx received", "module", "mempool")
+	logger.Info("app started")
+
+	bz, err := os.ReadFile(filepath.Join(tempDir, "consensus.log"))
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "block proposed")
+	require.NotContains(t, out.String(), "block proposed")
+	require.NotContains(t, out.String(), "tx received")
+	require.Contains(t, out.String(), "app started")
+
+	serverCtx.Viper.Set("log.routes", []map[string]interface{}{{"module": "consensus", "level": "debug"}})
+	_, err = server.CreateSDKLogger(serverCtx, &out)
+	require.ErrorContains(t, err, "output cannot be empty")
+}
+
 type mapGetter map[string]interface{}
 
 func (m mapGetter) Get(key string) interface{} {
@@ -12,6 +12,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cast"
 
@@ -24,6 +25,8 @@ import (
 	"cosmossdk.io/x/accounts/accountstd"
 	baseaccount "cosmossdk.io/x/accounts/defaults/base"
 	lockup "cosmossdk.io/x/accounts/defaults/lockup"
+	multisigaccount "cosmossdk.io/x/accounts/defaults/multisig"
+	recoveryaccount "cosmossdk.io/x/accounts/defaults/recovery"
 	"cosmossdk.io/x/accounts/testing/account_abstraction"
 	"cosmossdk.io/x/accounts/testing/counter"
 	"cosmossdk.io/x/auth"
@@ -90,6 +93,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"github.com/cosmos/cosmos-sdk/client/grpc/collectionsservice"
+	"github.com/cosmos/cosmos-sdk/client/grpc/mempoolservice"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -301,7 +306,24 @@ func NewSimApp(
 		accountstd.AddAccount(lockup.DELAYED_LOCKING_ACCOUNT, lockup.NewDelayedLockingAccount),
 		accountstd.AddAccount(lockup.PERMANENT_LOCKING_ACCOUNT, lockup.NewPermanentLockingAccount),
 		// PRODUCTION: add
-		baseaccount.NewAccount("base", txConfig.SignModeHandler()),
+		baseaccount.NewAccount("base", txConfig.SignModeHandler(),
+			baseaccount.WithSecp256K1PubKey(),
+			baseaccount.WithSecp256R1PubKey(),
+			baseaccount.WithEd25519PubKey(),
+			baseaccount.WithWebAuthnPubKey(),
+		),
+		multisigaccount.NewAccount("multisig", txConfig.SignModeHandler(),
+			baseaccount.WithSecp256K1PubKey(),
+			baseaccount.WithSecp256R1PubKey(),
+			baseaccount.WithEd25519PubKey(),
+			baseaccount.WithWebAuthnPubKey(),
+		),
+		recoveryaccount.NewAccount("recovery", txConfig.SignModeHandler(),
+			baseaccount.WithSecp256K1PubKey(),
+			baseaccount.WithSecp256R1PubKey(),
+			baseaccount.WithEd25519PubKey(),
+			baseaccount.WithWebAuthnPubKey(),
+		),
 	)
 	if err != nil {
 		panic(err)
@@ -750,6 +772,12 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	// Register node gRPC service for grpc-gateway.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register mempool gRPC service for grpc-gateway.
+	mempoolservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
+	// Register collections gRPC service for grpc-gateway.
+	collectionsservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
 	// Register grpc-gateway routes for all modules.
 	app.ModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
@@ -777,6 +805,14 @@ func (app *SimApp) RegisterTendermintService(clientCtx client.Context) {
 
 func (app *SimApp) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
+	collectionsservice.RegisterCollectionsService(app.GRPCQueryRouter(), app.ModuleManager.Modules)
+}
+
+// RegisterGRPCServer registers the app gRPC services on the node gRPC server,
+// along with the mempool gRPC service, which is not exposed through ABCI queries.
+func (app *SimApp) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(server)
+	mempoolservice.RegisterMempoolService(server, app.Mempool(), app.txConfig.TxEncoder())
 }
 
 // GetMaccPerms returns a copy of the module account permissions
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
@@ -35,6 +36,8 @@ import (
 	"cosmossdk.io/x/upgrade"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
@@ -357,3 +360,15 @@ func TestAddressCodecFactory(t *testing.T) {
 	_, ok = consAddressCodec.(customAddressCodec)
 	require.True(t, ok)
 }
+
+// TestMempoolServiceRegistration tests that the mempool service is served by the
+// node gRPC server but not routed through ABCI queries.
+func TestMempoolServiceRegistration(t *testing.T) {
+	app := Setup(t, false)
+	app.RegisterNodeService(client.Context{}, config.Config{})
+	require.Nil(t, app.GRPCQueryRouter().Route("/cosmos.base.mempool.v1beta1.Service/Txs"))
+
+	grpcSrv := grpc.NewServer()
+	app.RegisterGRPCServer(grpcSrv)
+	require.Contains(t, grpcSrv.GetServiceInfo(), "cosmos.base.mempool.v1beta1.Service")
+}
@@ -0,0 +1,136 @@
+package branch
+
+import (
+	"bytes"
+
+	corestore "cosmossdk.io/core/store"
+)
+
+var _ corestore.Iterator = (*iterator)(nil)
+
+// iterator merges the iterator of the parent with the writes of a Store, the
+// writes taking precedence over the keys of the parent.
+type iterator struct {
+	parent corestore.Iterator
+	// changes are the writes of the domain, in order of iteration
+	changes []change
+	// removed returns true if a key of the parent is removed by a range deletion
+	removed func(key []byte) bool
+
+	start, end []byte
+	reverse    bool
+
+	key, value []byte
+	// fromParent and fromChanges report the sources the current key is read from
+	fromParent, fromChanges bool
+	valid                   bool
+}
+
+func newIterator(
+	parent corestore.Iterator,
+	changes []change,
+	removed func(key []byte) bool,
+	start, end []byte,
+	reverse bool,
+) *iterator {
+	itr := &iterator{
+		parent:  parent,
+		changes: changes,
+		removed: removed,
+		start:   start,
+		end:     end,
+		reverse: reverse,
+	}
+	itr.seek()
+
+	return itr
+}
+
+func (itr *iterator) Domain() (start, end []byte) {
+	return itr.start, itr.end
+}
+
+func (itr *iterator) Valid() bool {
+	return itr.valid
+}
+
+func (itr *iterator) Next() {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+
+	if itr.fromParent {
+		itr.parent.Next()
+	}
+	if itr.fromChanges {
+		itr.changes = itr.changes[1:]
+	}
+	itr.seek()
+}
+
+func (itr *iterator) Key() []byte {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+
+	return bytes.Clone(itr.key)
+}
+
+func (itr *iterator) Value() []byte {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+
+	return bytes.Clone(itr.value)
+}
+
+func (itr *iterator) Error() error {
+	return itr.parent.Error()
+}
+
+func (itr *iterator) Close() error {
+	itr.valid = false
+	return itr.parent.Close()
+}
+
+// seek moves the iterator to the next key which is not removed, from either the
+// parent or the writes.
+func (itr *iterator) seek() {
+	for {
+		for itr.parent.Valid() && itr.removed(itr.parent.Key()) {
+			itr.parent.Next()
+		}
+
+		parentValid, changesValid := itr.parent.Valid(), len(itr.changes) > 0
+		if !parentValid && !changesValid {
+			itr.valid = false
+			return
+		}
+
+		itr.fromParent, itr.fromChanges = parentValid, changesValid
+		if parentValid && changesValid {
+			cmp := bytes.Compare(itr.parent.Key(), itr.changes[0].key)
+			if itr.reverse {
+				cmp = -cmp
+			}
+			itr.fromParent, itr.fromChanges = cmp <= 0, cmp >= 0
+		}
+
+		if !itr.fromChanges {
+			itr.key, itr.value, itr.valid = itr.parent.Key(), itr.parent.Value(), true
+			return
+		}
+
+		c := itr.changes[0]
+		if !c.remove {
+			itr.key, itr.value, itr.valid = c.key, c.value, true
+			return
+		}
+
+		// the key is removed, skip it in both sources
+		if itr.fromParent {
+			itr.parent.Next()
+		}
+		itr.changes = itr.changes[1:]
+	}
+}
@@ -0,0 +1,248 @@
+package branch
+
+import (
+	"bytes"
+
+	"github.com/google/btree"
+
+	corestore "cosmossdk.io/core/store"
+	storeerrors "cosmossdk.io/store/v2/errors"
+)
+
+// The approximate number of items and children per B-tree node.
+const bTreeDegree = 32
+
+var (
+	_ corestore.Writer       = (*Store)(nil)
+	_ corestore.RangeDeleter = (*Store)(nil)
+)
+
+// change is a write kept by a Store, ordered by key.
+type change struct {
+	key    []byte
+	value  []byte
+	remove bool
+}
+
+// Less implements btree.Item.
+func (c change) Less(other btree.Item) bool {
+	return bytes.Compare(c.key, other.(change).key) < 0
+}
+
+// keyRange is the range [start, end) of a range deletion.
+type keyRange struct {
+	start, end []byte
+}
+
+func (r keyRange) contains(key []byte) bool {
+	return bytes.Compare(key, r.start) >= 0 && bytes.Compare(key, r.end) < 0
+}
+
+// Store is a corestore.Writer branching off a parent corestore.Reader, e.g. the
+// state of an actor at the latest committed version. Writes are kept in memory
+// and reads are served from them first, then from the parent.
+//
+// Range deletions are not resolved into the removal of every key of the range:
+// the keys of the range written to the Store are discarded and the range is kept
+// as a range tombstone, hiding the keys of the parent. ChangeSets returns the
+// range tombstones, i.e. pairs with a RangeEnd, before the other writes.
+type Store struct {
+	parent corestore.Reader
+
+	changes *btree.BTree
+	// ranges are the range deletions applied to the parent
+	ranges []keyRange
+}
+
+// NewStore returns a new Store branching off the given parent.
+func NewStore(parent corestore.Reader) *Store {
+	return &Store{
+		parent:  parent,
+		changes: btree.New(bTreeDegree),
+	}
+}
+
+func (s *Store) Get(key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, storeerrors.ErrKeyEmpty
+	}
+
+	if item := s.changes.Get(change{key: key}); item != nil {
+		c := item.(change)
+		if c.remove {
+			return nil, nil
+		}
+		return c.value, nil
+	}
+
+	if s.removedFromParent(key) {
+		return nil, nil
+	}
+
+	return s.parent.Get(key)
+}
+
+func (s *Store) Has(key []byte) (bool, error) {
+	if len(key) == 0 {
+		return false, storeerrors.ErrKeyEmpty
+	}
+
+	if item := s.changes.Get(change{key: key}); item != nil {
+		return !item.(change).remove, nil
+	}
+
+	if s.removedFromParent(key) {
+		return false, nil
+	}
+
+	return s.parent.Has(key)
+}
+
+func (s *Store) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return storeerrors.ErrKeyEmpty
+	}
+	if value == nil {
+		return storeerrors.ErrValueNil
+	}
+
+	s.changes.ReplaceOrInsert(change{key: bytes.Clone(key), value: bytes.Clone(value)})
+	return nil
+}
+
+func (s *Store) Delete(key []byte) error {
+	if len(key) == 0 {
+		return storeerrors.ErrKeyEmpty
+	}
+
+	s.changes.ReplaceOrInsert(change{key: bytes.Clone(key), remove: true})
+	return nil
+}
+
+// DeleteRange implements corestore.RangeDeleter. The writes of the range are
+// discarded and the range is recorded as a range tombstone, s.t. the cost does
+// not depend on the number of keys of the parent in the range.
+func (s *Store) DeleteRange(start, end []byte) error {
+	if len(start) == 0 || len(end) == 0 {
+		return storeerrors.ErrKeyEmpty
+	}
+	if bytes.Compare(start, end) >= 0 {
+		return nil
+	}
+
+	var discarded []btree.Item
+	s.changes.AscendRange(change{key: start}, change{key: end}, func(item btree.Item) bool {
+		discarded = append(discarded, item)
+		return true
+	})
+	for _, item := range discarded {
+		s.changes.Delete(item)
+	}
+
+	s.ranges = append(s.ranges, keyRange{start: bytes.Clone(start), end: bytes.Clone(end)})
+	return nil
+}
+
+// removedFromParent returns true if the given key of the parent is covered by a
+// range deletion.
+func (s *Store) removedFromParent(key []byte) bool {
+	for _, r := range s.ranges {
+		if r.contains(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ApplyChangeSets applies the given pairs in order.
+func (s *Store) ApplyChangeSets(pairs []corestore.KVPair) error {
+	for _, pair := range pairs {
+		var err error
+		switch {
+		case pair.IsRangeDelete():
+			err = s.DeleteRange(pair.Key, pair.RangeEnd)
+		case pair.Remove:
+			err = s.Delete(pair.Key)
+		default:
+			err = s.Set(pair.Key, pair.Value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ChangeSets returns the writes of the Store: the range tombstones first, then
+// the other writes in ascending order of key. Applying them in order to the
+// parent results in the state of the Store.
+func (s *Store) ChangeSets() ([]corestore.KVPair, error) {
+	pairs := make([]corestore.KVPair, 0, len(s.ranges)+s.changes.Len())
+	for _, r := range s.ranges {
+		pairs = append(pairs, corestore.KVPair{Key: r.start, Remove: true, RangeEnd: r.end})
+	}
+
+	s.changes.Ascend(func(item btree.Item) bool {
+		c := item.(change)
+		pairs = append(pairs, corestore.KVPair{Key: c.key, Value: c.value, Remove: c.remove})
+		return true
+	})
+
+	return pairs, nil
+}
+
+func (s *Store) Iterator(start, end []byte) (corestore.Iterator, error) {
+	return s.newIterator(start, end, false)
+}
+
+func (s *Store) ReverseIterator(start, end []byte) (corestore.Iterator, error) {
+	return s.newIterator(start, end, true)
+}
+
+func (s *Store) newIterator(start, end []byte, reverse bool) (corestore.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, storeerrors.ErrKeyEmpty
+	}
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		return nil, storeerrors.ErrStartAfterEnd
+	}
+
+	var (
+		parent corestore.Iterator
+		err    error
+	)
+	if reverse {
+		parent, err = s.parent.ReverseIterator(start, end)
+	} else {
+		parent, err = s.parent.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the writes of the domain are copied s.t. the iterator is not invalidated by
+	// later writes
+	var changes []change
+	visit := func(item btree.Item) bool {
+		c := item.(change)
+		if end != nil && bytes.Compare(c.key, end) >= 0 {
+			return false
+		}
+		changes = append(changes, c)
+		return true
+	}
+	if start == nil {
+		s.changes.Ascend(visit)
+	} else {
+		s.changes.AscendGreaterOrEqual(change{key: start}, visit)
+	}
+	if reverse {
+		for i, j := 0, len(changes)-1; i < j; i, j = i+1, j-1 {
+			changes[i], changes[j] = changes[j], changes[i]
+		}
+	}
+
+	return newIterator(parent, changes, s.removedFromParent, start, end, reverse), nil
+}
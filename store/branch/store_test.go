@@ -0,0 +1,158 @@
+package branch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	dbm "cosmossdk.io/store/v2/db"
+)
+
+func newTestStore(t *testing.T, parentKeys ...string) *Store {
+	t.Helper()
+
+	parent := dbm.NewMemDB()
+	for _, key := range parentKeys {
+		require.NoError(t, parent.Set([]byte(key), []byte("parent-"+key)))
+	}
+
+	return NewStore(parent)
+}
+
+func collect(t *testing.T, itr corestore.Iterator) (keys, values []string) {
+	t.Helper()
+
+	defer func() { require.NoError(t, itr.Close()) }()
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, string(itr.Key()))
+		values = append(values, string(itr.Value()))
+	}
+	require.NoError(t, itr.Error())
+
+	return keys, values
+}
+
+func TestStoreIterator(t *testing.T) {
+	s := newTestStore(t, "a", "c", "e", "g")
+
+	require.NoError(t, s.Set([]byte("b"), []byte("b")))
+	require.NoError(t, s.Set([]byte("c"), []byte("c")))
+	require.NoError(t, s.Delete([]byte("e")))
+	require.NoError(t, s.Delete([]byte("f")))
+
+	itr, err := s.Iterator(nil, nil)
+	require.NoError(t, err)
+	keys, values := collect(t, itr)
+	require.Equal(t, []string{"a", "b", "c", "g"}, keys)
+	require.Equal(t, []string{"parent-a", "b", "c", "parent-g"}, values)
+
+	itr, err = s.ReverseIterator([]byte("b"), []byte("g"))
+	require.NoError(t, err)
+	keys, _ = collect(t, itr)
+	require.Equal(t, []string{"c", "b"}, keys)
+
+	// writes after the creation of an iterator are not observed by it
+	itr, err = s.Iterator(nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, s.Set([]byte("d"), []byte("d")))
+	keys, _ = collect(t, itr)
+	require.Equal(t, []string{"a", "b", "c", "g"}, keys)
+}
+
+func TestStoreDeleteRange(t *testing.T) {
+	s := newTestStore(t, "a", "b", "c", "d", "e")
+
+	require.NoError(t, s.Set([]byte("bb"), []byte("bb")))
+	require.NoError(t, s.DeleteRange([]byte("b"), []byte("d")))
+	require.NoError(t, s.Set([]byte("c"), []byte("c")))
+
+	for key, exists := range map[string]bool{"a": true, "b": false, "bb": false, "c": true, "d": true} {
+		has, err := s.Has([]byte(key))
+		require.NoError(t, err)
+		require.Equal(t, exists, has, key)
+	}
+
+	value, err := s.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	itr, err := s.Iterator(nil, nil)
+	require.NoError(t, err)
+	keys, values := collect(t, itr)
+	require.Equal(t, []string{"a", "c", "d", "e"}, keys)
+	require.Equal(t, []string{"parent-a", "c", "parent-d", "parent-e"}, values)
+
+	itr, err = s.ReverseIterator(nil, nil)
+	require.NoError(t, err)
+	keys, _ = collect(t, itr)
+	require.Equal(t, []string{"e", "d", "c", "a"}, keys)
+
+	// empty ranges are ignored
+	require.NoError(t, s.DeleteRange([]byte("e"), []byte("a")))
+	require.ErrorContains(t, s.DeleteRange(nil, []byte("a")), "key empty")
+}
+
+func TestStoreChangeSets(t *testing.T) {
+	s := newTestStore(t, "a", "b", "c")
+
+	require.NoError(t, s.Set([]byte("d"), []byte("d")))
+	require.NoError(t, s.Delete([]byte("a")))
+	require.NoError(t, s.Set([]byte("bb"), []byte("bb")))
+	require.NoError(t, s.DeleteRange([]byte("b"), []byte("c")))
+	require.NoError(t, s.Set([]byte("b"), []byte("b")))
+
+	pairs, err := s.ChangeSets()
+	require.NoError(t, err)
+	require.Equal(t, []corestore.KVPair{
+		{Key: []byte("b"), Remove: true, RangeEnd: []byte("c")},
+		{Key: []byte("a"), Remove: true},
+		{Key: []byte("b"), Value: []byte("b")},
+		{Key: []byte("d"), Value: []byte("d")},
+	}, pairs)
+
+	// applying the changesets to a branch of the same parent results in the
+	// same state
+	other := newTestStore(t, "a", "b", "c")
+	require.NoError(t, other.ApplyChangeSets(pairs))
+
+	itr, err := s.Iterator(nil, nil)
+	require.NoError(t, err)
+	keys, values := collect(t, itr)
+	itr, err = other.Iterator(nil, nil)
+	require.NoError(t, err)
+	otherKeys, otherValues := collect(t, itr)
+	require.Equal(t, []string{"b", "c", "d"}, keys)
+	require.Equal(t, keys, otherKeys)
+	require.Equal(t, values, otherValues)
+}
+
+func TestWriterMap(t *testing.T) {
+	parent := dbm.NewMemDB()
+	require.NoError(t, parent.Set([]byte("a"), []byte("a")))
+	m := NewWriterMap(readerMap{parent})
+
+	for _, actor := range []string{"b", "a", "c"} {
+		writer, err := m.GetWriter([]byte(actor))
+		require.NoError(t, err)
+		if actor != "c" {
+			require.NoError(t, writer.(corestore.RangeDeleter).DeleteRange([]byte("a"), []byte("b")))
+		}
+	}
+
+	stateChanges, err := m.GetStateChanges()
+	require.NoError(t, err)
+	require.Len(t, stateChanges, 2)
+	require.Equal(t, []byte("a"), stateChanges[0].Actor)
+	require.Equal(t, []byte("b"), stateChanges[1].Actor)
+	require.True(t, stateChanges[0].StateChanges[0].IsRangeDelete())
+}
+
+// readerMap returns the same reader for every actor.
+type readerMap struct {
+	corestore.Reader
+}
+
+func (r readerMap) GetReader([]byte) (corestore.Reader, error) {
+	return r.Reader, nil
+}
@@ -0,0 +1,81 @@
+package branch
+
+import (
+	"bytes"
+	"sort"
+
+	corestore "cosmossdk.io/core/store"
+)
+
+var _ corestore.WriterMap = (*WriterMap)(nil)
+
+// WriterMap is a corestore.WriterMap branching off a parent corestore.ReaderMap,
+// the state of every actor being branched by a Store.
+type WriterMap struct {
+	parent  corestore.ReaderMap
+	writers map[string]*Store
+}
+
+// NewWriterMap returns a new WriterMap branching off the given parent.
+func NewWriterMap(parent corestore.ReaderMap) *WriterMap {
+	return &WriterMap{
+		parent:  parent,
+		writers: make(map[string]*Store),
+	}
+}
+
+func (m *WriterMap) GetReader(actor []byte) (corestore.Reader, error) {
+	return m.GetWriter(actor)
+}
+
+func (m *WriterMap) GetWriter(actor []byte) (corestore.Writer, error) {
+	if writer, ok := m.writers[string(actor)]; ok {
+		return writer, nil
+	}
+
+	reader, err := m.parent.GetReader(actor)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := NewStore(reader)
+	m.writers[string(actor)] = writer
+
+	return writer, nil
+}
+
+func (m *WriterMap) ApplyStateChanges(stateChanges []corestore.StateChanges) error {
+	for _, sc := range stateChanges {
+		writer, err := m.GetWriter(sc.Actor)
+		if err != nil {
+			return err
+		}
+		if err := writer.ApplyChangeSets(sc.StateChanges); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetStateChanges returns the writes of every actor written to, sorted by actor.
+func (m *WriterMap) GetStateChanges() ([]corestore.StateChanges, error) {
+	stateChanges := make([]corestore.StateChanges, 0, len(m.writers))
+	for actor, writer := range m.writers {
+		pairs, err := writer.ChangeSets()
+		if err != nil {
+			return nil, err
+		}
+		if len(pairs) == 0 {
+			continue
+		}
+
+		stateChanges = append(stateChanges, corestore.StateChanges{Actor: []byte(actor), StateChanges: pairs})
+	}
+
+	sort.Slice(stateChanges, func(i, j int) bool {
+		return bytes.Compare(stateChanges[i].Actor, stateChanges[j].Actor) < 0
+	})
+
+	return stateChanges, nil
+}
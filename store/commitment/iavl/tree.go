@@ -12,7 +12,14 @@ import (
 	dbm "cosmossdk.io/store/v2/db"
 )
 
-var _ commitment.Tree = (*IavlTree)(nil)
+var (
+	_ commitment.Tree         = (*IavlTree)(nil)
+	_ commitment.RangeRemover = (*IavlTree)(nil)
+)
+
+// removeRangeBatchSize is the number of keys collected before being removed by
+// RemoveRange, as the tree cannot be modified while being iterated.
+const removeRangeBatchSize = 10000
 
 // IavlTree is a wrapper around iavl.MutableTree.
 type IavlTree struct {
@@ -39,6 +46,35 @@ func (t *IavlTree) Remove(key []byte) error {
 	return nil
 }
 
+// RemoveRange removes all the keys in the range [start, end) from the working
+// tree.
+func (t *IavlTree) RemoveRange(start, end []byte) error {
+	for {
+		itr, err := t.tree.Iterator(start, end, true)
+		if err != nil {
+			return err
+		}
+
+		keys := make([][]byte, 0, removeRangeBatchSize)
+		for ; itr.Valid() && len(keys) < removeRangeBatchSize; itr.Next() {
+			keys = append(keys, itr.Key())
+		}
+		if err := itr.Close(); err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			if _, _, err := t.tree.Remove(key); err != nil {
+				return err
+			}
+		}
+
+		if len(keys) < removeRangeBatchSize {
+			return nil
+		}
+	}
+}
+
 // Set sets the given key-value pair in the tree.
 func (t *IavlTree) Set(key, value []byte) error {
 	_, err := t.tree.Set(key, value)
@@ -97,6 +133,10 @@ func (t *IavlTree) SetInitialVersion(version uint64) error {
 }
 
 // Prune prunes all versions up to and including the provided version.
+//
+// Note, IavlTree does not implement store.LimitedPruner as iavl deletes the
+// orphaned nodes of the versions in a single call, so it is not throttled by the
+// rate limit of a pruning.Manager.
 func (t *IavlTree) Prune(version uint64) error {
 	return t.tree.DeleteVersionsTo(int64(version))
 }
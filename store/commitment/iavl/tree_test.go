@@ -1,11 +1,14 @@
 package iavl
 
 import (
+	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/commitment"
@@ -110,3 +113,49 @@ func TestIavlTree(t *testing.T) {
 	// close the db
 	require.NoError(t, tree.Close())
 }
+
+func BenchmarkCommitStoreWorkingHash(b *testing.B) {
+	const (
+		numStores  = 25
+		numChanges = 500
+	)
+
+	storeKeys := make([]string, numStores)
+	for i := range storeKeys {
+		storeKeys[i] = fmt.Sprintf("store%02d", i)
+	}
+
+	for _, concurrency := range []int{1, 4, 16} {
+		b.Run(fmt.Sprintf("concurrency_%d", concurrency), func(b *testing.B) {
+			db := dbm.NewMemDB()
+			multiTrees := make(map[string]commitment.Tree)
+			for _, storeKey := range storeKeys {
+				multiTrees[storeKey] = NewIavlTree(dbm.NewPrefixDB(db, []byte(storeKey)), log.NewNopLogger(), DefaultConfig())
+			}
+			commitStore, err := commitment.NewCommitStore(multiTrees, db, nil, log.NewNopLogger())
+			require.NoError(b, err)
+			commitStore.SetHashConcurrency(concurrency)
+
+			r := rand.New(rand.NewSource(1))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				kvPairs := make(map[string]corestore.KVPairs, numStores)
+				for _, storeKey := range storeKeys {
+					for j := 0; j < numChanges; j++ {
+						key, value := make([]byte, 32), make([]byte, 64)
+						r.Read(key)
+						r.Read(value)
+						kvPairs[storeKey] = append(kvPairs[storeKey], corestore.KVPair{Key: key, Value: value})
+					}
+				}
+				require.NoError(b, commitStore.WriteBatch(corestore.NewChangesetWithPairs(kvPairs)))
+				b.StartTimer()
+
+				commitStore.WorkingCommitInfo(uint64(i + 1))
+				_, err := commitStore.Commit(uint64(i + 1))
+				require.NoError(b, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package smt
+
+import (
+	"cosmossdk.io/store/v2/commitment"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+var _ commitment.Exporter = (*Exporter)(nil)
+
+// Exporter exports the leaves of a version of the SmtTree in path order.
+type Exporter struct {
+	tree    *SmtTree
+	version uint64
+	// stack contains the subtrees which are not traversed yet, the next subtree
+	// is at the end
+	stack []*node
+}
+
+func newExporter(tree *SmtTree, version uint64, root *node) *Exporter {
+	e := &Exporter{
+		tree:    tree,
+		version: version,
+	}
+	if root != nil {
+		e.stack = append(e.stack, root)
+	}
+
+	return e
+}
+
+// Next returns the next item in the exporter.
+func (e *Exporter) Next() (*snapshotstypes.SnapshotIAVLItem, error) {
+	for len(e.stack) > 0 {
+		n := e.stack[len(e.stack)-1]
+		e.stack = e.stack[:len(e.stack)-1]
+
+		if err := e.tree.load(n); err != nil {
+			return nil, err
+		}
+
+		if n.isLeaf() {
+			return &snapshotstypes.SnapshotIAVLItem{
+				Key:     n.key,
+				Value:   n.value,
+				Version: int64(n.version),
+				Height:  0,
+			}, nil
+		}
+
+		if n.right != nil {
+			e.stack = append(e.stack, n.right)
+		}
+		if n.left != nil {
+			e.stack = append(e.stack, n.left)
+		}
+	}
+
+	return nil, commitment.ErrorExportDone
+}
+
+// Close closes the exporter.
+func (e *Exporter) Close() error {
+	e.stack = nil
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package smt
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/v2/commitment"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+var _ commitment.Importer = (*Importer)(nil)
+
+// Importer imports the leaves of an exported SmtTree into an empty tree, the
+// tree is rebuilt and committed at the import version.
+type Importer struct {
+	tree    *SmtTree
+	version uint64
+}
+
+func newImporter(tree *SmtTree, version uint64) *Importer {
+	return &Importer{
+		tree:    tree,
+		version: version,
+	}
+}
+
+// Add adds the given item to the importer.
+func (i *Importer) Add(item *snapshotstypes.SnapshotIAVLItem) error {
+	if item.Height != 0 {
+		return fmt.Errorf("unexpected SMT item height %d, only leaves are exported", item.Height)
+	}
+
+	return i.tree.Set(item.Key, item.Value)
+}
+
+// Commit commits the importer.
+func (i *Importer) Commit() error {
+	// importing into an empty tree is equivalent to starting the tree at the
+	// import version
+	if err := i.tree.SetInitialVersion(i.version); err != nil {
+		return err
+	}
+
+	_, version, err := i.tree.Commit()
+	if err != nil {
+		return err
+	}
+	if version != i.version {
+		return fmt.Errorf("imported version %d does not match the expected version %d", version, i.version)
+	}
+
+	return nil
+}
+
+// Close closes the importer.
+func (i *Importer) Close() error {
+	return nil
+}
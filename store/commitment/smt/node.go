@@ -0,0 +1,252 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/store/v2/internal/encoding"
+)
+
+const (
+	hashSize = sha256.Size
+
+	// depth defines the depth of the tree, i.e. the number of bits of a path
+	depth = hashSize * 8
+
+	leafNodeType  byte = 0
+	innerNodeType byte = 1
+)
+
+var (
+	leafPrefix  = []byte{0}
+	innerPrefix = []byte{1}
+
+	// placeholder is the hash of an empty subtree
+	placeholder = make([]byte, hashSize)
+)
+
+// node defines a node of the sparse Merkle tree. A node is either a leaf node or
+// an inner node. Note, the tree is compacted, i.e. a subtree containing a single
+// leaf is represented by the leaf itself.
+//
+// Nodes are immutable once created and are identified by the version they were
+// created at and their hash. A node which is not yet loaded from the database
+// only has its version and hash set.
+type node struct {
+	version   uint64
+	hash      []byte
+	loaded    bool
+	persisted bool
+
+	// leaf fields
+	path  []byte
+	key   []byte
+	value []byte
+
+	// inner fields, a nil child represents an empty subtree
+	left, right *node
+}
+
+func (n *node) isLeaf() bool {
+	return n.path != nil
+}
+
+// newLeaf returns a new leaf node created at the given version.
+func newLeaf(version uint64, key, value []byte) *node {
+	path := sha256.Sum256(key)
+	n := &node{
+		version: version,
+		loaded:  true,
+		path:    path[:],
+		key:     key,
+		value:   value,
+	}
+	n.hash = leafHash(n.path, value)
+
+	return n
+}
+
+// newInner returns a new inner node created at the given version.
+func newInner(version uint64, left, right *node) *node {
+	return &node{
+		version: version,
+		loaded:  true,
+		left:    left,
+		right:   right,
+		hash:    innerHash(hashOf(left), hashOf(right)),
+	}
+}
+
+// newStub returns a node which is not loaded yet.
+func newStub(version uint64, hash []byte) *node {
+	return &node{
+		version:   version,
+		hash:      hash,
+		persisted: true,
+	}
+}
+
+func hashOf(n *node) []byte {
+	if n == nil {
+		return placeholder
+	}
+
+	return n.hash
+}
+
+func leafHash(path, value []byte) []byte {
+	valueHash := sha256.Sum256(value)
+
+	h := sha256.New()
+	h.Write(leafPrefix)
+	h.Write(path)
+	h.Write(valueHash[:])
+
+	return h.Sum(nil)
+}
+
+func innerHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write(innerPrefix)
+	h.Write(left)
+	h.Write(right)
+
+	return h.Sum(nil)
+}
+
+// bit returns the bit of the given path at the given depth, 0 for left and 1
+// for right.
+func bit(path []byte, d int) int {
+	return int(path[d/8]>>(7-d%8)) & 1
+}
+
+// nodeKey returns the database key of a node, i.e. n<version><hash>.
+func nodeKey(version uint64, hash []byte) []byte {
+	key := make([]byte, 1+8+len(hash))
+	key[0] = nodePrefix
+	binary.BigEndian.PutUint64(key[1:], version)
+	copy(key[9:], hash)
+
+	return key
+}
+
+// encodeRef encodes a reference to the given node as follows:
+// - present (1 byte), 0 for an empty subtree
+// - version (8 bytes)
+// - hash (32 bytes)
+func encodeRef(buf *bytes.Buffer, n *node) {
+	if n == nil {
+		buf.WriteByte(0)
+		return
+	}
+
+	var v [8]byte
+	binary.BigEndian.PutUint64(v[:], n.version)
+
+	buf.WriteByte(1)
+	buf.Write(v[:])
+	buf.Write(n.hash)
+}
+
+func decodeRef(bz []byte) (*node, int, error) {
+	if len(bz) == 0 {
+		return nil, 0, fmt.Errorf("invalid node reference: empty")
+	}
+	if bz[0] == 0 {
+		return nil, 1, nil
+	}
+	if len(bz) < 1+8+hashSize {
+		return nil, 0, fmt.Errorf("invalid node reference length: %d", len(bz))
+	}
+
+	version := binary.BigEndian.Uint64(bz[1:9])
+	hash := bytes.Clone(bz[9 : 9+hashSize])
+
+	return newStub(version, hash), 1 + 8 + hashSize, nil
+}
+
+// encode returns the encoded byte representation of a node.
+// NOTE: A leaf node is encoded as follows:
+// - type (1 byte)
+// - path (32 bytes)
+// - key (bytes)
+// - value (bytes)
+//
+// An inner node is encoded as follows:
+// - type (1 byte)
+// - left reference
+// - right reference
+func (n *node) encode() ([]byte, error) {
+	var buf bytes.Buffer
+
+	if n.isLeaf() {
+		buf.Grow(1 + hashSize + encoding.EncodeBytesSize(n.key) + encoding.EncodeBytesSize(n.value))
+		buf.WriteByte(leafNodeType)
+		buf.Write(n.path)
+		if err := encoding.EncodeBytes(&buf, n.key); err != nil {
+			return nil, err
+		}
+		if err := encoding.EncodeBytes(&buf, n.value); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	buf.Grow(1 + 2*(1+8+hashSize))
+	buf.WriteByte(innerNodeType)
+	encodeRef(&buf, n.left)
+	encodeRef(&buf, n.right)
+
+	return buf.Bytes(), nil
+}
+
+// decode decodes the encoded byte representation of a node into n and marks it
+// as loaded.
+func (n *node) decode(bz []byte) error {
+	if len(bz) == 0 {
+		return fmt.Errorf("invalid node: empty")
+	}
+
+	switch bz[0] {
+	case leafNodeType:
+		bz = bz[1:]
+		if len(bz) < hashSize {
+			return fmt.Errorf("invalid leaf node path length: %d", len(bz))
+		}
+		n.path = bytes.Clone(bz[:hashSize])
+		bz = bz[hashSize:]
+
+		key, read, err := encoding.DecodeBytes(bz)
+		if err != nil {
+			return err
+		}
+		value, _, err := encoding.DecodeBytes(bz[read:])
+		if err != nil {
+			return err
+		}
+		n.key = key
+		n.value = value
+
+	case innerNodeType:
+		left, read, err := decodeRef(bz[1:])
+		if err != nil {
+			return err
+		}
+		right, _, err := decodeRef(bz[1+read:])
+		if err != nil {
+			return err
+		}
+		n.left = left
+		n.right = right
+
+	default:
+		return fmt.Errorf("invalid node type: %d", bz[0])
+	}
+
+	n.loaded = true
+
+	return nil
+}
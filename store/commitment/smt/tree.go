@@ -0,0 +1,810 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/proof"
+)
+
+const (
+	nodePrefix   byte = 'n' // n<version><hash>
+	orphanPrefix byte = 'o' // o<orphaned_version><version><hash>
+	rootPrefix   byte = 'r' // r<version>
+
+	// pruneChunkSize defines the number of orphaned nodes per wait call when
+	// pruning is throttled
+	pruneChunkSize = 1000
+)
+
+var (
+	_ commitment.Tree           = (*SmtTree)(nil)
+	_ commitment.ProofSpecifier = (*SmtTree)(nil)
+	_ store.LimitedPruner       = (*SmtTree)(nil)
+
+	latestVersionKey = []byte("m/latest")
+)
+
+// pendingOp defines a write to the tree which has not been applied to the working
+// tree yet.
+type pendingOp struct {
+	key    []byte
+	value  []byte
+	remove bool
+}
+
+// SmtTree is a sparse Merkle tree implementation of the commitment.Tree interface.
+// The path of a key is the SHA-256 hash of the key, so every leaf is located on
+// a fixed depth path of 256 bits. The tree is compacted, i.e. a subtree containing
+// a single leaf is replaced by the leaf itself. Hashing follows ics23.SmtSpec, so
+// proofs can be verified with the ics23 library.
+//
+// Every version of the tree is stored in the database, where each node is keyed
+// by the version it was created at and its hash. Nodes which are replaced by a
+// newer version are recorded as orphans, so they can be deleted once all versions
+// referencing them are pruned.
+type SmtTree struct {
+	logger log.Logger
+	db     store.RawDB
+
+	version        uint64
+	initialVersion uint64
+
+	// root is the root of the working tree, it is nil for an empty tree
+	root *node
+	// lastHash is the root hash of the latest saved version
+	lastHash []byte
+
+	// pending contains the writes which are not yet applied to the working tree,
+	// keyed by the path of the key
+	pending map[string]pendingOp
+	// orphans contains the persisted nodes replaced in the working tree
+	orphans []*node
+}
+
+// NewSmtTree creates a new SmtTree instance, opening the latest version saved in
+// the given database (if any).
+func NewSmtTree(db store.RawDB, logger log.Logger) (*SmtTree, error) {
+	t := &SmtTree{
+		logger:   logger,
+		db:       db,
+		pending:  make(map[string]pendingOp),
+		lastHash: placeholder,
+	}
+
+	bz, err := db.Get(latestVersionKey)
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return t, nil
+	}
+	if len(bz) != 8 {
+		return nil, fmt.Errorf("invalid latest version length: %d", len(bz))
+	}
+
+	version := binary.BigEndian.Uint64(bz)
+	root, err := t.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	t.version = version
+	t.root = root
+	t.lastHash = hashOf(root)
+
+	return t, nil
+}
+
+// ProofType implements commitment.ProofSpecifier.
+func (t *SmtTree) ProofType() string {
+	return proof.ProofOpSMTCommitment
+}
+
+// Set sets the given key-value pair in the tree.
+func (t *SmtTree) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return fmt.Errorf("key cannot be empty")
+	}
+	if value == nil {
+		return fmt.Errorf("value cannot be nil")
+	}
+
+	path := sha256.Sum256(key)
+	t.pending[string(path[:])] = pendingOp{key: key, value: value}
+
+	return nil
+}
+
+// Remove removes the given key from the tree. An error is returned if the key
+// does not exist.
+func (t *SmtTree) Remove(key []byte) error {
+	path := sha256.Sum256(key)
+
+	exists := false
+	if op, ok := t.pending[string(path[:])]; ok {
+		exists = !op.remove
+	} else {
+		leaf, err := t.findLeaf(t.root, path[:])
+		if err != nil {
+			return err
+		}
+		exists = leaf != nil
+	}
+
+	if !exists {
+		return fmt.Errorf("key %x not found", key)
+	}
+
+	t.pending[string(path[:])] = pendingOp{key: key, remove: true}
+
+	return nil
+}
+
+// Hash returns the hash of the latest saved version of the tree.
+func (t *SmtTree) Hash() []byte {
+	return t.lastHash
+}
+
+// WorkingHash returns the working hash of the tree.
+func (t *SmtTree) WorkingHash() []byte {
+	if err := t.applyPending(); err != nil {
+		panic(fmt.Errorf("failed to apply pending writes to the SMT: %w", err))
+	}
+
+	return hashOf(t.root)
+}
+
+// GetLatestVersion returns the latest version of the tree.
+func (t *SmtTree) GetLatestVersion() uint64 {
+	return t.version
+}
+
+// SetInitialVersion sets the initial version of the tree.
+func (t *SmtTree) SetInitialVersion(version uint64) error {
+	t.initialVersion = version
+	return nil
+}
+
+// LoadVersion loads the tree at the given version. Any version greater than the
+// given version is removed, i.e. the tree is rolled back to the given version.
+func (t *SmtTree) LoadVersion(version uint64) error {
+	root, err := t.getRoot(version)
+	if err != nil {
+		return err
+	}
+
+	batch := t.db.NewBatch()
+	defer batch.Close()
+
+	var start [8]byte
+	binary.BigEndian.PutUint64(start[:], version+1)
+
+	// remove the roots and nodes of any newer version and restore the nodes
+	// orphaned by them
+	for _, prefix := range []byte{rootPrefix, nodePrefix, orphanPrefix} {
+		if err := deleteRange(t.db, batch, append([]byte{prefix}, start[:]...), []byte{prefix + 1}); err != nil {
+			return err
+		}
+	}
+
+	binary.BigEndian.PutUint64(start[:], version)
+	if err := batch.Set(latestVersionKey, start[:]); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	t.version = version
+	t.root = root
+	t.lastHash = hashOf(root)
+	t.pending = make(map[string]pendingOp)
+	t.orphans = nil
+
+	return nil
+}
+
+// Commit commits the working tree to the database as a new version.
+func (t *SmtTree) Commit() ([]byte, uint64, error) {
+	if err := t.applyPending(); err != nil {
+		return nil, 0, err
+	}
+
+	version := t.nextVersion()
+
+	batch := t.db.NewBatch()
+	defer batch.Close()
+
+	if err := t.writeNodes(batch, t.root); err != nil {
+		return nil, 0, err
+	}
+
+	for _, orphan := range t.orphans {
+		if err := batch.Set(orphanKey(version, orphan), []byte{}); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	var buf bytes.Buffer
+	encodeRef(&buf, t.root)
+	if err := batch.Set(rootKey(version), buf.Bytes()); err != nil {
+		return nil, 0, err
+	}
+
+	var v [8]byte
+	binary.BigEndian.PutUint64(v[:], version)
+	if err := batch.Set(latestVersionKey, v[:]); err != nil {
+		return nil, 0, err
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return nil, 0, err
+	}
+
+	t.version = version
+	t.orphans = nil
+	t.lastHash = hashOf(t.root)
+
+	// release the loaded nodes, they are lazily loaded again when needed
+	if t.root != nil {
+		t.root = newStub(t.root.version, t.root.hash)
+	}
+
+	return t.lastHash, version, nil
+}
+
+// GetProof returns a proof for the given key and version. An existence proof is
+// returned if the key exists, otherwise a non-existence proof is returned.
+func (t *SmtTree) GetProof(version uint64, key []byte) (*ics23.CommitmentProof, error) {
+	root, err := t.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	path := sha256.Sum256(key)
+	leaf, err := t.findLeaf(root, path[:])
+	if err != nil {
+		return nil, err
+	}
+
+	if leaf != nil {
+		exist, err := t.existenceProof(root, leaf)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ics23.CommitmentProof{
+			Proof: &ics23.CommitmentProof_Exist{Exist: exist},
+		}, nil
+	}
+
+	left, right, err := t.neighbors(root, path[:])
+	if err != nil {
+		return nil, err
+	}
+	if left == nil && right == nil {
+		return nil, fmt.Errorf("cannot create a non-existence proof for an empty tree at version %d", version)
+	}
+
+	nonExist := &ics23.NonExistenceProof{Key: key}
+	if left != nil {
+		if nonExist.Left, err = t.existenceProof(root, left); err != nil {
+			return nil, err
+		}
+	}
+	if right != nil {
+		if nonExist.Right, err = t.existenceProof(root, right); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Nonexist{Nonexist: nonExist},
+	}, nil
+}
+
+// Get returns the value of the given key at the given version, it returns nil
+// if the key does not exist.
+func (t *SmtTree) Get(version uint64, key []byte) ([]byte, error) {
+	root, err := t.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	path := sha256.Sum256(key)
+	leaf, err := t.findLeaf(root, path[:])
+	if err != nil || leaf == nil {
+		return nil, err
+	}
+
+	return leaf.value, nil
+}
+
+// Prune prunes all versions up to and including the provided version.
+func (t *SmtTree) Prune(version uint64) error {
+	return t.PruneLimited(version, nil)
+}
+
+// PruneLimited implements store.LimitedPruner, wait is called for every chunk of
+// pruneChunkSize orphaned nodes. The deletions are written at once when all the
+// orphans are collected.
+func (t *SmtTree) PruneLimited(version uint64, wait func(n int) error) error {
+	if version >= t.version {
+		return fmt.Errorf("cannot prune the latest version %d, requested %d", t.version, version)
+	}
+
+	var end [8]byte
+
+	batch := t.db.NewBatch()
+	defer batch.Close()
+
+	// nodes orphaned at version v were last referenced by version v-1, thus they
+	// can be deleted once version v-1 is pruned
+	binary.BigEndian.PutUint64(end[:], version+2)
+	itr, err := t.db.Iterator([]byte{orphanPrefix}, append([]byte{orphanPrefix}, end[:]...))
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	pruned := 0
+	for ; itr.Valid(); itr.Next() {
+		if wait != nil && pruned%pruneChunkSize == 0 {
+			if err := wait(pruneChunkSize); err != nil {
+				return err
+			}
+		}
+		pruned++
+
+		orphanKey := bytes.Clone(itr.Key())
+		if err := batch.Delete(append([]byte{nodePrefix}, orphanKey[9:]...)); err != nil {
+			return err
+		}
+		if err := batch.Delete(orphanKey); err != nil {
+			return err
+		}
+	}
+	if err := itr.Error(); err != nil {
+		return err
+	}
+
+	binary.BigEndian.PutUint64(end[:], version+1)
+	if err := deleteRange(t.db, batch, []byte{rootPrefix}, append([]byte{rootPrefix}, end[:]...)); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// Export exports the tree exporter at the given version.
+func (t *SmtTree) Export(version uint64) (commitment.Exporter, error) {
+	root, err := t.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return newExporter(t, version, root), nil
+}
+
+// Import imports the tree importer at the given version. The tree must be empty.
+func (t *SmtTree) Import(version uint64) (commitment.Importer, error) {
+	if t.version != 0 {
+		return nil, fmt.Errorf("cannot import into a non-empty tree at version %d", t.version)
+	}
+
+	return newImporter(t, version), nil
+}
+
+// Close closes the tree.
+func (t *SmtTree) Close() error {
+	t.root = nil
+	t.pending = nil
+	t.orphans = nil
+
+	return nil
+}
+
+func (t *SmtTree) nextVersion() uint64 {
+	if t.version == 0 && t.initialVersion > 1 {
+		return t.initialVersion
+	}
+
+	return t.version + 1
+}
+
+// getRoot returns the root node of the given version, version zero refers to
+// the empty tree.
+func (t *SmtTree) getRoot(version uint64) (*node, error) {
+	if version == 0 {
+		return nil, nil
+	}
+
+	bz, err := t.db.Get(rootKey(version))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("version %d does not exist", version)
+	}
+
+	root, _, err := decodeRef(bz)
+	return root, err
+}
+
+// load loads the given node from the database if it is not loaded yet.
+func (t *SmtTree) load(n *node) error {
+	if n.loaded {
+		return nil
+	}
+
+	bz, err := t.db.Get(nodeKey(n.version, n.hash))
+	if err != nil {
+		return err
+	}
+	if bz == nil {
+		return fmt.Errorf("node %X at version %d not found", n.hash, n.version)
+	}
+
+	return n.decode(bytes.Clone(bz))
+}
+
+// findLeaf returns the leaf of the given path in the subtree of the given node,
+// it returns nil if the path does not exist.
+func (t *SmtTree) findLeaf(n *node, path []byte) (*node, error) {
+	for d := 0; n != nil; d++ {
+		if err := t.load(n); err != nil {
+			return nil, err
+		}
+
+		if n.isLeaf() {
+			if bytes.Equal(n.path, path) {
+				return n, nil
+			}
+
+			return nil, nil
+		}
+
+		if bit(path, d) == 0 {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+
+	return nil, nil
+}
+
+// neighbors returns the leaves adjacent to the given non-existent path, i.e. the
+// leaves with the greatest path less than and the smallest path greater than the
+// given path, either may be nil.
+func (t *SmtTree) neighbors(n *node, path []byte) (left, right *node, err error) {
+	var leftSubtree, rightSubtree *node
+
+	for d := 0; n != nil; d++ {
+		if err := t.load(n); err != nil {
+			return nil, nil, err
+		}
+
+		if n.isLeaf() {
+			if bytes.Compare(n.path, path) < 0 {
+				leftSubtree = n
+			} else {
+				rightSubtree = n
+			}
+
+			break
+		}
+
+		if bit(path, d) == 0 {
+			if n.right != nil {
+				rightSubtree = n.right
+			}
+			n = n.left
+		} else {
+			if n.left != nil {
+				leftSubtree = n.left
+			}
+			n = n.right
+		}
+	}
+
+	if left, err = t.edgeLeaf(leftSubtree, true); err != nil {
+		return nil, nil, err
+	}
+	if right, err = t.edgeLeaf(rightSubtree, false); err != nil {
+		return nil, nil, err
+	}
+
+	return left, right, nil
+}
+
+// edgeLeaf returns the right-most leaf of the given subtree if rightMost is set,
+// otherwise the left-most leaf.
+func (t *SmtTree) edgeLeaf(n *node, rightMost bool) (*node, error) {
+	for n != nil {
+		if err := t.load(n); err != nil {
+			return nil, err
+		}
+
+		if n.isLeaf() {
+			return n, nil
+		}
+
+		switch {
+		case rightMost && n.right != nil, !rightMost && n.left == nil:
+			n = n.right
+		default:
+			n = n.left
+		}
+	}
+
+	return nil, nil
+}
+
+// existenceProof returns the ics23 existence proof of the given leaf, which must
+// exist in the subtree of the given root.
+func (t *SmtTree) existenceProof(root, leaf *node) (*ics23.ExistenceProof, error) {
+	var siblings [][]byte
+
+	n := root
+	for d := 0; n != leaf; d++ {
+		if n == nil {
+			return nil, fmt.Errorf("leaf %X not found", leaf.path)
+		}
+		if err := t.load(n); err != nil {
+			return nil, err
+		}
+
+		if bit(leaf.path, d) == 0 {
+			siblings = append(siblings, hashOf(n.right))
+			n = n.left
+		} else {
+			siblings = append(siblings, hashOf(n.left))
+			n = n.right
+		}
+	}
+
+	// the path of inner ops is ordered from the leaf up to the root
+	inners := make([]*ics23.InnerOp, len(siblings))
+	for d := len(siblings) - 1; d >= 0; d-- {
+		op := &ics23.InnerOp{Hash: ics23.HashOp_SHA256}
+		if bit(leaf.path, d) == 0 {
+			op.Prefix = innerPrefix
+			op.Suffix = siblings[d]
+		} else {
+			op.Prefix = append(bytes.Clone(innerPrefix), siblings[d]...)
+		}
+		inners[len(siblings)-1-d] = op
+	}
+
+	return &ics23.ExistenceProof{
+		Key:   leaf.key,
+		Value: leaf.value,
+		Leaf:  ics23.SmtSpec.LeafSpec,
+		Path:  inners,
+	}, nil
+}
+
+// applyPending applies all pending writes to the working tree.
+func (t *SmtTree) applyPending() error {
+	if len(t.pending) == 0 {
+		return nil
+	}
+
+	paths := make([]string, 0, len(t.pending))
+	for path := range t.pending {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	ops := make([]pendingOp, len(paths))
+	for i, path := range paths {
+		ops[i] = t.pending[path]
+	}
+
+	root, err := t.update(t.root, 0, paths, ops)
+	if err != nil {
+		return err
+	}
+
+	t.root = root
+	t.pending = make(map[string]pendingOp)
+
+	return nil
+}
+
+// update applies the given sorted writes to the subtree of the given node at
+// the given depth and returns the new subtree. All paths share the same prefix
+// up to the given depth.
+func (t *SmtTree) update(n *node, d int, paths []string, ops []pendingOp) (*node, error) {
+	if len(ops) == 0 {
+		return n, nil
+	}
+
+	if n == nil {
+		return t.build(d, t.newLeaves(ops)), nil
+	}
+
+	if err := t.load(n); err != nil {
+		return nil, err
+	}
+
+	if n.isLeaf() {
+		leaves := t.newLeaves(ops)
+
+		i := sort.SearchStrings(paths, string(n.path))
+		touched := i < len(paths) && paths[i] == string(n.path)
+		if !touched {
+			if len(leaves) == 0 {
+				// only removals of non-existent keys
+				return n, nil
+			}
+
+			// keep the existing leaf, ordered by path
+			j := sort.Search(len(leaves), func(j int) bool { return bytes.Compare(leaves[j].path, n.path) > 0 })
+			leaves = append(leaves[:j], append([]*node{n}, leaves[j:]...)...)
+		} else {
+			t.orphan(n)
+		}
+
+		return t.build(d, leaves), nil
+	}
+
+	// split the writes by the bit at the current depth
+	split := sort.Search(len(paths), func(i int) bool { return bit([]byte(paths[i]), d) == 1 })
+
+	left, err := t.update(n.left, d+1, paths[:split], ops[:split])
+	if err != nil {
+		return nil, err
+	}
+	right, err := t.update(n.right, d+1, paths[split:], ops[split:])
+	if err != nil {
+		return nil, err
+	}
+
+	if left == n.left && right == n.right {
+		return n, nil
+	}
+
+	t.orphan(n)
+
+	return t.compact(left, right)
+}
+
+// compact returns the subtree of the given children, a single leaf child with
+// an empty sibling replaces the inner node.
+func (t *SmtTree) compact(left, right *node) (*node, error) {
+	if left == nil && right == nil {
+		return nil, nil
+	}
+
+	if left != nil && right != nil {
+		return newInner(t.nextVersion(), left, right), nil
+	}
+
+	child := left
+	if child == nil {
+		child = right
+	}
+
+	// the child must be loaded to determine whether it is a leaf
+	if err := t.load(child); err != nil {
+		return nil, err
+	}
+	if child.isLeaf() {
+		return child, nil
+	}
+
+	return newInner(t.nextVersion(), left, right), nil
+}
+
+// build returns the subtree of the given leaves, sorted by path, at the given
+// depth.
+func (t *SmtTree) build(d int, leaves []*node) *node {
+	switch len(leaves) {
+	case 0:
+		return nil
+
+	case 1:
+		return leaves[0]
+	}
+
+	split := sort.Search(len(leaves), func(i int) bool { return bit(leaves[i].path, d) == 1 })
+
+	return newInner(t.nextVersion(), t.build(d+1, leaves[:split]), t.build(d+1, leaves[split:]))
+}
+
+// newLeaves returns the new leaves of the given writes, omitting removals.
+func (t *SmtTree) newLeaves(ops []pendingOp) []*node {
+	leaves := make([]*node, 0, len(ops))
+	for _, op := range ops {
+		if !op.remove {
+			leaves = append(leaves, newLeaf(t.nextVersion(), op.key, op.value))
+		}
+	}
+
+	return leaves
+}
+
+// orphan records the given node as orphaned by the working tree if it has been
+// persisted.
+func (t *SmtTree) orphan(n *node) {
+	if n.persisted {
+		t.orphans = append(t.orphans, n)
+	}
+}
+
+// writeNodes writes all the nodes of the given subtree which are not persisted
+// yet to the batch.
+func (t *SmtTree) writeNodes(batch store.RawBatch, n *node) error {
+	if n == nil || n.persisted {
+		return nil
+	}
+
+	bz, err := n.encode()
+	if err != nil {
+		return err
+	}
+	if err := batch.Set(nodeKey(n.version, n.hash), bz); err != nil {
+		return err
+	}
+	n.persisted = true
+
+	if n.isLeaf() {
+		return nil
+	}
+
+	if err := t.writeNodes(batch, n.left); err != nil {
+		return err
+	}
+
+	return t.writeNodes(batch, n.right)
+}
+
+func rootKey(version uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = rootPrefix
+	binary.BigEndian.PutUint64(key[1:], version)
+
+	return key
+}
+
+func orphanKey(orphanedVersion uint64, n *node) []byte {
+	key := make([]byte, 1+8+8+len(n.hash))
+	key[0] = orphanPrefix
+	binary.BigEndian.PutUint64(key[1:], orphanedVersion)
+	binary.BigEndian.PutUint64(key[9:], n.version)
+	copy(key[17:], n.hash)
+
+	return key
+}
+
+// deleteRange adds the deletion of all keys in the range [start, end) to the
+// batch.
+func deleteRange(db store.RawDB, batch store.RawBatch, start, end []byte) (err error) {
+	itr, err := db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, itr.Close())
+	}()
+
+	for ; itr.Valid(); itr.Next() {
+		if err := batch.Delete(bytes.Clone(itr.Key())); err != nil {
+			return err
+		}
+	}
+
+	return itr.Error()
+}
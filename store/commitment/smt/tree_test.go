@@ -0,0 +1,257 @@
+package smt
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	dbm "cosmossdk.io/store/v2/db"
+)
+
+func TestCommitterSuite(t *testing.T) {
+	s := &commitment.CommitStoreTestSuite{
+		NewStore: func(db store.RawDB, storeKeys []string, pruneOpts *store.PruneOptions, logger log.Logger) (*commitment.CommitStore, error) {
+			multiTrees := make(map[string]commitment.Tree)
+			for _, storeKey := range storeKeys {
+				prefixDB := dbm.NewPrefixDB(db, []byte(storeKey))
+				tree, err := NewSmtTree(prefixDB, logger)
+				if err != nil {
+					return nil, err
+				}
+				multiTrees[storeKey] = tree
+			}
+			return commitment.NewCommitStore(multiTrees, db, pruneOpts, logger)
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func generateTree(t *testing.T, db store.RawDB) *SmtTree {
+	t.Helper()
+
+	tree, err := NewSmtTree(db, log.NewNopLogger())
+	require.NoError(t, err)
+
+	return tree
+}
+
+func TestSmtTree(t *testing.T) {
+	// generate a new tree
+	tree := generateTree(t, dbm.NewMemDB())
+	require.NotNil(t, tree)
+
+	initVersion := tree.GetLatestVersion()
+	require.Equal(t, uint64(0), initVersion)
+
+	// write a batch of version 1
+	require.NoError(t, tree.Set([]byte("key1"), []byte("value1")))
+	require.NoError(t, tree.Set([]byte("key2"), []byte("value2")))
+	require.NoError(t, tree.Set([]byte("key3"), []byte("value3")))
+
+	workingHash := tree.WorkingHash()
+	require.NotNil(t, workingHash)
+	require.Equal(t, uint64(0), tree.GetLatestVersion())
+
+	// commit the batch
+	commitHash, version, err := tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, version, uint64(1))
+	require.Equal(t, workingHash, commitHash)
+	require.Equal(t, uint64(1), tree.GetLatestVersion())
+
+	// ensure we can get expected values
+	bz, err := tree.Get(1, []byte("key1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), bz)
+
+	bz, err = tree.Get(2, []byte("key1"))
+	require.Error(t, err)
+	require.Nil(t, bz)
+
+	// removing a non-existent key must fail
+	require.Error(t, tree.Remove([]byte("key0")))
+
+	// write a batch of version 2
+	require.NoError(t, tree.Set([]byte("key4"), []byte("value4")))
+	require.NoError(t, tree.Set([]byte("key5"), []byte("value5")))
+	require.NoError(t, tree.Set([]byte("key6"), []byte("value6")))
+	require.NoError(t, tree.Remove([]byte("key1"))) // delete key1
+	version2Hash := tree.WorkingHash()
+	require.NotNil(t, version2Hash)
+	commitHash, version, err = tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, version, uint64(2))
+	require.Equal(t, version2Hash, commitHash)
+
+	// get proof for key1
+	proof, err := tree.GetProof(1, []byte("key1"))
+	require.NoError(t, err)
+	require.NotNil(t, proof.GetExist())
+
+	proof, err = tree.GetProof(2, []byte("key1"))
+	require.NoError(t, err)
+	require.NotNil(t, proof.GetNonexist())
+
+	// write a batch of version 3
+	require.NoError(t, tree.Set([]byte("key7"), []byte("value7")))
+	require.NoError(t, tree.Set([]byte("key8"), []byte("value8")))
+	_, _, err = tree.Commit()
+	require.NoError(t, err)
+
+	// prune version 1
+	err = tree.Prune(1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), tree.GetLatestVersion())
+	err = tree.LoadVersion(1)
+	require.Error(t, err)
+
+	// load version 2
+	err = tree.LoadVersion(2)
+	require.NoError(t, err)
+	require.Equal(t, version2Hash, tree.WorkingHash())
+
+	// close the db
+	require.NoError(t, tree.Close())
+}
+
+func TestSmtTreeReopen(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := generateTree(t, db)
+
+	require.NoError(t, tree.SetInitialVersion(5))
+	require.NoError(t, tree.Set([]byte("key1"), []byte("value1")))
+	hash, version, err := tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), version)
+
+	require.NoError(t, tree.Set([]byte("key2"), []byte("value2")))
+	_, version, err = tree.Commit()
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), version)
+
+	// rollback to version 5 and reopen the tree
+	require.NoError(t, tree.LoadVersion(5))
+	require.NoError(t, tree.Close())
+
+	tree = generateTree(t, db)
+	require.Equal(t, uint64(5), tree.GetLatestVersion())
+	require.Equal(t, hash, tree.Hash())
+
+	bz, err := tree.Get(5, []byte("key1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), bz)
+	_, err = tree.Get(6, []byte("key2"))
+	require.Error(t, err)
+}
+
+func TestSmtTreeCanonicalHash(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	keys := make([][]byte, 200)
+	for i := range keys {
+		keys[i] = []byte(fmt.Sprintf("key%03d", i))
+	}
+
+	// a tree built over multiple versions with removals must be equal to a tree
+	// built at once from the remaining keys
+	tree := generateTree(t, dbm.NewMemDB())
+	for _, i := range r.Perm(len(keys)) {
+		require.NoError(t, tree.Set(keys[i], keys[i]))
+		if r.Intn(10) == 0 {
+			_, _, err := tree.Commit()
+			require.NoError(t, err)
+		}
+	}
+	_, _, err := tree.Commit()
+	require.NoError(t, err)
+
+	for i := 0; i < len(keys); i += 2 {
+		require.NoError(t, tree.Remove(keys[i]))
+		if r.Intn(10) == 0 {
+			_, _, err := tree.Commit()
+			require.NoError(t, err)
+		}
+	}
+	hash, _, err := tree.Commit()
+	require.NoError(t, err)
+
+	expected := generateTree(t, dbm.NewMemDB())
+	for i := 1; i < len(keys); i += 2 {
+		require.NoError(t, expected.Set(keys[i], keys[i]))
+	}
+	require.Equal(t, expected.WorkingHash(), hash)
+
+	// removing all keys results in the empty tree
+	for i := 1; i < len(keys); i += 2 {
+		require.NoError(t, tree.Remove(keys[i]))
+	}
+	require.Equal(t, placeholder, tree.WorkingHash())
+}
+
+func TestSmtTreeProofs(t *testing.T) {
+	tree := generateTree(t, dbm.NewMemDB())
+
+	// proofs cannot be created for an empty tree
+	_, err := tree.GetProof(0, []byte("key"))
+	require.Error(t, err)
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, tree.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%03d", i))))
+	}
+	root, version, err := tree.Commit()
+	require.NoError(t, err)
+
+	for i := 0; i < 100; i++ {
+		key := []byte(fmt.Sprintf("key%03d", i))
+		proof, err := tree.GetProof(version, key)
+		require.NoError(t, err)
+		require.True(t, ics23.VerifyMembership(ics23.SmtSpec, root, proof, key, []byte(fmt.Sprintf("value%03d", i))))
+		require.False(t, ics23.VerifyMembership(ics23.SmtSpec, root, proof, key, []byte("invalid")))
+
+		absent := []byte(fmt.Sprintf("absent%03d", i))
+		proof, err = tree.GetProof(version, absent)
+		require.NoError(t, err)
+		require.NotNil(t, proof.GetNonexist())
+		require.True(t, ics23.VerifyNonMembership(ics23.SmtSpec, root, proof, absent))
+	}
+
+	// a tree with a single leaf
+	tree = generateTree(t, dbm.NewMemDB())
+	require.NoError(t, tree.Set([]byte("key"), []byte("value")))
+	root, version, err = tree.Commit()
+	require.NoError(t, err)
+
+	proof, err := tree.GetProof(version, []byte("key"))
+	require.NoError(t, err)
+	require.True(t, ics23.VerifyMembership(ics23.SmtSpec, root, proof, []byte("key"), []byte("value")))
+
+	proof, err = tree.GetProof(version, []byte("absent"))
+	require.NoError(t, err)
+	require.True(t, ics23.VerifyNonMembership(ics23.SmtSpec, root, proof, []byte("absent")))
+}
+
+func BenchmarkSmtTreeCommit(b *testing.B) {
+	tree, err := NewSmtTree(dbm.NewMemDB(), log.NewNopLogger())
+	require.NoError(b, err)
+
+	r := rand.New(rand.NewSource(1))
+	key := make([]byte, 32)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < 100; j++ {
+			r.Read(key)
+			require.NoError(b, tree.Set(append([]byte(nil), key...), key))
+		}
+		_, _, err := tree.Commit()
+		require.NoError(b, err)
+	}
+}
@@ -6,12 +6,16 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"runtime"
+	"sort"
 
 	protoio "github.com/cosmos/gogoproto/io"
+	"golang.org/x/sync/errgroup"
 
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
 	internal "cosmossdk.io/store/v2/internal/conv"
 	"cosmossdk.io/store/v2/internal/encoding"
 	"cosmossdk.io/store/v2/proof"
@@ -27,6 +31,8 @@ const (
 var (
 	_ store.Committer             = (*CommitStore)(nil)
 	_ snapshots.CommitSnapshotter = (*CommitStore)(nil)
+	_ store.LimitedPruner         = (*CommitStore)(nil)
+	_ store.TreeRetainer          = (*CommitStore)(nil)
 )
 
 // CommitStore is a wrapper around multiple Tree objects mapped by a unique store
@@ -38,9 +44,18 @@ type CommitStore struct {
 	logger     log.Logger
 	db         store.RawDB
 	multiTrees map[string]Tree
+	// storeKeys are the store keys of multiTrees in lexicographical order
+	storeKeys []string
+
+	// hashConcurrency is the maximum number of trees hashed concurrently.
+	hashConcurrency int
 
 	// pruneOptions is the pruning configuration.
 	pruneOptions *store.PruneOptions
+
+	// retainedTrees are the store keys of the trees which are not pruned along
+	// with the other trees, see RetainTrees.
+	retainedTrees map[string]struct{}
 }
 
 // NewCommitStore creates a new CommitStore instance.
@@ -49,14 +64,59 @@ func NewCommitStore(trees map[string]Tree, db store.RawDB, pruneOpts *store.Prun
 		pruneOpts = store.DefaultPruneOptions()
 	}
 
+	storeKeys := make([]string, 0, len(trees))
+	for storeKey := range trees {
+		storeKeys = append(storeKeys, storeKey)
+	}
+	sort.Strings(storeKeys)
+
 	return &CommitStore{
-		logger:       logger,
-		db:           db,
-		multiTrees:   trees,
-		pruneOptions: pruneOpts,
+		logger:          logger,
+		db:              db,
+		multiTrees:      trees,
+		storeKeys:       storeKeys,
+		hashConcurrency: runtime.GOMAXPROCS(0),
+		pruneOptions:    pruneOpts,
 	}, nil
 }
 
+// SetHashConcurrency sets the maximum number of trees hashed concurrently when
+// computing the working commit info or committing, which defaults to GOMAXPROCS.
+// A value of 1 hashes the trees sequentially.
+func (c *CommitStore) SetHashConcurrency(n int) {
+	if n < 1 {
+		n = 1
+	}
+
+	c.hashConcurrency = n
+}
+
+// forEachTree calls fn for every tree, concurrently up to hashConcurrency trees,
+// with the index of its store key in storeKeys. It returns the first error
+// returned by fn, if any.
+func (c *CommitStore) forEachTree(fn func(i int, tree Tree) error) error {
+	if c.hashConcurrency <= 1 || len(c.storeKeys) <= 1 {
+		for i, storeKey := range c.storeKeys {
+			if err := fn(i, c.multiTrees[storeKey]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var eg errgroup.Group
+	eg.SetLimit(c.hashConcurrency)
+	for i, storeKey := range c.storeKeys {
+		i, tree := i, c.multiTrees[storeKey]
+		eg.Go(func() error {
+			return fn(i, tree)
+		})
+	}
+
+	return eg.Wait()
+}
+
 func (c *CommitStore) WriteBatch(cs *corestore.Changeset) error {
 	for _, pairs := range cs.Changes {
 
@@ -67,7 +127,15 @@ func (c *CommitStore) WriteBatch(cs *corestore.Changeset) error {
 			return fmt.Errorf("store key %s not found in multiTrees", key)
 		}
 		for _, kv := range pairs.StateChanges {
-			if kv.Remove {
+			if kv.IsRangeDelete() {
+				remover, ok := tree.(RangeRemover)
+				if !ok {
+					return fmt.Errorf("store key %s: %w", key, ErrRangeRemovalUnsupported)
+				}
+				if err := remover.RemoveRange(kv.Key, kv.RangeEnd); err != nil {
+					return err
+				}
+			} else if kv.Remove {
 				if err := tree.Remove(kv.Key); err != nil {
 					return err
 				}
@@ -80,18 +148,22 @@ func (c *CommitStore) WriteBatch(cs *corestore.Changeset) error {
 	return nil
 }
 
+// WorkingCommitInfo returns the commit info of the working trees at the given
+// version, with the store infos sorted by store key. The trees are hashed
+// concurrently.
 func (c *CommitStore) WorkingCommitInfo(version uint64) *proof.CommitInfo {
-	storeInfos := make([]proof.StoreInfo, 0, len(c.multiTrees))
-	for storeKey, tree := range c.multiTrees {
-		bz := []byte(storeKey)
-		storeInfos = append(storeInfos, proof.StoreInfo{
-			Name: bz,
+	storeInfos := make([]proof.StoreInfo, len(c.storeKeys))
+	_ = c.forEachTree(func(i int, tree Tree) error {
+		storeInfos[i] = proof.StoreInfo{
+			Name: []byte(c.storeKeys[i]),
 			CommitID: proof.CommitID{
 				Version: version,
 				Hash:    tree.WorkingHash(),
 			},
-		})
-	}
+		}
+
+		return nil
+	})
 
 	return &proof.CommitInfo{
 		Version:    version,
@@ -194,10 +266,11 @@ func (c *CommitStore) flushCommitInfo(version uint64, cInfo *proof.CommitInfo) e
 	return batch.WriteSync()
 }
 
+// Commit commits all the trees concurrently and flushes the resulting commit
+// info, with the store infos sorted by store key.
 func (c *CommitStore) Commit(version uint64) (*proof.CommitInfo, error) {
-	storeInfos := make([]proof.StoreInfo, 0, len(c.multiTrees))
-
-	for storeKey, tree := range c.multiTrees {
+	storeInfos := make([]proof.StoreInfo, len(c.storeKeys))
+	err := c.forEachTree(func(i int, tree Tree) error {
 		// If a commit event execution is interrupted, a new iavl store's version
 		// will be larger than the RMS's metadata, when the block is replayed, we
 		// should avoid committing that iavl store again.
@@ -208,17 +281,22 @@ func (c *CommitStore) Commit(version uint64) (*proof.CommitInfo, error) {
 		} else {
 			hash, version, err := tree.Commit()
 			if err != nil {
-				return nil, err
+				return fmt.Errorf("failed to commit store %s: %w", c.storeKeys[i], err)
 			}
 			commitID = proof.CommitID{
 				Version: version,
 				Hash:    hash,
 			}
 		}
-		storeInfos = append(storeInfos, proof.StoreInfo{
-			Name:     []byte(storeKey),
+		storeInfos[i] = proof.StoreInfo{
+			Name:     []byte(c.storeKeys[i]),
 			CommitID: commitID,
-		})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	cInfo := &proof.CommitInfo{
@@ -256,24 +334,77 @@ func (c *CommitStore) GetProof(storeKey []byte, version uint64, key []byte) ([]p
 		return nil, fmt.Errorf("store %s not found", storeKey)
 	}
 
-	iProof, err := tree.GetProof(version, key)
-	if err != nil {
-		return nil, err
-	}
 	cInfo, err := c.GetCommitInfo(version)
 	if err != nil {
 		return nil, err
 	}
 	if cInfo == nil {
-		return nil, fmt.Errorf("commit info not found for version %d", version)
+		return nil, c.missingVersionErr(version)
+	}
+	commitOp, err := getTreeProof(tree, version, key)
+	if err != nil {
+		return nil, err
 	}
-	commitOp := proof.NewIAVLCommitmentOp(key, iProof)
 	_, storeCommitmentOp, err := cInfo.GetStoreProof(storeKey)
 	if err != nil {
 		return nil, err
 	}
 
-	return []proof.CommitmentOp{commitOp, *storeCommitmentOp}, nil
+	return []proof.CommitmentOp{*commitOp, *storeCommitmentOp}, nil
+}
+
+// GetTreeProof implements store.TreeRetainer. Unlike GetProof, it does not
+// require the CommitInfo of the version, only the tree version.
+func (c *CommitStore) GetTreeProof(storeKey []byte, version uint64, key []byte) (*proof.CommitmentOp, error) {
+	tree, ok := c.multiTrees[internal.UnsafeBytesToStr(storeKey)]
+	if !ok {
+		return nil, fmt.Errorf("store %s not found", storeKey)
+	}
+
+	return getTreeProof(tree, version, key)
+}
+
+func getTreeProof(tree Tree, version uint64, key []byte) (*proof.CommitmentOp, error) {
+	iProof, err := tree.GetProof(version, key)
+	if err != nil {
+		return nil, err
+	}
+	commitOp := proof.NewIAVLCommitmentOp(key, iProof)
+	if ps, ok := tree.(ProofSpecifier); ok && ps.ProofType() == proof.ProofOpSMTCommitment {
+		commitOp = proof.NewSMTCommitmentOp(key, iProof)
+	}
+
+	return &commitOp, nil
+}
+
+// missingVersionErr returns the error for a version whose commit info is not
+// found, i.e. ErrVersionPruned if the version is below the latest version.
+func (c *CommitStore) missingVersionErr(version uint64) error {
+	latestVersion, err := c.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if version > latestVersion {
+		return fmt.Errorf("commit info not found for version %d", version)
+	}
+
+	// the commit infos are pruned up to a version, so the retained ones form a
+	// contiguous range ending at the latest version
+	lo, hi := version+1, latestVersion
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		exist, err := c.db.Has([]byte(fmt.Sprintf(commitInfoKeyFmt, mid)))
+		if err != nil {
+			return err
+		}
+		if exist {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	return storeerrors.ErrVersionPruned{EarliestVersion: lo}
 }
 
 func (c *CommitStore) Get(storeKey []byte, version uint64, key []byte) ([]byte, error) {
@@ -284,13 +415,22 @@ func (c *CommitStore) Get(storeKey []byte, version uint64, key []byte) ([]byte,
 
 	bz, err := tree.Get(version, key)
 	if err != nil {
+		if cInfo, _ := c.GetCommitInfo(version); cInfo == nil {
+			return nil, c.missingVersionErr(version)
+		}
 		return nil, fmt.Errorf("failed to get key %s from store %s: %w", key, storeKey, err)
 	}
 
 	return bz, nil
 }
 
-func (c *CommitStore) Prune(version uint64) (ferr error) {
+func (c *CommitStore) Prune(version uint64) error {
+	return c.PruneLimited(version, nil)
+}
+
+// PruneLimited implements store.LimitedPruner. Trees which do not support
+// throttling, e.g. IAVL trees, are pruned regardless of wait.
+func (c *CommitStore) PruneLimited(version uint64, wait func(n int) error) (ferr error) {
 	// prune the metadata
 	batch := c.db.NewBatch()
 	for v := version; v > 0; v-- {
@@ -306,8 +446,18 @@ func (c *CommitStore) Prune(version uint64) (ferr error) {
 		return err
 	}
 
-	for _, tree := range c.multiTrees {
-		if err := tree.Prune(version); err != nil {
+	for storeKey, tree := range c.multiTrees {
+		if _, ok := c.retainedTrees[storeKey]; ok {
+			continue
+		}
+
+		var err error
+		if pruner, ok := tree.(store.LimitedPruner); ok && wait != nil {
+			err = pruner.PruneLimited(version, wait)
+		} else {
+			err = tree.Prune(version)
+		}
+		if err != nil {
 			ferr = errors.Join(ferr, err)
 		}
 	}
@@ -315,6 +465,30 @@ func (c *CommitStore) Prune(version uint64) (ferr error) {
 	return ferr
 }
 
+// RetainTrees implements store.TreeRetainer.
+func (c *CommitStore) RetainTrees(storeKeys ...[]byte) error {
+	retainedTrees := make(map[string]struct{}, len(storeKeys))
+	for _, storeKey := range storeKeys {
+		if _, ok := c.multiTrees[string(storeKey)]; !ok {
+			return fmt.Errorf("store %s not found", storeKey)
+		}
+		retainedTrees[string(storeKey)] = struct{}{}
+	}
+	c.retainedTrees = retainedTrees
+
+	return nil
+}
+
+// PruneTree implements store.TreeRetainer.
+func (c *CommitStore) PruneTree(storeKey []byte, version uint64) error {
+	tree, ok := c.multiTrees[internal.UnsafeBytesToStr(storeKey)]
+	if !ok {
+		return fmt.Errorf("store %s not found", storeKey)
+	}
+
+	return tree.Prune(version)
+}
+
 // Snapshot implements snapshotstypes.CommitSnapshotter.
 func (c *CommitStore) Snapshot(version uint64, protoWriter protoio.Writer) error {
 	if version == 0 {
@@ -2,6 +2,7 @@ package commitment
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"sync"
@@ -161,3 +162,103 @@ func (s *CommitStoreTestSuite) TestStore_Pruning() {
 		}
 	}
 }
+
+func (s *CommitStoreTestSuite) TestStore_ParallelHashing() {
+	storeKeys := make([]string, 8)
+	for i := range storeKeys {
+		storeKeys[i] = fmt.Sprintf("store%d", len(storeKeys)-i)
+	}
+
+	sequential, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, log.NewNopLogger())
+	s.Require().NoError(err)
+	sequential.SetHashConcurrency(1)
+	parallel, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, log.NewNopLogger())
+	s.Require().NoError(err)
+	parallel.SetHashConcurrency(4)
+
+	for v := uint64(1); v <= 5; v++ {
+		kvPairs := make(map[string]corestore.KVPairs)
+		for _, storeKey := range storeKeys {
+			for j := 0; j < 20; j++ {
+				key := []byte(fmt.Sprintf("key-%d-%d", v, j))
+				value := []byte(fmt.Sprintf("value-%s-%d-%d", storeKey, v, j))
+				kvPairs[storeKey] = append(kvPairs[storeKey], corestore.KVPair{Key: key, Value: value})
+			}
+		}
+		cs := corestore.NewChangesetWithPairs(kvPairs)
+		s.Require().NoError(sequential.WriteBatch(cs))
+		s.Require().NoError(parallel.WriteBatch(cs))
+
+		// the store infos are sorted by store key
+		workingInfo := parallel.WorkingCommitInfo(v)
+		s.Require().Len(workingInfo.StoreInfos, len(storeKeys))
+		for i := 1; i < len(workingInfo.StoreInfos); i++ {
+			s.Require().Equal(-1, bytes.Compare(workingInfo.StoreInfos[i-1].Name, workingInfo.StoreInfos[i].Name))
+		}
+		s.Require().Equal(sequential.WorkingCommitInfo(v), workingInfo)
+
+		expected, err := sequential.Commit(v)
+		s.Require().NoError(err)
+		actual, err := parallel.Commit(v)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, actual)
+		s.Require().Equal(workingInfo.Hash(), actual.Hash())
+	}
+}
+
+func (s *CommitStoreTestSuite) TestStore_DeleteRange() {
+	storeKeys := []string{storeKey1, storeKey2}
+	ranged, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, log.NewNopLogger())
+	s.Require().NoError(err)
+	removed, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, log.NewNopLogger())
+	s.Require().NoError(err)
+
+	cs := corestore.NewChangeset()
+	for _, storeKey := range storeKeys {
+		for i := 0; i < 20; i++ {
+			cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%03d", i)), false)
+		}
+	}
+	s.Require().NoError(ranged.WriteBatch(cs))
+	s.Require().NoError(removed.WriteBatch(cs))
+	_, err = ranged.Commit(1)
+	s.Require().NoError(err)
+	_, err = removed.Commit(1)
+	s.Require().NoError(err)
+
+	// removing a range must be equivalent to removing each key in the range
+	rangedCS := corestore.NewChangeset()
+	rangedCS.AddDeleteRange([]byte(storeKey1), []byte("key005"), []byte("key015"))
+	rangedCS.Add([]byte(storeKey1), []byte("key007"), []byte("updated"), false)
+	removedCS := corestore.NewChangeset()
+	for i := 5; i < 15; i++ {
+		removedCS.Add([]byte(storeKey1), []byte(fmt.Sprintf("key%03d", i)), nil, true)
+	}
+	removedCS.Add([]byte(storeKey1), []byte("key007"), []byte("updated"), false)
+	err = ranged.WriteBatch(rangedCS)
+	if errors.Is(err, ErrRangeRemovalUnsupported) {
+		s.T().Skip("the trees do not support range deletions")
+	}
+	s.Require().NoError(err)
+	s.Require().NoError(removed.WriteBatch(removedCS))
+
+	expected, err := removed.Commit(2)
+	s.Require().NoError(err)
+	actual, err := ranged.Commit(2)
+	s.Require().NoError(err)
+	s.Require().Equal(expected.Hash(), actual.Hash())
+
+	for i := 0; i < 20; i++ {
+		key := []byte(fmt.Sprintf("key%03d", i))
+		bz, err := ranged.Get([]byte(storeKey1), 2, key)
+		s.Require().NoError(err)
+		switch {
+		case i == 7:
+			s.Require().Equal([]byte("updated"), bz)
+		case i >= 5 && i < 15:
+			s.Require().Nil(bz)
+		default:
+			s.Require().Equal([]byte(fmt.Sprintf("value%03d", i)), bz)
+		}
+	}
+}
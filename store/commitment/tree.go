@@ -9,8 +9,14 @@ import (
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 )
 
-// ErrorExportDone is returned by Exporter.Next() when all items have been exported.
-var ErrorExportDone = errors.New("export is complete")
+var (
+	// ErrorExportDone is returned by Exporter.Next() when all items have been exported.
+	ErrorExportDone = errors.New("export is complete")
+
+	// ErrRangeRemovalUnsupported is returned when a range deletion is written to a
+	// tree which does not implement RangeRemover.
+	ErrRangeRemovalUnsupported = errors.New("range deletion not supported")
+)
 
 // Tree is the interface that wraps the basic Tree methods.
 type Tree interface {
@@ -42,6 +48,23 @@ type Tree interface {
 	io.Closer
 }
 
+// ProofSpecifier is an optional interface a Tree can implement to report the
+// type of the commitment proofs it generates, e.g. proof.ProofOpSMTCommitment.
+// Trees not implementing it are assumed to generate IAVL proofs.
+type ProofSpecifier interface {
+	ProofType() string
+}
+
+// RangeRemover is an optional interface a Tree can implement to remove all the
+// keys in the range [start, end) of its working state, including the keys set
+// since the last commit. It is required to apply changesets containing range
+// deletions. Trees whose leaves are not ordered by key, e.g. SMT, do not
+// implement it as they would be traversed entirely, the root store resolves the
+// range deletions into the removal of every key of the range from SS instead.
+type RangeRemover interface {
+	RemoveRange(start, end []byte) error
+}
+
 // Exporter is the interface that wraps the basic Export methods.
 type Exporter interface {
 	Next() (*snapshotstypes.SnapshotIAVLItem, error)
@@ -32,6 +32,13 @@ type Writer interface {
 	//
 	// Note: <key> is safe to modify and read after calling Delete.
 	Delete(storeKey, key []byte) error
+
+	// DeleteRange removes all the keys in the range [start, end) of the store
+	// key, including the keys set earlier through the same Writer. Start and end
+	// must not be empty.
+	//
+	// Note: <start, end> are safe to modify and read after calling DeleteRange.
+	DeleteRange(storeKey, start, end []byte) error
 }
 
 // Database contains all the methods required to allow handling different
@@ -56,6 +63,14 @@ type VersionedDatabase interface {
 
 	ApplyChangeset(version uint64, cs *corestore.Changeset) error
 
+	// KeyHistory returns the changes of the given key committed between
+	// fromVersion and toVersion, both inclusive, in ascending order of version.
+	// At most limit changes are returned, a zero limit returning all of them,
+	// except that the changes committed at the same version are never split. If
+	// changes remain in the range, nextVersion is the version of the next one.
+	// It returns an ErrVersionPruned error if fromVersion is pruned.
+	KeyHistory(storeKey, key []byte, fromVersion, toVersion uint64, limit int) (changes []KeyChange, nextVersion uint64, err error)
+
 	// Prune attempts to prune all versions up to and including the provided
 	// version argument. The operation should be idempotent. An error should be
 	// returned upon failure.
@@ -66,6 +81,14 @@ type VersionedDatabase interface {
 	io.Closer
 }
 
+// KeyChange defines a change of a key committed at a given version. A removed
+// key has no value.
+type KeyChange struct {
+	Version uint64
+	Value   []byte
+	Deleted bool
+}
+
 // Committer defines an API for committing state.
 type Committer interface {
 	// WriteBatch writes a batch of key-value pairs to the tree.
@@ -108,6 +131,31 @@ type Committer interface {
 	io.Closer
 }
 
+// LimitedPruner is an optional interface an SS or SC backend can implement to
+// allow the caller to throttle pruning. Prior to deleting a chunk of keys, the
+// backend calls wait with the number of keys it is about to delete, and aborts
+// pruning if wait returns an error. A nil wait function does not throttle.
+type LimitedPruner interface {
+	PruneLimited(version uint64, wait func(n int) error) error
+}
+
+// TreeRetainer is an optional interface an SC backend can implement to retain
+// the versions of some of its trees beyond its own pruning, so that key-level
+// proofs can be built for versions whose CommitInfo has been pruned.
+type TreeRetainer interface {
+	// RetainTrees exempts the trees of the given store keys from Prune, they are
+	// only pruned by PruneTree.
+	RetainTrees(storeKeys ...[]byte) error
+
+	// PruneTree prunes all versions of the tree of the given store key up to and
+	// including the provided version.
+	PruneTree(storeKey []byte, version uint64) error
+
+	// GetTreeProof returns the key-level proof of existence or non-existence for
+	// the given key in the tree of the given store key at the given version.
+	GetTreeProof(storeKey []byte, version uint64, key []byte) (*proof.CommitmentOp, error)
+}
+
 // RawDB is the main interface for all key-value database backends. DBs are concurrency-safe.
 // Callers must call Close on the database when done.
 //
@@ -38,6 +38,10 @@ var (
 
 	// ErrValueNil is returned when attempting to set a nil value.
 	ErrValueNil = errors.New("value nil")
+
+	// ErrProofUnavailable is returned when no commitment proof can be served for
+	// a key at a given version, e.g. from the historical proofs retained in SS.
+	ErrProofUnavailable = errors.New("proof unavailable")
 )
 
 // ErrVersionPruned defines an error returned when a version queried is pruned
@@ -47,6 +47,19 @@ func TestChangesetMarshal(t *testing.T) {
 			encodedSize:  1 + 1 + 8 + 1 + 1 + 3 + 1,
 			encodedBytes: []byte{0x1, 0x8, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x6b, 0x65, 0x79, 0x1, 0x3, 0x6b, 0x65, 0x79, 0x1},
 		},
+		{
+			name: "one range remove store",
+			changeset: &corestore.Changeset{Changes: []corestore.StateChanges{
+				{
+					Actor: []byte("storekey"),
+					StateChanges: corestore.KVPairs{
+						{Key: []byte("a"), Remove: true, RangeEnd: []byte("b")},
+					},
+				},
+			}},
+			encodedSize:  1 + 1 + 8 + 1 + 1 + 1 + 1 + 1 + 1,
+			encodedBytes: []byte{0x1, 0x8, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x6b, 0x65, 0x79, 0x1, 0x1, 0x61, 0x2, 0x1, 0x62},
+		},
 		{
 			name: "two stores",
 			changeset: &corestore.Changeset{Changes: []corestore.StateChanges{
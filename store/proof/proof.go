@@ -1,12 +1,15 @@
 package proof
 
 import (
+	"bytes"
 	"crypto/sha256"
+	"fmt"
 
 	ics23 "github.com/cosmos/ics23/go"
 
 	"cosmossdk.io/errors"
 	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/internal/encoding"
 )
 
 // Proof operation types
@@ -84,6 +87,90 @@ func (op CommitmentOp) GetKey() []byte {
 	return op.Key
 }
 
+// Marshal returns the encoded byte representation of CommitmentOp.
+// NOTE: CommitmentOp is encoded as follows:
+// - type (bytes)
+// - key (bytes)
+// - proof (bytes), i.e. the protobuf encoded ics23 CommitmentProof
+//
+// Spec is not encoded as it is derived from the type.
+func (op CommitmentOp) Marshal() ([]byte, error) {
+	proofBz, err := op.Proof.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(encoding.EncodeBytesSize([]byte(op.Type)) + encoding.EncodeBytesSize(op.Key) + encoding.EncodeBytesSize(proofBz))
+
+	if err := encoding.EncodeBytes(&buf, []byte(op.Type)); err != nil {
+		return nil, err
+	}
+	if err := encoding.EncodeBytes(&buf, op.Key); err != nil {
+		return nil, err
+	}
+	if err := encoding.EncodeBytes(&buf, proofBz); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal unmarshals the encoded byte representation of CommitmentOp. The
+// Spec is set based on the decoded type.
+func (op *CommitmentOp) Unmarshal(buf []byte) error {
+	typ, n, err := encoding.DecodeBytes(buf)
+	if err != nil {
+		return err
+	}
+	buf = buf[n:]
+
+	key, n, err := encoding.DecodeBytes(buf)
+	if err != nil {
+		return err
+	}
+	buf = buf[n:]
+
+	proofBz, _, err := encoding.DecodeBytes(buf)
+	if err != nil {
+		return err
+	}
+
+	spec, err := SpecFromType(string(typ))
+	if err != nil {
+		return err
+	}
+
+	commitmentProof := &ics23.CommitmentProof{}
+	if err := commitmentProof.Unmarshal(proofBz); err != nil {
+		return err
+	}
+
+	op.Type = string(typ)
+	op.Key = key
+	op.Spec = spec
+	op.Proof = commitmentProof
+
+	return nil
+}
+
+// SpecFromType returns the ics23 proof spec for the given proof operation type.
+func SpecFromType(typ string) (*ics23.ProofSpec, error) {
+	switch typ {
+	case ProofOpIAVLCommitment:
+		return ics23.IavlSpec, nil
+
+	case ProofOpSimpleMerkleCommitment:
+		return SimpleMerkleSpec, nil
+
+	case ProofOpSMTCommitment:
+		return ics23.SmtSpec, nil
+
+	default:
+		return nil, fmt.Errorf("unknown proof operation type: %s", typ)
+	}
+}
+
 // Run takes in a list of arguments and attempts to run the proof op against these
 // arguments. Returns the root wrapped in [][]byte if the proof op succeeds with
 // given args. If not, it will return an error.
@@ -59,3 +59,34 @@ func TestProofFromBytesSlices(t *testing.T) {
 
 	}
 }
+
+func TestCommitmentOpMarshal(t *testing.T) {
+	leaves := make([][]byte, 2)
+	for i, key := range []string{"key1", "key2"} {
+		var err error
+		leaves[i], err = LeafHash([]byte(key), []byte("value"))
+		require.NoError(t, err)
+	}
+
+	rootHash, inners := ProofFromByteSlices(leaves, 1)
+	op := ConvertCommitmentOp(inners, []byte("key2"), []byte("value"))
+
+	bz, err := op.Marshal()
+	require.NoError(t, err)
+
+	var decoded CommitmentOp
+	require.NoError(t, decoded.Unmarshal(bz))
+	require.Equal(t, op.Type, decoded.Type)
+	require.Equal(t, op.Key, decoded.Key)
+	require.Equal(t, op.Spec, decoded.Spec)
+
+	root, err := decoded.Run([][]byte{[]byte("value")})
+	require.NoError(t, err)
+	require.Equal(t, rootHash, root[0])
+
+	// unknown proof types must be rejected
+	op.Type = "unknown"
+	bz, err = op.Marshal()
+	require.NoError(t, err)
+	require.Error(t, decoded.Unmarshal(bz))
+}
@@ -0,0 +1,55 @@
+package pruning
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// limiter limits the rate of deleted keys. The keys of a wait call are spread
+// evenly over time, i.e. a call blocks until the time slot reserved for its keys
+// has elapsed.
+type limiter struct {
+	mtx sync.Mutex
+	// perKey is the time slot of a single key
+	perKey time.Duration
+	// next is the end of the last reserved time slot
+	next time.Time
+}
+
+// newLimiter returns a limiter of the given rate, a nil limiter does not limit.
+func newLimiter(keysPerSecond int) *limiter {
+	if keysPerSecond <= 0 {
+		return nil
+	}
+
+	return &limiter{perKey: time.Second / time.Duration(keysPerSecond)}
+}
+
+// wait blocks until the given number of keys may be deleted or the context is
+// canceled.
+func (l *limiter) wait(ctx context.Context, n int) error {
+	if l == nil || l.perKey == 0 {
+		return ctx.Err()
+	}
+
+	l.mtx.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	l.next = l.next.Add(time.Duration(n) * l.perKey)
+	delay := l.next.Sub(now)
+	l.mtx.Unlock()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
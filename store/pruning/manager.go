@@ -0,0 +1,340 @@
+package pruning
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/metrics"
+)
+
+// ErrClosed is returned by Wait when the Manager is closed before pruning
+// completes.
+var ErrClosed = errors.New("pruning manager is closed")
+
+// Pruner defines a backend pruned by the Manager, e.g. the SS or SC backend. A
+// Pruner which also implements store.LimitedPruner is throttled according to the
+// configured rate limit.
+type Pruner interface {
+	Prune(version uint64) error
+}
+
+// Options defines the options of a Manager.
+type Options struct {
+	// Concurrency defines the maximum number of backends pruned concurrently.
+	// Zero prunes all the backends concurrently.
+	Concurrency int
+	// KeysPerSecond defines the maximum number of keys deleted per second across
+	// all the backends. Zero disables rate limiting. Note, only backends which
+	// implement store.LimitedPruner are throttled: the SQLite and PebbleDB SS
+	// backends and SMT trees are, IAVL trees are not. The root store does not
+	// throttle the SC backend, which it prunes under its commit lock.
+	KeysPerSecond int
+}
+
+// Manager prunes a set of backends in the background, off the commit path.
+//
+// Pruning requests only record the version to prune up to, which is immediately
+// reflected by PrunedVersion, and are served by a single background worker. Since
+// backends are not pruned atomically, reads must pin the version they read with
+// Acquire, which rejects versions up to and including PrunedVersion, so that a
+// partially pruned version is never observed. The worker never prunes a version
+// pinned by a reader: it prunes up to the version below the lowest pinned one, and
+// resumes once the readers release it. Requests received while pruning is in
+// progress are coalesced.
+type Manager struct {
+	logger    log.Logger
+	telemetry metrics.StoreMetrics
+	opts      Options
+	limiter   *limiter
+
+	names   []string
+	pruners map[string]Pruner
+
+	mtx  sync.Mutex
+	cond *sync.Cond
+	// prunedVersion is the latest requested version, all versions up to and
+	// including it are considered pruned
+	prunedVersion uint64
+	// completedVersion is the latest version all the backends are pruned to
+	completedVersion uint64
+	// readers counts the open readers per pinned version
+	readers map[uint64]int
+	// lastErr is the error of the last pruning attempt, if any
+	lastErr error
+	closed  bool
+
+	chNotify chan struct{}
+	ctx      context.Context
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// NewManager returns a new Manager pruning the given named backends. The Manager
+// must be started with Start and closed with Close.
+func NewManager(logger log.Logger, pruners map[string]Pruner, opts Options, telemetry metrics.StoreMetrics) *Manager {
+	names := make([]string, 0, len(pruners))
+	for name := range pruners {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	m := &Manager{
+		logger:    logger.With("module", "pruning"),
+		telemetry: telemetry,
+		opts:      opts,
+		limiter:   newLimiter(opts.KeysPerSecond),
+		names:     names,
+		pruners:   pruners,
+		readers:   make(map[uint64]int),
+		chNotify:  make(chan struct{}, 1),
+		ctx:       ctx,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+	m.cond = sync.NewCond(&m.mtx)
+
+	return m
+}
+
+// Start starts the background worker.
+func (m *Manager) Start() {
+	go m.run()
+}
+
+// Prune requests all the backends to be pruned up to and including the given
+// version. It does not block, the version is considered pruned as soon as it
+// returns.
+func (m *Manager) Prune(version uint64) {
+	m.mtx.Lock()
+	if version <= m.prunedVersion || m.closed {
+		m.mtx.Unlock()
+		return
+	}
+	m.prunedVersion = version
+	m.lastErr = nil
+	m.mtx.Unlock()
+
+	if m.telemetry != nil {
+		m.telemetry.SetGauge(float32(version), "pruning", "target_version")
+	}
+
+	m.notify()
+}
+
+// Acquire pins the given version for reading, it is not pruned until the returned
+// release function is called. It returns false if the version is pruned or being
+// pruned.
+func (m *Manager) Acquire(version uint64) (release func(), ok bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.prunedVersion > 0 && version <= m.prunedVersion {
+		return nil, false
+	}
+	m.readers[version]++
+
+	var once sync.Once
+	return func() { once.Do(func() { m.release(version) }) }, true
+}
+
+func (m *Manager) release(version uint64) {
+	m.mtx.Lock()
+	m.readers[version]--
+	if m.readers[version] == 0 {
+		delete(m.readers, version)
+	}
+	pending := m.completedVersion < m.prunedVersion
+	m.mtx.Unlock()
+
+	// the worker may be waiting for the version to be released
+	if pending {
+		m.notify()
+	}
+}
+
+func (m *Manager) notify() {
+	select {
+	case m.chNotify <- struct{}{}:
+	default:
+		// a notification is already pending
+	}
+}
+
+// pruneTarget returns the version the backends can be pruned to, i.e. the latest
+// requested version below the lowest pinned version. Note, it must be called with
+// the lock held.
+func (m *Manager) pruneTarget() uint64 {
+	target := m.prunedVersion
+	for version := range m.readers {
+		if version <= target {
+			target = version - 1
+		}
+	}
+
+	return target
+}
+
+// PrunedVersion returns the latest version requested to be pruned. Versions up
+// to and including it may be partially pruned and must not be read.
+func (m *Manager) PrunedVersion() uint64 {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.prunedVersion
+}
+
+// IsPruned returns true if the given version is pruned or being pruned.
+func (m *Manager) IsPruned(version uint64) bool {
+	pruned := m.PrunedVersion()
+
+	return pruned > 0 && version <= pruned
+}
+
+// CompletedVersion returns the latest version all the backends are completely
+// pruned to.
+func (m *Manager) CompletedVersion() uint64 {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.completedVersion
+}
+
+// Wait blocks until all the pending requests are completed, and returns the
+// error of the last pruning attempt, if any. Note, it blocks as long as a version
+// being pruned is pinned by a reader.
+func (m *Manager) Wait() error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	for m.completedVersion < m.prunedVersion && m.lastErr == nil && !m.closed {
+		m.cond.Wait()
+	}
+
+	if m.lastErr != nil {
+		return m.lastErr
+	}
+	if m.completedVersion < m.prunedVersion {
+		return ErrClosed
+	}
+
+	return nil
+}
+
+// Close cancels the pruning in progress, if any, and stops the background
+// worker. Backends which support throttling stop at the next chunk of keys, the
+// others complete their current pruning call.
+func (m *Manager) Close() error {
+	m.mtx.Lock()
+	if m.closed {
+		m.mtx.Unlock()
+		return nil
+	}
+	m.closed = true
+	m.cond.Broadcast()
+	m.mtx.Unlock()
+
+	m.cancel()
+	<-m.done
+
+	return nil
+}
+
+func (m *Manager) run() {
+	defer close(m.done)
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+
+		case <-m.chNotify:
+		}
+
+		m.mtx.Lock()
+		version := m.pruneTarget()
+		skip := version <= m.completedVersion
+		m.mtx.Unlock()
+
+		// the versions to prune are pinned by readers, pruning resumes once they
+		// are released
+		if skip {
+			continue
+		}
+
+		err := m.pruneAll(version)
+		if errors.Is(err, context.Canceled) {
+			m.logger.Info("pruning canceled", "version", version)
+			return
+		}
+		if err != nil {
+			m.logger.Error("failed to prune", "version", version, "err", err)
+		}
+
+		m.mtx.Lock()
+		m.lastErr = err
+		if err == nil {
+			m.completedVersion = version
+		}
+		m.cond.Broadcast()
+		m.mtx.Unlock()
+
+		if err == nil && m.telemetry != nil {
+			m.telemetry.SetGauge(float32(version), "pruning", "completed_version")
+		}
+	}
+}
+
+// pruneAll prunes all the backends up to and including the given version.
+func (m *Manager) pruneAll(version uint64) error {
+	if m.telemetry != nil {
+		now := time.Now()
+		defer m.telemetry.MeasureSince(now, "pruning", "duration")
+	}
+
+	eg, ctx := errgroup.WithContext(m.ctx)
+	if m.opts.Concurrency > 0 {
+		eg.SetLimit(m.opts.Concurrency)
+	}
+
+	for _, name := range m.names {
+		name, pruner := name, m.pruners[name]
+		eg.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			limited, ok := pruner.(store.LimitedPruner)
+			if !ok {
+				return pruner.Prune(version)
+			}
+
+			return limited.PruneLimited(version, func(n int) error {
+				if err := m.limiter.wait(ctx, n); err != nil {
+					return err
+				}
+
+				if m.telemetry != nil {
+					m.telemetry.IncrCounter(float32(n), "pruning", name, "keys")
+				}
+
+				return nil
+			})
+		})
+	}
+
+	err := eg.Wait()
+	if err != nil && m.ctx.Err() != nil {
+		return context.Canceled
+	}
+
+	return err
+}
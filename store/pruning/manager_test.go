@@ -0,0 +1,225 @@
+package pruning
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+)
+
+var _ store.LimitedPruner = (*mockPruner)(nil)
+
+// mockPruner deletes keys keys per version in chunks of chunkSize keys.
+type mockPruner struct {
+	mtx       sync.Mutex
+	keys      int
+	chunkSize int
+	err       error
+	block     chan struct{}
+
+	versions []uint64
+	active   *atomic.Int32
+	maxCalls *atomic.Int32
+}
+
+func (p *mockPruner) Prune(version uint64) error {
+	return p.PruneLimited(version, nil)
+}
+
+func (p *mockPruner) PruneLimited(version uint64, wait func(n int) error) error {
+	if p.active != nil {
+		n := p.active.Add(1)
+		defer p.active.Add(-1)
+		for {
+			cur := p.maxCalls.Load()
+			if n <= cur || p.maxCalls.CompareAndSwap(cur, n) {
+				break
+			}
+		}
+	}
+
+	if p.block != nil {
+		<-p.block
+	}
+
+	for deleted := 0; deleted < p.keys; deleted += p.chunkSize {
+		if wait != nil {
+			if err := wait(min(p.chunkSize, p.keys-deleted)); err != nil {
+				return err
+			}
+		}
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	p.versions = append(p.versions, version)
+
+	return nil
+}
+
+func (p *mockPruner) prunedVersions() []uint64 {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	return p.versions
+}
+
+func TestManagerPrune(t *testing.T) {
+	ss, sc := &mockPruner{}, &mockPruner{}
+	m := NewManager(log.NewNopLogger(), map[string]Pruner{"ss": ss, "sc": sc}, Options{}, nil)
+	m.Start()
+	defer m.Close()
+
+	require.False(t, m.IsPruned(1))
+	require.NoError(t, m.Wait())
+
+	m.Prune(5)
+	require.True(t, m.IsPruned(5))
+	require.False(t, m.IsPruned(6))
+	require.NoError(t, m.Wait())
+	require.Equal(t, uint64(5), m.CompletedVersion())
+
+	// lower versions are ignored
+	m.Prune(3)
+	require.Equal(t, uint64(5), m.PrunedVersion())
+	require.NoError(t, m.Wait())
+
+	require.Equal(t, []uint64{5}, ss.prunedVersions())
+	require.Equal(t, []uint64{5}, sc.prunedVersions())
+}
+
+func TestManagerAcquire(t *testing.T) {
+	ss := &mockPruner{}
+	m := NewManager(log.NewNopLogger(), map[string]Pruner{"ss": ss}, Options{}, nil)
+	m.Start()
+	defer m.Close()
+
+	release3, ok := m.Acquire(3)
+	require.True(t, ok)
+	release4, ok := m.Acquire(4)
+	require.True(t, ok)
+
+	// the pinned versions are rejected to new readers, but not pruned
+	m.Prune(5)
+	_, ok = m.Acquire(3)
+	require.False(t, ok)
+	require.Eventually(t, func() bool { return m.CompletedVersion() == 2 }, time.Second, time.Millisecond)
+
+	// pruning resumes up to the lowest version still pinned
+	release3()
+	release3()
+	require.Eventually(t, func() bool { return m.CompletedVersion() == 3 }, time.Second, time.Millisecond)
+	release4()
+	require.NoError(t, m.Wait())
+	require.Equal(t, []uint64{2, 3, 5}, ss.prunedVersions())
+
+	_, ok = m.Acquire(6)
+	require.True(t, ok)
+}
+
+func TestManagerCoalesce(t *testing.T) {
+	ss := &mockPruner{block: make(chan struct{}), active: &atomic.Int32{}, maxCalls: &atomic.Int32{}}
+	m := NewManager(log.NewNopLogger(), map[string]Pruner{"ss": ss}, Options{}, nil)
+	m.Start()
+	defer m.Close()
+
+	m.Prune(1)
+	// wait for the first request to be in progress
+	require.Eventually(t, func() bool { return ss.active.Load() == 1 }, time.Second, time.Millisecond)
+
+	// requests received while pruning are coalesced
+	m.Prune(2)
+	m.Prune(3)
+	m.Prune(4)
+	close(ss.block)
+
+	require.NoError(t, m.Wait())
+	require.Equal(t, []uint64{1, 4}, ss.prunedVersions())
+	require.Equal(t, uint64(4), m.CompletedVersion())
+}
+
+func TestManagerConcurrency(t *testing.T) {
+	active, maxCalls := &atomic.Int32{}, &atomic.Int32{}
+	block := make(chan struct{})
+
+	pruners := make(map[string]Pruner)
+	for _, name := range []string{"a", "b", "c", "d"} {
+		pruners[name] = &mockPruner{block: block, active: active, maxCalls: maxCalls}
+	}
+
+	m := NewManager(log.NewNopLogger(), pruners, Options{Concurrency: 2}, nil)
+	m.Start()
+	defer m.Close()
+
+	m.Prune(1)
+	require.Eventually(t, func() bool { return active.Load() == 2 }, time.Second, time.Millisecond)
+	close(block)
+
+	require.NoError(t, m.Wait())
+	require.Equal(t, int32(2), maxCalls.Load())
+}
+
+func TestManagerRateLimit(t *testing.T) {
+	ss, sc := &mockPruner{keys: 100, chunkSize: 10}, &mockPruner{keys: 100, chunkSize: 10}
+	m := NewManager(log.NewNopLogger(), map[string]Pruner{"ss": ss, "sc": sc}, Options{KeysPerSecond: 1000}, nil)
+	m.Start()
+	defer m.Close()
+
+	// 200 keys at 1000 keys per second
+	start := time.Now()
+	m.Prune(1)
+	require.NoError(t, m.Wait())
+	require.GreaterOrEqual(t, time.Since(start), 190*time.Millisecond)
+}
+
+func TestManagerError(t *testing.T) {
+	ss := &mockPruner{err: errors.New("failed")}
+	m := NewManager(log.NewNopLogger(), map[string]Pruner{"ss": ss}, Options{}, nil)
+	m.Start()
+	defer m.Close()
+
+	m.Prune(1)
+	require.Error(t, m.Wait())
+	require.Zero(t, m.CompletedVersion())
+	require.True(t, m.IsPruned(1))
+
+	// a new request retries
+	ss.mtx.Lock()
+	ss.err = nil
+	ss.mtx.Unlock()
+	m.Prune(2)
+	require.NoError(t, m.Wait())
+	require.Equal(t, uint64(2), m.CompletedVersion())
+}
+
+func TestManagerClose(t *testing.T) {
+	ss := &mockPruner{keys: 1000, chunkSize: 1}
+	m := NewManager(log.NewNopLogger(), map[string]Pruner{"ss": ss}, Options{KeysPerSecond: 10}, nil)
+	m.Start()
+
+	m.Prune(1)
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- m.Wait() }()
+
+	// the pruning in progress is canceled
+	start := time.Now()
+	require.NoError(t, m.Close())
+	require.Less(t, time.Since(start), time.Second)
+	require.ErrorIs(t, <-errCh, ErrClosed)
+	require.Empty(t, ss.prunedVersions())
+
+	// requests are ignored once closed
+	m.Prune(2)
+	require.Equal(t, uint64(1), m.PrunedVersion())
+	require.NoError(t, m.Close())
+}
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"cosmossdk.io/store/v2/query"
+)
+
+const (
+	flagGRPCAddr    = "grpc-addr"
+	flagFromVersion = "from-version"
+	flagToVersion   = "to-version"
+	flagLimit       = "limit"
+	flagStringKey   = "string-key"
+
+	defaultGRPCAddr = "localhost:9090"
+)
+
+// NewKeyHistoryCmd creates a command to query the changes of a key committed
+// within a range of versions from the store/v2 query service of a node.
+func NewKeyHistoryCmd() *cobra.Command {
+	var (
+		grpcAddr               string
+		fromVersion, toVersion uint64
+		limit                  uint64
+		stringKey              bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "key-history [store-key] [key]",
+		Short: "Query the changes of a key within a range of versions",
+		Long: `
+Query the changes of a key committed within a range of versions, as kept by the
+state storage of a node. The key is hex encoded unless --string-key is set.
+
+Every change is printed on a separate line as the version followed by the hex
+encoded value of the key, or by "deleted" if the key was removed at the version.
+The versions pruned from state storage are not available.
+`,
+		Example: "key-history bank 0214... --from-version 100 --to-version 200",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key := []byte(args[1])
+			if !stringKey {
+				var err error
+				if key, err = hex.DecodeString(args[1]); err != nil {
+					return fmt.Errorf("invalid hex key: %w", err)
+				}
+			}
+
+			conn, err := grpc.NewClient(grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				return fmt.Errorf("failed to connect to %s: %w", grpcAddr, err)
+			}
+			defer conn.Close()
+
+			client := query.NewQueryClient(conn)
+			req := &query.QueryKeyHistoryRequest{
+				StoreKey:    args[0],
+				Key:         key,
+				FromVersion: fromVersion,
+				ToVersion:   toVersion,
+				Limit:       limit,
+			}
+			for {
+				res, err := client.KeyHistory(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+
+				for _, change := range res.Changes {
+					if change.Deleted {
+						cmd.Printf("%d deleted\n", change.Version)
+					} else {
+						cmd.Printf("%d %X\n", change.Version, change.Value)
+					}
+				}
+
+				if res.NextVersion == 0 {
+					return nil
+				}
+				req.FromVersion = res.NextVersion
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&grpcAddr, flagGRPCAddr, defaultGRPCAddr, "address of the gRPC server of the node")
+	cmd.Flags().Uint64Var(&fromVersion, flagFromVersion, 0, "first version of the range, 0 defaults to the earliest version available")
+	cmd.Flags().Uint64Var(&toVersion, flagToVersion, 0, "last version of the range, 0 defaults to the latest version")
+	cmd.Flags().Uint64Var(&limit, flagLimit, 0, "number of changes fetched per request, 0 uses the server default")
+	cmd.Flags().BoolVar(&stringKey, flagStringKey, false, "treat the key as a string rather than hex encoded bytes")
+
+	return cmd
+}
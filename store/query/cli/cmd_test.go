@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"cosmossdk.io/store/v2/query"
+)
+
+// mockQueryServer serves the changes of a single key, one change per page.
+type mockQueryServer struct {
+	query.UnimplementedQueryServer
+
+	changes []*query.KeyChange
+}
+
+func (s *mockQueryServer) KeyHistory(_ context.Context, req *query.QueryKeyHistoryRequest) (*query.QueryKeyHistoryResponse, error) {
+	res := &query.QueryKeyHistoryResponse{}
+	if !bytes.Equal(req.Key, []byte("foo")) {
+		return res, nil
+	}
+
+	for i, change := range s.changes {
+		if change.Version >= req.FromVersion {
+			res.Changes = []*query.KeyChange{change}
+			if i+1 < len(s.changes) {
+				res.NextVersion = s.changes[i+1].Version
+			}
+			break
+		}
+	}
+
+	return res, nil
+}
+
+func TestKeyHistoryCmd(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	query.RegisterQueryServer(server, &mockQueryServer{changes: []*query.KeyChange{
+		{Version: 1, Value: []byte{0x1}},
+		{Version: 3, Deleted: true},
+		{Version: 4, Value: []byte{0x2}},
+	}})
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	run := func(args ...string) (string, error) {
+		cmd := NewKeyHistoryCmd()
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetErr(out)
+		cmd.SetArgs(append(args, "--grpc-addr", listener.Addr().String()))
+
+		err := cmd.ExecuteContext(context.Background())
+		return out.String(), err
+	}
+
+	_, err = run("bank", "not-hex")
+	require.Error(t, err)
+
+	// every page is fetched
+	out, err := run("bank", "666f6f")
+	require.NoError(t, err)
+	require.Equal(t, "1 01\n3 deleted\n4 02\n", out)
+
+	out, err = run("bank", "foo", "--string-key")
+	require.NoError(t, err)
+	require.Equal(t, "1 01\n3 deleted\n4 02\n", out)
+}
@@ -0,0 +1,156 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/proof"
+)
+
+const (
+	// DefaultKeyHistoryLimit is the number of changes returned by KeyHistory if
+	// no limit is provided.
+	DefaultKeyHistoryLimit = 100
+	// MaxKeyHistoryLimit is the maximum number of changes returned by KeyHistory.
+	MaxKeyHistoryLimit = 1000
+)
+
+var _ QueryServer = queryServer{}
+
+// queryServer implements the QueryServer interface backed by a RootStore.
+type queryServer struct {
+	rootStore store.RootStore
+}
+
+// NewQueryServer returns a QueryServer serving queries from the given RootStore.
+func NewQueryServer(rs store.RootStore) QueryServer {
+	return queryServer{rootStore: rs}
+}
+
+// Proof implements the Query/Proof gRPC method.
+func (s queryServer) Proof(_ context.Context, req *QueryProofRequest) (*QueryProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.StoreKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty store key")
+	}
+	if len(req.Key) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty key")
+	}
+
+	version, err := s.resolveVersion(req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.rootStore.Query([]byte(req.StoreKey), version, req.Key, true)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	proofOps, err := convertProofOps(result.ProofOps)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &QueryProofResponse{
+		Key:      result.Key,
+		Value:    result.Value,
+		Version:  result.Version,
+		ProofOps: proofOps,
+	}, nil
+}
+
+// KeyHistory implements the Query/KeyHistory gRPC method.
+func (s queryServer) KeyHistory(_ context.Context, req *QueryKeyHistoryRequest) (*QueryKeyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.StoreKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty store key")
+	}
+	if len(req.Key) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty key")
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultKeyHistoryLimit
+	}
+	limit = min(limit, MaxKeyHistoryLimit)
+
+	toVersion, err := s.resolveVersion(req.ToVersion)
+	if err != nil {
+		return nil, err
+	}
+	fromVersion := max(req.FromVersion, 1)
+	if fromVersion > toVersion {
+		return nil, status.Errorf(codes.InvalidArgument, "from version %d is greater than to version %d", fromVersion, toVersion)
+	}
+
+	ss := s.rootStore.GetStateStorage()
+	changes, nextVersion, err := ss.KeyHistory([]byte(req.StoreKey), req.Key, fromVersion, toVersion, int(limit))
+
+	// a zero from version starts at the earliest version available
+	var errPruned storeerrors.ErrVersionPruned
+	if req.FromVersion == 0 && errors.As(err, &errPruned) && errPruned.EarliestVersion <= toVersion {
+		changes, nextVersion, err = ss.KeyHistory([]byte(req.StoreKey), req.Key, errPruned.EarliestVersion, toVersion, int(limit))
+	}
+	if err != nil {
+		if errors.As(err, &errPruned) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &QueryKeyHistoryResponse{NextVersion: nextVersion}
+	res.Changes = make([]*KeyChange, len(changes))
+	for i, change := range changes {
+		res.Changes[i] = &KeyChange{
+			Version: change.Version,
+			Value:   change.Value,
+			Deleted: change.Deleted,
+		}
+	}
+
+	return res, nil
+}
+
+// resolveVersion returns the latest version of the RootStore if the provided
+// version is zero, otherwise the provided version is returned.
+func (s queryServer) resolveVersion(version uint64) (uint64, error) {
+	if version != 0 {
+		return version, nil
+	}
+
+	latest, err := s.rootStore.GetLatestVersion()
+	if err != nil {
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return latest, nil
+}
+
+func convertProofOps(ops []proof.CommitmentOp) ([]*CommitmentOp, error) {
+	res := make([]*CommitmentOp, len(ops))
+	for i, op := range ops {
+		bz, err := op.Proof.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		res[i] = &CommitmentOp{
+			ProofType: op.Type,
+			Key:       op.Key,
+			Proof:     bz,
+		}
+	}
+
+	return res, nil
+}
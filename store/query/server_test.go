@@ -0,0 +1,134 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/proof"
+	"cosmossdk.io/store/v2/root"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+const testStoreKey = "test_store_key"
+
+func newTestRootStore(t *testing.T) store.RootStore {
+	t.Helper()
+
+	noopLog := log.NewNopLogger()
+
+	sqliteDB, err := sqlite.New(t.TempDir())
+	require.NoError(t, err)
+	ss := storage.NewStorageStore(sqliteDB, nil, noopLog)
+
+	tree := iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig())
+	sc, err := commitment.NewCommitStore(map[string]commitment.Tree{testStoreKey: tree}, dbm.NewMemDB(), nil, noopLog)
+	require.NoError(t, err)
+
+	rs, err := root.New(noopLog, ss, sc, nil, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, rs.Close()) })
+
+	return rs
+}
+
+func commit(t *testing.T, rs store.RootStore, cs *corestore.Changeset) []byte {
+	t.Helper()
+
+	_, err := rs.WorkingHash(cs)
+	require.NoError(t, err)
+	commitHash, err := rs.Commit(cs)
+	require.NoError(t, err)
+
+	return commitHash
+}
+
+func TestQueryServerProof(t *testing.T) {
+	rs := newTestRootStore(t)
+
+	cs := corestore.NewChangeset()
+	cs.Add([]byte(testStoreKey), []byte("foo"), []byte("bar"), false)
+	commitHash := commit(t, rs, cs)
+
+	srv := NewQueryServer(rs)
+
+	_, err := srv.Proof(context.Background(), nil)
+	require.Error(t, err)
+	_, err = srv.Proof(context.Background(), &QueryProofRequest{Key: []byte("foo")})
+	require.Error(t, err)
+
+	// a zero version defaults to the latest version
+	res, err := srv.Proof(context.Background(), &QueryProofRequest{StoreKey: testStoreKey, Key: []byte("foo")})
+	require.NoError(t, err)
+	require.Equal(t, []byte("bar"), res.Value)
+	require.Equal(t, uint64(1), res.Version)
+	require.Len(t, res.ProofOps, 2)
+
+	// the returned proof ops must verify against the commit hash
+	args := [][]byte{res.Value}
+	for _, op := range res.ProofOps {
+		spec, err := proof.SpecFromType(op.ProofType)
+		require.NoError(t, err)
+
+		commitmentProof := &ics23.CommitmentProof{}
+		require.NoError(t, commitmentProof.Unmarshal(op.Proof))
+
+		commitmentOp := proof.CommitmentOp{Type: op.ProofType, Key: op.Key, Spec: spec, Proof: commitmentProof}
+		args, err = commitmentOp.Run(args)
+		require.NoError(t, err)
+	}
+	require.Equal(t, commitHash, args[0])
+}
+
+func TestQueryServerKeyHistory(t *testing.T) {
+	rs := newTestRootStore(t)
+	for v := 1; v <= 5; v++ {
+		cs := corestore.NewChangeset()
+		cs.Add([]byte(testStoreKey), []byte("foo"), []byte(fmt.Sprintf("bar%d", v)), v == 3)
+		cs.Add([]byte(testStoreKey), []byte("other"), []byte("value"), false)
+		commit(t, rs, cs)
+	}
+
+	srv := NewQueryServer(rs)
+
+	_, err := srv.KeyHistory(context.Background(), &QueryKeyHistoryRequest{StoreKey: testStoreKey})
+	require.Error(t, err)
+	_, err = srv.KeyHistory(context.Background(), &QueryKeyHistoryRequest{StoreKey: testStoreKey, Key: []byte("foo"), FromVersion: 4, ToVersion: 3})
+	require.Error(t, err)
+
+	// the changes are paginated by version, zero versions default to the whole
+	// history
+	req := &QueryKeyHistoryRequest{StoreKey: testStoreKey, Key: []byte("foo"), Limit: 2}
+	res, err := srv.KeyHistory(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, []*KeyChange{
+		{Version: 1, Value: []byte("bar1")},
+		{Version: 2, Value: []byte("bar2")},
+	}, res.Changes)
+	require.Equal(t, uint64(3), res.NextVersion)
+
+	req.FromVersion = res.NextVersion
+	res, err = srv.KeyHistory(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, []*KeyChange{
+		{Version: 3, Deleted: true},
+		{Version: 4, Value: []byte("bar4")},
+	}, res.Changes)
+	require.Equal(t, uint64(5), res.NextVersion)
+
+	req.FromVersion = res.NextVersion
+	res, err = srv.KeyHistory(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, []*KeyChange{{Version: 5, Value: []byte("bar5")}}, res.Changes)
+	require.Zero(t, res.NextVersion)
+}
@@ -0,0 +1,168 @@
+package root
+
+import (
+	"errors"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/proof"
+)
+
+const (
+	// anchorStoreKey is the reserved SS store key under which the CommitInfo of
+	// every committed version is kept when historical proofs are enabled.
+	anchorStoreKey = "_commit_anchors"
+
+	anchorKeyFmt = "c/%d" // c/<version>
+)
+
+// EnableHistoricalProofs enables serving proofs for versions whose CommitInfo
+// no longer exists in the SC backend, e.g. after SC pruning.
+//
+// When enabled, the CommitInfo of every committed version is kept in SS as a
+// commitment anchor, and the SC trees of the provided store keys are exempted
+// from SC pruning. Instead, they are pruned on commit according to the given
+// retention, which should retain at least as many versions as the SC pruning,
+// while a nil retention keeps every version. A historical query for a key at
+// version v is answered with the key-level proof of the retained tree at v and
+// the store-level proof of the anchor of v, see queryHistoricalProof.
+//
+// Note, the SC backend must implement store.TreeRetainer, and the retained trees
+// grow with every version kept, see the store README.
+func (s *Store) EnableHistoricalProofs(retention *store.PruneOptions, storeKeys ...[]byte) error {
+	retainer, ok := s.stateCommitment.(store.TreeRetainer)
+	if !ok {
+		return errors.New("the SC backend does not support retaining trees")
+	}
+	if err := retainer.RetainTrees(storeKeys...); err != nil {
+		return err
+	}
+
+	s.historicalProofStores = make(map[string]struct{}, len(storeKeys))
+	for _, storeKey := range storeKeys {
+		s.historicalProofStores[string(storeKey)] = struct{}{}
+	}
+	s.historicalRetention = retention
+
+	return nil
+}
+
+func (s *Store) historicalProofsEnabled() bool {
+	return s.historicalProofStores != nil
+}
+
+// anchorChangeset returns a Changeset containing the commitment anchor for the
+// provided CommitInfo.
+func anchorChangeset(cInfo *proof.CommitInfo) (*corestore.Changeset, error) {
+	anchor, err := cInfo.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal commit info: %w", err)
+	}
+
+	anchorCS := corestore.NewChangeset()
+	anchorCS.Add([]byte(anchorStoreKey), anchorKey(cInfo.Version), anchor, false)
+
+	return anchorCS, nil
+}
+
+// pruneRetainedTrees prunes the SC trees retained for historical proofs if the
+// given committed version triggers pruning according to their retention.
+func (s *Store) pruneRetainedTrees(version uint64) {
+	if !s.historicalProofsEnabled() || s.historicalRetention == nil {
+		return
+	}
+
+	prune, pruneVersion := s.historicalRetention.ShouldPrune(version)
+	if !prune {
+		return
+	}
+
+	s.scMtx.Lock()
+	defer s.scMtx.Unlock()
+
+	retainer := s.stateCommitment.(store.TreeRetainer)
+	for storeKey := range s.historicalProofStores {
+		if err := retainer.PruneTree([]byte(storeKey), pruneVersion); err != nil {
+			s.logger.Info("failed to prune retained SC tree", "store_key", storeKey, "prune_version", pruneVersion, "err", err)
+		}
+	}
+}
+
+// liveKeys returns the keys of the range [start, end) of the given store key
+// which are live in SS at the given version.
+func (s *Store) liveKeys(storeKey []byte, version uint64, start, end []byte) ([][]byte, error) {
+	itr, err := s.stateStorage.Iterator(storeKey, version, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate SS store: %w", err)
+	}
+	defer itr.Close()
+
+	var keys [][]byte
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+
+	return keys, itr.Error()
+}
+
+// queryHistoricalProof reconstructs the commitment proof chain for the given key
+// at the given version, i.e. the key-level proof of the SC tree retained for the
+// store and the store-level proof of the commitment anchor of the version kept in
+// SS. ErrProofUnavailable is returned if the store's tree is not retained or the
+// anchor of the version has been pruned.
+func (s *Store) queryHistoricalProof(storeKey []byte, version uint64, key []byte) (store.QueryResult, error) {
+	if _, ok := s.historicalProofStores[string(storeKey)]; !ok {
+		return store.QueryResult{}, fmt.Errorf("%w: historical proofs are not retained for store %s", storeerrors.ErrProofUnavailable, storeKey)
+	}
+
+	cInfo, err := s.getAnchor(version)
+	if err != nil {
+		return store.QueryResult{}, err
+	}
+
+	keyOp, err := s.stateCommitment.(store.TreeRetainer).GetTreeProof(storeKey, version, key)
+	if err != nil {
+		return store.QueryResult{}, fmt.Errorf("failed to get SC tree proof: %w", err)
+	}
+
+	_, storeOp, err := cInfo.GetStoreProof(storeKey)
+	if err != nil {
+		return store.QueryResult{}, err
+	}
+
+	val, err := s.stateStorage.Get(storeKey, version, key)
+	if err != nil {
+		return store.QueryResult{}, fmt.Errorf("failed to query SS store: %w", err)
+	}
+
+	return store.QueryResult{
+		Key:      key,
+		Value:    val,
+		Version:  version,
+		ProofOps: []proof.CommitmentOp{*keyOp, *storeOp},
+	}, nil
+}
+
+// getAnchor returns the commitment anchor, i.e. the CommitInfo, of the given version.
+func (s *Store) getAnchor(version uint64) (*proof.CommitInfo, error) {
+	anchor, err := s.stateStorage.Get([]byte(anchorStoreKey), version, anchorKey(version))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query commitment anchor: %w", err)
+	}
+	if anchor == nil {
+		return nil, fmt.Errorf("%w: commitment anchor not found for version %d", storeerrors.ErrProofUnavailable, version)
+	}
+
+	cInfo := &proof.CommitInfo{}
+	if err := cInfo.Unmarshal(anchor); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal commitment anchor: %w", err)
+	}
+
+	return cInfo, nil
+}
+
+func anchorKey(version uint64) []byte {
+	return []byte(fmt.Sprintf(anchorKeyFmt, version))
+}
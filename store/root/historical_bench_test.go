@@ -0,0 +1,63 @@
+package root
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+// BenchmarkCommitHistoricalProofs measures the cost historical proofs add to
+// Commit, i.e. writing the commitment anchor to SS.
+func BenchmarkCommitHistoricalProofs(b *testing.B) {
+	for _, keysPerCommit := range []int{10, 100, 1000} {
+		for _, enabled := range []bool{false, true} {
+			b.Run(fmt.Sprintf("keys=%d/historical_proofs=%t", keysPerCommit, enabled), func(b *testing.B) {
+				noopLog := log.NewNopLogger()
+
+				sqliteDB, err := sqlite.New(b.TempDir())
+				require.NoError(b, err)
+				ss := storage.NewStorageStore(sqliteDB, nil, noopLog)
+
+				tree := iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig())
+				sc, err := commitment.NewCommitStore(map[string]commitment.Tree{testStoreKey: tree}, dbm.NewMemDB(), nil, noopLog)
+				require.NoError(b, err)
+
+				rs, err := New(noopLog, ss, sc, nil, nil)
+				require.NoError(b, err)
+				defer rs.Close()
+
+				if enabled {
+					require.NoError(b, rs.(*Store).EnableHistoricalProofs(store.DefaultPruneOptions(), testStoreKeyBytes))
+				}
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					cs := corestore.NewChangeset()
+					for j := 0; j < keysPerCommit; j++ {
+						// half of the keys are updated on every commit, half are new
+						key := fmt.Sprintf("key%08d", j)
+						if j%2 == 1 {
+							key = fmt.Sprintf("key%08d", (i+1)*keysPerCommit+j)
+						}
+						cs.Add(testStoreKeyBytes, []byte(key), []byte(fmt.Sprintf("val%08d", i)), false)
+					}
+
+					_, err := rs.WorkingHash(cs)
+					require.NoError(b, err)
+					_, err = rs.Commit(cs)
+					require.NoError(b, err)
+				}
+			})
+		}
+	}
+}
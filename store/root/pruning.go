@@ -0,0 +1,196 @@
+package root
+
+import (
+	"errors"
+	"sync"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/pruning"
+)
+
+var _ store.VersionedDatabase = (*guardedStorage)(nil)
+
+// EnableAsyncPruning moves pruning off the commit path. Once enabled, the SS and
+// SC backends are pruned in the background by a pruning.Manager, according to the
+// given prune options, and reads of versions being pruned are rejected.
+//
+// Reads pin the version they read, see pruning.Manager.Acquire, so that iterators
+// opened before a version is requested to be pruned keep observing the complete
+// version until closed.
+//
+// The SC backend is never pruned while it is written on the commit path, which
+// the SC trees do not support: it is pruned under the same lock, thus a commit
+// waits for the SC pruning in progress, if any. Since the lock must not be held
+// while throttled, the rate limit of opts only applies to the SS backend.
+//
+// Note, the SS and SC backends must be created without prune options, otherwise
+// they are still pruned synchronously on commit. Async pruning cannot be used
+// along with a migration.
+func (s *Store) EnableAsyncPruning(pruneOpts *store.PruneOptions, opts pruning.Options) error {
+	if s.isMigrating {
+		return errors.New("cannot enable async pruning while migrating")
+	}
+	if s.pruningManager != nil {
+		return errors.New("async pruning is already enabled")
+	}
+
+	s.pruneOptions = pruneOpts
+	s.pruningManager = pruning.NewManager(s.logger, map[string]pruning.Pruner{
+		"ss": s.stateStorage,
+		"sc": &lockedPruner{pruner: s.stateCommitment, mtx: &s.scMtx},
+	}, opts, s.telemetry)
+	s.pruningManager.Start()
+
+	s.stateStorage = &guardedStorage{VersionedDatabase: s.stateStorage, manager: s.pruningManager}
+
+	return nil
+}
+
+// schedulePruning requests the pruning manager to prune the backends if the
+// given committed version triggers pruning.
+func (s *Store) schedulePruning(version uint64) {
+	if s.pruningManager == nil || s.isMigrating {
+		return
+	}
+
+	if prune, pruneVersion := s.pruneOptions.ShouldPrune(version); prune {
+		s.pruningManager.Prune(pruneVersion)
+	}
+}
+
+// checkPruned returns an error if the given version is pruned or being pruned
+// in the background.
+func (s *Store) checkPruned(version uint64) error {
+	if s.pruningManager == nil {
+		return nil
+	}
+
+	return checkPruned(s.pruningManager, version)
+}
+
+func checkPruned(m *pruning.Manager, version uint64) error {
+	if m.IsPruned(version) {
+		return storeerrors.ErrVersionPruned{EarliestVersion: m.PrunedVersion() + 1}
+	}
+
+	return nil
+}
+
+// acquireVersion pins the given version s.t. it is not pruned in the background
+// while being read. It returns an error if the version is pruned or being pruned.
+func (s *Store) acquireVersion(version uint64) (release func(), err error) {
+	if s.pruningManager == nil {
+		return func() {}, nil
+	}
+
+	return acquireVersion(s.pruningManager, version)
+}
+
+func acquireVersion(m *pruning.Manager, version uint64) (release func(), err error) {
+	release, ok := m.Acquire(version)
+	if !ok {
+		return nil, storeerrors.ErrVersionPruned{EarliestVersion: m.PrunedVersion() + 1}
+	}
+
+	return release, nil
+}
+
+// lockedPruner prunes a backend under the given lock. It does not implement
+// store.LimitedPruner, so the backend is not throttled while holding the lock.
+type lockedPruner struct {
+	pruner pruning.Pruner
+	mtx    *sync.Mutex
+}
+
+func (p *lockedPruner) Prune(version uint64) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	return p.pruner.Prune(version)
+}
+
+// guardedStorage wraps the SS backend to reject reads of versions pruned in the
+// background, which may be partially pruned. The versions read are pinned until
+// the read completes, or the iterator is closed, so that they are not pruned
+// while being read.
+type guardedStorage struct {
+	store.VersionedDatabase
+
+	manager *pruning.Manager
+}
+
+func (g *guardedStorage) Has(storeKey []byte, version uint64, key []byte) (bool, error) {
+	release, err := acquireVersion(g.manager, version)
+	if err != nil {
+		return false, err
+	}
+	defer release()
+
+	return g.VersionedDatabase.Has(storeKey, version, key)
+}
+
+func (g *guardedStorage) Get(storeKey []byte, version uint64, key []byte) ([]byte, error) {
+	release, err := acquireVersion(g.manager, version)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return g.VersionedDatabase.Get(storeKey, version, key)
+}
+
+func (g *guardedStorage) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
+	release, err := acquireVersion(g.manager, version)
+	if err != nil {
+		return nil, err
+	}
+
+	itr, err := g.VersionedDatabase.Iterator(storeKey, version, start, end)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	return &guardedIterator{Iterator: itr, release: release}, nil
+}
+
+func (g *guardedStorage) ReverseIterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
+	release, err := acquireVersion(g.manager, version)
+	if err != nil {
+		return nil, err
+	}
+
+	itr, err := g.VersionedDatabase.ReverseIterator(storeKey, version, start, end)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	return &guardedIterator{Iterator: itr, release: release}, nil
+}
+
+func (g *guardedStorage) KeyHistory(storeKey, key []byte, fromVersion, toVersion uint64, limit int) ([]store.KeyChange, uint64, error) {
+	// pinning the lowest version read prevents all the higher ones from being pruned
+	release, err := acquireVersion(g.manager, fromVersion)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer release()
+
+	return g.VersionedDatabase.KeyHistory(storeKey, key, fromVersion, toVersion, limit)
+}
+
+// guardedIterator releases the version pinned by the iterator once closed.
+type guardedIterator struct {
+	corestore.Iterator
+
+	release func()
+}
+
+func (i *guardedIterator) Close() error {
+	defer i.release()
+
+	return i.Iterator.Close()
+}
@@ -14,9 +14,12 @@ import (
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
 	"cosmossdk.io/store/v2/metrics"
 	"cosmossdk.io/store/v2/migration"
 	"cosmossdk.io/store/v2/proof"
+	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/streaming"
 )
 
 var _ store.RootStore = (*Store)(nil)
@@ -47,6 +50,25 @@ type Store struct {
 	// telemetry reflects a telemetry agent responsible for emitting metrics (if any)
 	telemetry metrics.StoreMetrics
 
+	// historicalProofStores reflects the store keys whose SC trees are retained
+	// for historical proofs, it is nil if historical proofs are disabled
+	historicalProofStores map[string]struct{}
+	// historicalRetention reflects the pruning of the retained SC trees, nil if
+	// they are never pruned
+	historicalRetention *store.PruneOptions
+
+	// pruningManager reflects the manager pruning the SS and SC backends in the
+	// background according to pruneOptions, it is nil if async pruning is disabled
+	pruningManager *pruning.Manager
+	pruneOptions   *store.PruneOptions
+	// scMtx serializes the writes to the SC backend on the commit path with its
+	// pruning in the background
+	scMtx sync.Mutex
+
+	// streamingManager reflects the manager delivering the committed changesets
+	// to the registered sinks, it is nil if streaming is disabled
+	streamingManager *streaming.Manager
+
 	// Migration related fields
 	// migrationManager reflects the migration manager used to migrate state from v1 to v2
 	migrationManager *migration.Manager
@@ -79,6 +101,13 @@ func New(
 // Close closes the store and resets all internal fields. Note, Close() is NOT
 // idempotent and should only be called once.
 func (s *Store) Close() (err error) {
+	if s.pruningManager != nil {
+		err = errors.Join(err, s.pruningManager.Close())
+	}
+	if s.streamingManager != nil {
+		err = errors.Join(err, s.streamingManager.Close())
+	}
+
 	err = errors.Join(err, s.stateStorage.Close())
 	err = errors.Join(err, s.stateCommitment.Close())
 
@@ -110,6 +139,10 @@ func (s *Store) StateLatest() (uint64, corestore.ReaderMap, error) {
 }
 
 func (s *Store) StateAt(v uint64) (corestore.ReaderMap, error) {
+	if err := s.checkPruned(v); err != nil {
+		return nil, err
+	}
+
 	// TODO(bez): We may want to avoid relying on the SC metadata here. Instead,
 	// we should add a VersionExists() method to the VersionedDatabase interface.
 	//
@@ -163,15 +196,25 @@ func (s *Store) Query(storeKey []byte, version uint64, key []byte, prove bool) (
 		defer s.telemetry.MeasureSince(now, "root_store", "query")
 	}
 
+	// the version is pinned s.t. the SS and SC backends are not pruned in the
+	// background while being queried
+	release, err := s.acquireVersion(version)
+	if err != nil {
+		return store.QueryResult{}, err
+	}
+	defer release()
+
 	val, err := s.stateStorage.Get(storeKey, version, key)
 	if err != nil || val == nil {
 		// fallback to querying SC backend if not found in SS backend
 		//
 		// Note, this should only used during migration, i.e. while SS and IAVL v2
-		// are being asynchronously synced.
+		// are being asynchronously synced. Versions pruned from the SC backend are
+		// served by the SS backend only.
 		if val == nil {
 			bz, scErr := s.stateCommitment.Get(storeKey, version, key)
-			if scErr != nil {
+			var errPruned storeerrors.ErrVersionPruned
+			if scErr != nil && !errors.As(scErr, &errPruned) {
 				return store.QueryResult{}, fmt.Errorf("failed to query SC store: %w", scErr)
 			}
 
@@ -192,7 +235,14 @@ func (s *Store) Query(storeKey []byte, version uint64, key []byte, prove bool) (
 	if prove {
 		result.ProofOps, err = s.stateCommitment.GetProof(storeKey, version, key)
 		if err != nil {
-			return store.QueryResult{}, fmt.Errorf("failed to get SC store proof: %w", err)
+			var errPruned storeerrors.ErrVersionPruned
+			if !s.historicalProofsEnabled() || !errors.As(err, &errPruned) {
+				return store.QueryResult{}, fmt.Errorf("failed to get SC store proof: %w", err)
+			}
+
+			// fallback to reconstructing the proof from the SS backend as the
+			// version has been pruned from the SC backend
+			return s.queryHistoricalProof(storeKey, version, key)
 		}
 	}
 
@@ -287,6 +337,25 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 		s.logger.Debug("commit header and version mismatch", "header_height", s.commitHeader.Height, "version", version)
 	}
 
+	if s.commitHeader != nil {
+		s.lastCommitInfo.Timestamp = s.commitHeader.Time
+	}
+
+	if err := s.prepareStreaming(version, cs); err != nil {
+		return nil, err
+	}
+
+	// the commitment anchor of the version is committed to SS along with the
+	// changeset if historical proofs are enabled
+	ssChangeset := cs
+	if s.historicalProofsEnabled() {
+		anchorCS, err := anchorChangeset(s.lastCommitInfo)
+		if err != nil {
+			return nil, err
+		}
+		ssChangeset = &corestore.Changeset{Changes: append(slices.Clip(cs.Changes), anchorCS.Changes...)}
+	}
+
 	eg := new(errgroup.Group)
 
 	// commit SS async
@@ -297,7 +366,7 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 			return nil
 		}
 
-		if err := s.stateStorage.ApplyChangeset(version, cs); err != nil {
+		if err := s.stateStorage.ApplyChangeset(version, ssChangeset); err != nil {
 			return fmt.Errorf("failed to commit SS: %w", err)
 		}
 
@@ -317,22 +386,31 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 		return nil, err
 	}
 
-	if s.commitHeader != nil {
-		s.lastCommitInfo.Timestamp = s.commitHeader.Time
-	}
-
 	s.workingHash = nil
+	s.schedulePruning(version)
+	s.pruneRetainedTrees(version)
+
+	if err := s.publishStreaming(version); err != nil {
+		return nil, err
+	}
 
 	return s.lastCommitInfo.Hash(), nil
 }
 
-// Prune prunes the root store to the provided version.
+// Prune prunes the root store to the provided version. If async pruning is
+// enabled, the pruning is delegated to the pruning manager and Prune blocks until
+// it completes.
 func (s *Store) Prune(version uint64) error {
 	if s.telemetry != nil {
 		now := time.Now()
 		defer s.telemetry.MeasureSince(now, "root_store", "prune")
 	}
 
+	if s.pruningManager != nil {
+		s.pruningManager.Prune(version)
+		return s.pruningManager.Wait()
+	}
+
 	if err := s.stateStorage.Prune(version); err != nil {
 		return fmt.Errorf("failed to prune SS store: %w", err)
 	}
@@ -351,6 +429,9 @@ func (s *Store) StartMigration() error {
 	if s.isMigrating {
 		return fmt.Errorf("migration already in progress")
 	}
+	if s.pruningManager != nil {
+		return fmt.Errorf("cannot migrate while async pruning is enabled")
+	}
 
 	// buffer at most 1 changeset, if the receiver is behind attempting to buffer
 	// more than 1 will block.
@@ -405,7 +486,15 @@ func (s *Store) writeSC(cs *corestore.Changeset) error {
 		}
 	}
 
-	if err := s.stateCommitment.WriteBatch(cs); err != nil {
+	scChangeset, err := s.expandRangeDeletes(cs)
+	if err != nil {
+		return err
+	}
+
+	s.scMtx.Lock()
+	defer s.scMtx.Unlock()
+
+	if err := s.stateCommitment.WriteBatch(scChangeset); err != nil {
 		return fmt.Errorf("failed to write batch to SC store: %w", err)
 	}
 
@@ -430,12 +519,128 @@ func (s *Store) writeSC(cs *corestore.Changeset) error {
 	return nil
 }
 
+// expandRangeDeletes returns the changeset to write to the SC backend, i.e. the
+// given changeset with its range deletions replaced by the removal of every key
+// of the range: the keys live in SS at the latest version, along with the keys
+// set earlier in the changeset. The keys are iterated in order within the range
+// from SS, as the leaves of commitment trees are not necessarily ordered by key,
+// e.g. SMT. While migrating, the changeset is written as is, as SS may not be in
+// sync with SC.
+//
+// A key is removed at most once per actor, since the commitment trees fail to
+// remove a missing key: the keys removed by an earlier pair of the changeset,
+// by a range or by a point removal, are skipped, as are the point removals of
+// keys covered by an earlier range and not set since.
+func (s *Store) expandRangeDeletes(cs *corestore.Changeset) (*corestore.Changeset, error) {
+	if s.isMigrating || !hasRangeDelete(cs) {
+		return cs, nil
+	}
+
+	version := s.lastCommitInfo.GetVersion()
+	expanded := &corestore.Changeset{Changes: make([]corestore.StateChanges, len(cs.Changes))}
+	for i, sc := range cs.Changes {
+		pairs, err := s.expandActorRangeDeletes(sc, version)
+		if err != nil {
+			return nil, err
+		}
+
+		expanded.Changes[i] = corestore.StateChanges{Actor: sc.Actor, StateChanges: pairs}
+	}
+
+	return expanded, nil
+}
+
+// expandActorRangeDeletes expands the range deletions of the pairs of a single
+// actor, see expandRangeDeletes.
+func (s *Store) expandActorRangeDeletes(sc corestore.StateChanges, version uint64) ([]corestore.KVPair, error) {
+	var (
+		pairs = make([]corestore.KVPair, 0, len(sc.StateChanges))
+		// removed contains the keys removed by the pairs written so far, and set
+		// the keys set since their last removal
+		removed = make(map[string]bool)
+		set     = make(map[string]bool)
+		ranges  []corestore.KVPair
+	)
+	remove := func(key []byte) {
+		pairs = append(pairs, corestore.KVPair{Key: key, Remove: true})
+		removed[string(key)] = true
+		delete(set, string(key))
+	}
+
+	for _, kv := range sc.StateChanges {
+		switch {
+		case kv.IsRangeDelete():
+			var keys [][]byte
+			if version > 0 {
+				var err error
+				keys, err = s.liveKeys(sc.Actor, version, kv.Key, kv.RangeEnd)
+				if err != nil {
+					return nil, err
+				}
+			}
+			// the keys set earlier in the changeset are removed along with the
+			// live keys, in order
+			for key := range set {
+				if bytes.Compare([]byte(key), kv.Key) >= 0 && bytes.Compare([]byte(key), kv.RangeEnd) < 0 {
+					keys = append(keys, []byte(key))
+				}
+			}
+			slices.SortFunc(keys, bytes.Compare)
+
+			for i, key := range keys {
+				if removed[string(key)] || (i > 0 && bytes.Equal(key, keys[i-1])) {
+					continue
+				}
+				remove(key)
+			}
+			ranges = append(ranges, kv)
+
+		case kv.Remove:
+			if removed[string(kv.Key)] || (!set[string(kv.Key)] && coveredByRange(ranges, kv.Key)) {
+				continue
+			}
+			remove(kv.Key)
+
+		default:
+			pairs = append(pairs, kv)
+			delete(removed, string(kv.Key))
+			set[string(kv.Key)] = true
+		}
+	}
+
+	return pairs, nil
+}
+
+func coveredByRange(ranges []corestore.KVPair, key []byte) bool {
+	for _, r := range ranges {
+		if bytes.Compare(key, r.Key) >= 0 && bytes.Compare(key, r.RangeEnd) < 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasRangeDelete(cs *corestore.Changeset) bool {
+	for _, sc := range cs.Changes {
+		for _, kv := range sc.StateChanges {
+			if kv.IsRangeDelete() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // commitSC commits the SC store. At this point, a batch of the current changeset
 // should have already been written to the SC via WorkingHash(). This method
 // solely commits that batch. An error is returned if commit fails or if the
 // resulting commit hash is not equivalent to the working hash.
 func (s *Store) commitSC(cs *corestore.Changeset) error {
+	s.scMtx.Lock()
 	cInfo, err := s.stateCommitment.Commit(s.lastCommitInfo.Version)
+	s.scMtx.Unlock()
 	if err != nil {
 		return fmt.Errorf("failed to commit SC store: %w", err)
 	}
@@ -1,20 +1,30 @@
 package root
 
 import (
+	"context"
 	"fmt"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/collections"
 	coreheader "cosmossdk.io/core/header"
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/branch"
 	"cosmossdk.io/store/v2/commitment"
 	"cosmossdk.io/store/v2/commitment/iavl"
+	"cosmossdk.io/store/v2/commitment/smt"
 	dbm "cosmossdk.io/store/v2/db"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/pruning"
 	"cosmossdk.io/store/v2/storage"
 	"cosmossdk.io/store/v2/storage/sqlite"
+	"cosmossdk.io/store/v2/streaming"
 )
 
 const (
@@ -166,6 +176,85 @@ func (s *RootStoreTestSuite) TestQueryProof() {
 	s.Require().Equal(expRoots[0], cInfo.Hash())
 }
 
+func (s *RootStoreTestSuite) TestQueryHistoricalProof() {
+	// the retained tree keeps the 3 latest versions, i.e. versions 3 to 6
+	retention := &store.PruneOptions{KeepRecent: 3, Interval: 1}
+	s.Require().NoError(s.rootStore.(*Store).EnableHistoricalProofs(retention, testStoreKeyBytes))
+
+	commitHashes := make(map[uint64][]byte)
+	for v := uint64(1); v <= 6; v++ {
+		cs := corestore.NewChangeset()
+		switch v {
+		case 3:
+			cs.Add(testStoreKeyBytes, []byte("key001"), []byte("val001_003"), false)
+			cs.Add(testStoreKeyBytes, []byte("key002"), nil, true)
+		case 4:
+			// the store is not modified at version 4
+		default:
+			cs.Add(testStoreKeyBytes, []byte(fmt.Sprintf("key%03d", v)), []byte(fmt.Sprintf("val%03d", v)), false)
+		}
+		cs.Add(testStoreKey2Bytes, []byte("key"), []byte(fmt.Sprintf("val%03d", v)), false)
+
+		_, err := s.rootStore.WorkingHash(cs)
+		s.Require().NoError(err)
+		commitHashes[v], err = s.rootStore.Commit(cs)
+		s.Require().NoError(err)
+	}
+
+	// prune the SC backend s.t. proofs can only be served from the retained tree
+	s.Require().NoError(s.rootStore.GetStateCommitment().Prune(4))
+	_, err := s.rootStore.GetStateCommitment().GetProof(testStoreKeyBytes, 4, []byte("key001"))
+	var errPruned storeerrors.ErrVersionPruned
+	s.Require().ErrorAs(err, &errPruned)
+	s.Require().Equal(uint64(5), errPruned.EarliestVersion)
+
+	testCases := []struct {
+		version  uint64
+		key      string
+		expValue []byte
+	}{
+		// the store is modified after the version, at versions 5 and 6
+		{3, "key001", []byte("val001_003")},
+		{4, "key001", []byte("val001_003")},
+		{3, "key005", nil},
+		// the absence of removed keys and of keys never written is proven
+		{4, "key002", nil},
+		{4, "key999", nil},
+	}
+
+	for _, tc := range testCases {
+		result, err := s.rootStore.Query(testStoreKeyBytes, tc.version, []byte(tc.key), true)
+		s.Require().NoError(err)
+		s.Require().Equal(tc.expValue, result.Value)
+		s.Require().Equal(tc.version, result.Version)
+		s.Require().Len(result.ProofOps, 2)
+
+		// the proof chain must verify against the commit hash of the queried version
+		var args [][]byte
+		if tc.expValue != nil {
+			args = [][]byte{tc.expValue}
+		}
+		storeRoots, err := result.ProofOps[0].Run(args)
+		s.Require().NoError(err)
+		commitRoots, err := result.ProofOps[1].Run(storeRoots)
+		s.Require().NoError(err)
+		s.Require().Equal(commitHashes[tc.version], commitRoots[0])
+	}
+
+	// versions pruned from the retained tree cannot be proven
+	_, err = s.rootStore.Query(testStoreKeyBytes, 2, []byte("key001"), true)
+	s.Require().Error(err)
+
+	// keys of stores w/o historical proofs cannot be proven
+	_, err = s.rootStore.Query(testStoreKey2Bytes, 4, []byte("key"), true)
+	s.Require().ErrorIs(err, storeerrors.ErrProofUnavailable)
+
+	// versions still present in SC are proven by the SC backend
+	result, err := s.rootStore.Query(testStoreKeyBytes, 5, []byte("key001"), true)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), result.Version)
+}
+
 func (s *RootStoreTestSuite) TestLoadVersion() {
 	// write and commit a few changesets
 	for v := 1; v <= 5; v++ {
@@ -335,3 +424,346 @@ func (s *RootStoreTestSuite) TestStateAt() {
 		}
 	}
 }
+
+func (s *RootStoreTestSuite) TestAsyncPruning() {
+	rs := s.rootStore.(*Store)
+	s.Require().NoError(rs.EnableAsyncPruning(&store.PruneOptions{KeepRecent: 2, Interval: 5}, pruning.Options{Concurrency: 1, KeysPerSecond: 1000}))
+	s.Require().Error(rs.EnableAsyncPruning(store.DefaultPruneOptions(), pruning.Options{}))
+	s.Require().Error(rs.StartMigration())
+
+	for v := uint64(1); v <= 10; v++ {
+		cs := corestore.NewChangeset()
+		for i := 0; i < 10; i++ {
+			cs.Add(testStoreKeyBytes, []byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("val%03d_%03d", i, v)), false)
+		}
+
+		_, err := rs.WorkingHash(cs)
+		s.Require().NoError(err)
+		_, err = rs.Commit(cs)
+		s.Require().NoError(err)
+	}
+
+	// versions up to 7 are pruned, reads are rejected as soon as pruning is requested
+	s.Require().Equal(uint64(7), rs.pruningManager.PrunedVersion())
+	_, err := rs.Query(testStoreKeyBytes, 7, []byte("key000"), false)
+	s.Require().ErrorAs(err, &storeerrors.ErrVersionPruned{})
+	_, err = rs.StateAt(2)
+	s.Require().Error(err)
+
+	s.Require().NoError(rs.pruningManager.Wait())
+	s.Require().Equal(uint64(7), rs.pruningManager.CompletedVersion())
+
+	res, err := rs.Query(testStoreKeyBytes, 8, []byte("key000"), true)
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("val000_008"), res.Value)
+	s.Require().NotNil(res.ProofOps)
+
+	// the SS backend is pruned
+	_, err = rs.stateStorage.(*guardedStorage).VersionedDatabase.Get(testStoreKeyBytes, 7, []byte("key000"))
+	s.Require().Error(err)
+}
+
+func (s *RootStoreTestSuite) TestAsyncPruningOpenIterator() {
+	rs := s.rootStore.(*Store)
+	s.Require().NoError(rs.EnableAsyncPruning(&store.PruneOptions{}, pruning.Options{}))
+
+	for v := uint64(1); v <= 5; v++ {
+		cs := corestore.NewChangeset()
+		for i := 0; i < 10; i++ {
+			cs.Add(testStoreKeyBytes, []byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("val%03d_%03d", i, v)), false)
+		}
+
+		_, err := rs.WorkingHash(cs)
+		s.Require().NoError(err)
+		_, err = rs.Commit(cs)
+		s.Require().NoError(err)
+	}
+
+	itr, err := rs.stateStorage.Iterator(testStoreKeyBytes, 2, nil, nil)
+	s.Require().NoError(err)
+
+	// the version pinned by the iterator is not pruned, while new reads of it
+	// are rejected
+	rs.pruningManager.Prune(3)
+	_, err = rs.stateStorage.Get(testStoreKeyBytes, 2, []byte("key000"))
+	s.Require().ErrorAs(err, &storeerrors.ErrVersionPruned{})
+	s.Require().Eventually(func() bool { return rs.pruningManager.CompletedVersion() == 1 }, 5*time.Second, time.Millisecond)
+
+	count := 0
+	for ; itr.Valid(); itr.Next() {
+		s.Require().Equal([]byte(fmt.Sprintf("val%03d_002", count)), itr.Value())
+		count++
+	}
+	s.Require().NoError(itr.Error())
+	s.Require().Equal(10, count)
+
+	// pruning resumes once the iterator is closed
+	s.Require().NoError(itr.Close())
+	s.Require().NoError(rs.pruningManager.Wait())
+	s.Require().Equal(uint64(3), rs.pruningManager.CompletedVersion())
+}
+
+func (s *RootStoreTestSuite) TestAsyncPruningConcurrentCommit() {
+	noopLog := log.NewNopLogger()
+	sqliteDB, err := sqlite.New(s.T().TempDir())
+	s.Require().NoError(err)
+	smtTree, err := smt.NewSmtTree(dbm.NewMemDB(), noopLog)
+	s.Require().NoError(err)
+	sc, err := commitment.NewCommitStore(map[string]commitment.Tree{
+		testStoreKey:  iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig()),
+		testStoreKey2: iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig()),
+		testStoreKey3: smtTree,
+	}, dbm.NewMemDB(), nil, noopLog)
+	s.Require().NoError(err)
+	rootStore, err := New(noopLog, storage.NewStorageStore(sqliteDB, nil, noopLog), sc, nil, nil)
+	s.Require().NoError(err)
+	rs := rootStore.(*Store)
+	defer rs.Close()
+
+	s.Require().NoError(rs.EnableAsyncPruning(&store.PruneOptions{KeepRecent: 1, Interval: 1}, pruning.Options{}))
+
+	// the SC backend is pruned in the background after every commit, run with
+	// -race to check it is never pruned while committing
+	for v := uint64(1); v <= 50; v++ {
+		cs := corestore.NewChangeset()
+		for _, storeKey := range [][]byte{testStoreKeyBytes, testStoreKey2Bytes, testStoreKey3Bytes} {
+			for i := 0; i < 100; i++ {
+				cs.Add(storeKey, []byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("val%03d_%03d", i, v)), false)
+			}
+		}
+
+		_, err = rs.WorkingHash(cs)
+		s.Require().NoError(err)
+		_, err = rs.Commit(cs)
+		s.Require().NoError(err)
+	}
+
+	s.Require().NoError(rs.pruningManager.Wait())
+	s.Require().Equal(uint64(48), rs.pruningManager.CompletedVersion())
+
+	res, err := rs.Query(testStoreKey3Bytes, 50, []byte("key099"), true)
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("val099_050"), res.Value)
+	s.Require().NotNil(res.ProofOps)
+}
+
+// blockingCommitter blocks the pruning of the SC backend until released.
+type blockingCommitter struct {
+	store.Committer
+
+	pruning chan uint64
+	release chan struct{}
+}
+
+func (c *blockingCommitter) Prune(version uint64) error {
+	c.pruning <- version
+	<-c.release
+	return c.Committer.Prune(version)
+}
+
+func (s *RootStoreTestSuite) TestAsyncPruningBlocksCommit() {
+	rs := s.rootStore.(*Store)
+	sc := &blockingCommitter{Committer: rs.stateCommitment, pruning: make(chan uint64, 10), release: make(chan struct{})}
+	rs.stateCommitment = sc
+	var releaseOnce sync.Once
+	release := func() { releaseOnce.Do(func() { close(sc.release) }) }
+	// the pruning must be released for the store to be closed, even if the test
+	// fails
+	defer release()
+	s.Require().NoError(rs.EnableAsyncPruning(&store.PruneOptions{KeepRecent: 1, Interval: 1}, pruning.Options{}))
+
+	commit := func(v uint64) error {
+		cs := corestore.NewChangeset()
+		cs.Add(testStoreKeyBytes, []byte("key"), []byte(fmt.Sprintf("val%03d", v)), false)
+		if _, err := rs.WorkingHash(cs); err != nil {
+			return err
+		}
+		_, err := rs.Commit(cs)
+		return err
+	}
+	for v := uint64(1); v <= 3; v++ {
+		s.Require().NoError(commit(v))
+	}
+
+	// the next commit waits for the SC pruning in progress
+	s.Require().Equal(uint64(1), <-sc.pruning)
+	done := make(chan error, 1)
+	go func() { done <- commit(4) }()
+	s.Require().Never(func() bool { return len(done) > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+
+	release()
+	s.Require().NoError(<-done)
+	s.Require().NoError(rs.pruningManager.Wait())
+	s.Require().Equal(uint64(2), rs.pruningManager.CompletedVersion())
+}
+
+func (s *RootStoreTestSuite) TestStreaming() {
+	rs := s.rootStore.(*Store)
+	path := filepath.Join(s.T().TempDir(), "changesets.pb")
+	sink, err := streaming.NewFileSink(path)
+	s.Require().NoError(err)
+	defer sink.Close()
+
+	s.Require().NoError(rs.EnableStreaming(dbm.NewMemDB(), map[string]streaming.Sink{"file": sink}, streaming.Options{}))
+	s.Require().Error(rs.EnableStreaming(dbm.NewMemDB(), nil, streaming.Options{}))
+
+	for v := uint64(1); v <= 3; v++ {
+		rs.SetCommitHeader(&coreheader.Info{Height: int64(v), ChainID: "test"})
+
+		cs := corestore.NewChangeset()
+		cs.Add(testStoreKeyBytes, []byte(fmt.Sprintf("key%03d", v)), []byte(fmt.Sprintf("val%03d", v)), false)
+		// the key of the second store is alternately set and removed
+		cs.Add(testStoreKey2Bytes, []byte("key"), []byte("val"), v%2 == 0)
+
+		_, err := rs.WorkingHash(cs)
+		s.Require().NoError(err)
+		_, err = rs.Commit(cs)
+		s.Require().NoError(err)
+	}
+
+	s.Require().Eventually(func() bool { return rs.streamingManager.DeliveredVersion("file") == 3 }, 5*time.Second, time.Millisecond)
+
+	var events []*streaming.ChangesetEvent
+	s.Require().NoError(streaming.ReadFile(path, func(event *streaming.ChangesetEvent) error {
+		events = append(events, event)
+		return nil
+	}))
+	s.Require().Len(events, 3)
+	for i, event := range events {
+		v := uint64(i + 1)
+		s.Require().Equal(v, event.Version)
+		s.Require().Equal(int64(v), event.Header.Height)
+		s.Require().Equal([]streaming.StoreChanges{
+			{StoreKey: testStoreKeyBytes, Pairs: []streaming.KVPair{{Key: []byte(fmt.Sprintf("key%03d", v)), Value: []byte(fmt.Sprintf("val%03d", v))}}},
+			{StoreKey: testStoreKey2Bytes, Pairs: []streaming.KVPair{{Key: []byte("key"), Value: []byte("val"), Remove: v%2 == 0}}},
+		}, event.Changes)
+	}
+}
+
+// kvStoreService opens the writer of an actor of a branch.WriterMap.
+type kvStoreService struct {
+	writers *branch.WriterMap
+	actor   []byte
+}
+
+func (k kvStoreService) OpenKVStore(context.Context) corestore.KVStore {
+	writer, err := k.writers.GetWriter(k.actor)
+	if err != nil {
+		panic(err)
+	}
+
+	return writer.(corestore.KVStore)
+}
+
+func (s *RootStoreTestSuite) TestMapClear() {
+	ctx := context.Background()
+
+	// commit executes the given function against a branch of the latest state and
+	// commits the resulting state changes
+	commit := func(exec func(m collections.Map[uint64, uint64])) []corestore.StateChanges {
+		_, latest, err := s.rootStore.StateLatest()
+		s.Require().NoError(err)
+		writers := branch.NewWriterMap(latest)
+
+		sb := collections.NewSchemaBuilder(kvStoreService{writers: writers, actor: testStoreKeyBytes})
+		m := collections.NewMap(sb, collections.NewPrefix(0), "map", collections.Uint64Key, collections.Uint64Value)
+		exec(m)
+
+		stateChanges, err := writers.GetStateChanges()
+		s.Require().NoError(err)
+		cs := &corestore.Changeset{Changes: stateChanges}
+		_, err = s.rootStore.WorkingHash(cs)
+		s.Require().NoError(err)
+		_, err = s.rootStore.Commit(cs)
+		s.Require().NoError(err)
+
+		return stateChanges
+	}
+
+	commit(func(m collections.Map[uint64, uint64]) {
+		for i := uint64(0); i < 10; i++ {
+			s.Require().NoError(m.Set(ctx, i, i))
+		}
+	})
+
+	stateChanges := commit(func(m collections.Map[uint64, uint64]) {
+		s.Require().NoError(m.Set(ctx, 10, 10))
+		s.Require().NoError(m.Clear(ctx, new(collections.Range[uint64]).StartInclusive(2).EndExclusive(11)))
+		s.Require().NoError(m.Set(ctx, 4, 40))
+	})
+
+	// the range deletion is committed as a range tombstone, not a removal per key
+	s.Require().Len(stateChanges, 1)
+	s.Require().Len(stateChanges[0].StateChanges, 2)
+	s.Require().True(stateChanges[0].StateChanges[0].IsRangeDelete())
+
+	encode := func(k uint64) []byte {
+		key, err := collections.EncodeKeyWithPrefix(collections.NewPrefix(0).Bytes(), collections.Uint64Key, k)
+		s.Require().NoError(err)
+		return key
+	}
+
+	itr, err := s.rootStore.GetStateStorage().Iterator(testStoreKeyBytes, 2, nil, nil)
+	s.Require().NoError(err)
+	var keys [][]byte
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+	s.Require().NoError(itr.Close())
+	s.Require().Equal([][]byte{encode(0), encode(1), encode(4)}, keys)
+
+	for i := uint64(0); i <= 10; i++ {
+		value, err := s.rootStore.GetStateCommitment().Get(testStoreKeyBytes, 2, encode(i))
+		s.Require().NoError(err)
+		if i == 0 || i == 1 || i == 4 {
+			s.Require().NotNil(value, i)
+		} else {
+			s.Require().Nil(value, i)
+		}
+	}
+
+	// the removed keys remain readable at the previous version
+	value, err := s.rootStore.GetStateCommitment().Get(testStoreKeyBytes, 1, encode(5))
+	s.Require().NoError(err)
+	s.Require().NotNil(value)
+}
+
+func (s *RootStoreTestSuite) TestCommitOverlappingRemovals() {
+	commit := func(cs *corestore.Changeset) {
+		_, err := s.rootStore.WorkingHash(cs)
+		s.Require().NoError(err)
+		_, err = s.rootStore.Commit(cs)
+		s.Require().NoError(err)
+	}
+
+	cs := corestore.NewChangeset()
+	for _, key := range []string{"a", "b", "c", "d"} {
+		cs.Add(testStoreKeyBytes, []byte(key), []byte(key), false)
+	}
+	commit(cs)
+
+	// the same keys are removed by overlapping ranges and point removals, and
+	// set in between, as written by a branch store
+	commit(&corestore.Changeset{Changes: []corestore.StateChanges{{
+		Actor: testStoreKeyBytes,
+		StateChanges: []corestore.KVPair{
+			{Key: []byte("bb"), Value: []byte("bb")},
+			{Key: []byte("a"), Remove: true, RangeEnd: []byte("c")},
+			{Key: []byte("b"), Remove: true},
+			{Key: []byte("bb"), Remove: true},
+			{Key: []byte("b"), Remove: true, RangeEnd: []byte("d")},
+			{Key: []byte("a"), Value: []byte("a2")},
+			{Key: []byte("c"), Remove: true},
+		},
+	}}})
+
+	for key, expValue := range map[string][]byte{"a": []byte("a2"), "b": nil, "bb": nil, "c": nil, "d": []byte("d")} {
+		value, err := s.rootStore.GetStateCommitment().Get(testStoreKeyBytes, 2, []byte(key))
+		s.Require().NoError(err)
+		s.Require().Equal(expValue, value, key)
+
+		value, err = s.rootStore.GetStateStorage().Get(testStoreKeyBytes, 2, []byte(key))
+		s.Require().NoError(err)
+		s.Require().Equal(expValue, value, key)
+	}
+}
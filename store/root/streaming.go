@@ -0,0 +1,62 @@
+package root
+
+import (
+	"errors"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/streaming"
+)
+
+// EnableStreaming enables the delivery of the committed changesets, along with
+// the commit header if set, to the given named sinks, e.g. a streaming.FileSink
+// or a streaming.GRPCSink. The write-ahead log of the changesets and the delivery
+// progress of the sinks are kept in the given database, thus the changesets not
+// delivered before a restart are delivered once streaming is enabled again.
+func (s *Store) EnableStreaming(db store.RawDB, sinks map[string]streaming.Sink, opts streaming.Options) error {
+	if s.streamingManager != nil {
+		return errors.New("streaming is already enabled")
+	}
+
+	latestVersion, err := s.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+
+	m := streaming.NewManager(db, sinks, opts, s.logger, s.telemetry)
+	if err := m.Start(latestVersion); err != nil {
+		return fmt.Errorf("failed to start streaming: %w", err)
+	}
+	s.streamingManager = m
+
+	return nil
+}
+
+// prepareStreaming adds the changeset of the given version to the streaming
+// write-ahead log, if streaming is enabled.
+func (s *Store) prepareStreaming(version uint64, cs *corestore.Changeset) error {
+	if s.streamingManager == nil {
+		return nil
+	}
+
+	if err := s.streamingManager.Prepare(streaming.NewChangesetEvent(version, s.commitHeader, cs)); err != nil {
+		return fmt.Errorf("failed to prepare changeset streaming: %w", err)
+	}
+
+	return nil
+}
+
+// publishStreaming publishes the changeset of the given committed version to the
+// sinks, if streaming is enabled. It blocks while the sinks are too far behind.
+func (s *Store) publishStreaming(version uint64) error {
+	if s.streamingManager == nil {
+		return nil
+	}
+
+	if err := s.streamingManager.Publish(version); err != nil {
+		return fmt.Errorf("failed to publish changeset streaming: %w", err)
+	}
+
+	return nil
+}
@@ -8,9 +8,12 @@ import (
 	"io"
 	"math"
 	"os"
+	"slices"
 	"sort"
 	"sync"
 
+	protoio "github.com/cosmos/gogoproto/io"
+
 	corestore "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -185,20 +188,70 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	formats := m.opts.Formats
+	if len(formats) == 0 {
+		formats = []uint32{types.CurrentFormat}
+	}
+
+	var snapshot *types.Snapshot
+	for _, format := range formats {
+		s, err := m.createFormat(height, format)
+		if err != nil {
+			return nil, err
+		}
+		if snapshot == nil {
+			snapshot = s
+		}
+	}
+
+	return snapshot, nil
+}
+
+// createFormat creates a snapshot in the given format and saves it in the store.
+func (m *Manager) createFormat(height uint64, format uint32) (*types.Snapshot, error) {
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+	var zstdWriter *ZstdStreamWriter
+	switch format {
+	case types.FormatZlib:
+		go func() {
+			if streamWriter := NewStreamWriter(ch); streamWriter != nil {
+				m.createSnapshot(height, streamWriter)
+			}
+		}()
+
+	case types.FormatZstd:
+		var err error
+		zstdWriter, err = NewZstdStreamWriter(ch, m.opts.CompressionLevel)
+		if err != nil {
+			return nil, err
+		}
+		go m.createSnapshot(height, zstdWriter)
+
+	default:
+		return nil, errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", format)
+	}
+
+	snapshot, err := m.store.Save(height, format, ch)
+	if err != nil {
+		return nil, err
+	}
+
+	// the checksums are complete once all the chunks are saved
+	if zstdWriter != nil {
+		snapshot.Metadata.ChunkChecksums = zstdWriter.Checksums()
+		if err := m.store.saveSnapshot(snapshot); err != nil {
+			return nil, err
+		}
+	}
+
+	return snapshot, nil
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
-// the produced chunks are written to the channel.
-func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
-	streamWriter := NewStreamWriter(ch)
-	if streamWriter == nil {
-		return
-	}
+// the produced chunks are written to the stream writer.
+func (m *Manager) createSnapshot(height uint64, streamWriter WriteCloser) {
 	defer func() {
 		if err := streamWriter.Close(); err != nil {
 			streamWriter.CloseWithError(err)
@@ -310,9 +363,14 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	if !types.IsSupportedFormat(snapshot.Format) {
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
+	if snapshot.Format == types.FormatZstd && uint32(len(snapshot.Metadata.ChunkChecksums)) != snapshot.Chunks {
+		return errorsmod.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk checksums, but %v chunks",
+			uint32(len(snapshot.Metadata.ChunkChecksums)),
+			snapshot.Chunks)
+	}
 	if snapshot.Height == 0 {
 		return errorsmod.Wrap(storeerrors.ErrLogic, "cannot restore snapshot at height 0")
 	}
@@ -379,7 +437,7 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 	}
 
 	var nextItem types.SnapshotItem
-	streamReader, err := NewStreamReader(chChunks)
+	streamReader, err := newStreamReader(snapshot, chChunks)
 	if err != nil {
 		return err
 	}
@@ -548,6 +606,27 @@ func (m *Manager) sortedExtensionNames() []string {
 	return names
 }
 
+// newStreamReader returns the restore stream pipeline of the format of the given
+// snapshot.
+func newStreamReader(snapshot types.Snapshot, chunks <-chan io.ReadCloser) (protoio.ReadCloser, error) {
+	switch snapshot.Format {
+	case types.FormatZlib:
+		return NewStreamReader(chunks)
+
+	case types.FormatZstd:
+		return NewZstdStreamReader(chunks, snapshot.Metadata.ChunkChecksums)
+
+	default:
+		return nil, errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+}
+
+// SupportedFormats returns the snapshot formats the manager can restore, in
+// order of preference. Snapshots of other formats must be rejected when offered.
+func (m *Manager) SupportedFormats() []uint32 {
+	return slices.Clone(types.SupportedFormats)
+}
+
 // IsFormatSupported returns if the snapshotter supports restoration from given format.
 func IsFormatSupported(snapshotter ExtensionSnapshotter, format uint32) bool {
 	for _, i := range snapshotter.SupportedFormats() {
@@ -254,3 +254,51 @@ func TestManager_TakeError(t *testing.T) {
 	_, err = manager.Create(1)
 	require.Error(t, err)
 }
+
+func TestManager_Formats(t *testing.T) {
+	store, err := snapshots.NewStore(t.TempDir())
+	require.NoError(t, err)
+	items := [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	formatOpts := opts
+	formatOpts.Formats = []uint32{types.FormatZstd, types.FormatZlib}
+	formatOpts.CompressionLevel = 19
+	manager := snapshots.NewManager(store, formatOpts, &mockCommitSnapshotter{items: items}, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+	require.Equal(t, []uint32{types.FormatZstd, types.FormatZlib}, manager.SupportedFormats())
+
+	// the snapshot is taken in every format, the first one being returned
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+	require.Equal(t, types.FormatZstd, snapshot.Format)
+	require.Equal(t, uint32(1), snapshot.Chunks)
+	require.Len(t, snapshot.Metadata.ChunkChecksums, 1)
+
+	list, err := manager.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	stored, err := store.Get(5, types.FormatZstd)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, stored)
+	stored, err = store.Get(5, types.FormatZlib)
+	require.NoError(t, err)
+	require.Empty(t, stored.Metadata.ChunkChecksums)
+
+	chunk, err := manager.LoadChunk(5, types.FormatZstd, 0)
+	require.NoError(t, err)
+
+	target := &mockCommitSnapshotter{}
+	targetManager := snapshots.NewManager(setupStore(t), opts, target, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+
+	// unknown formats and zstd snapshots without checksums are rejected
+	invalid := *snapshot
+	invalid.Format = 99
+	require.ErrorIs(t, targetManager.Restore(invalid), types.ErrUnknownFormat)
+	invalid = *snapshot
+	invalid.Metadata.ChunkChecksums = nil
+	require.ErrorIs(t, targetManager.Restore(invalid), types.ErrInvalidMetadata)
+
+	require.NoError(t, targetManager.Restore(*snapshot))
+	done, err := targetManager.RestoreChunk(chunk)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, items, target.items)
+}
@@ -8,6 +8,16 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// Formats defines the formats each snapshot is taken in, the first one being
+	// the format returned by Manager.Create. Taking snapshots in several formats
+	// allows peers which cannot restore the preferred format to state sync from
+	// another one. If empty, snapshots are taken in types.CurrentFormat.
+	Formats []uint32
+
+	// CompressionLevel defines the zstd compression level of the snapshots taken
+	// in types.FormatZstd. If zero, DefaultZstdLevel is used.
+	CompressionLevel int
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
@@ -2,13 +2,18 @@ package snapshots
 
 import (
 	"bufio"
+	"bytes"
 	"compress/zlib"
+	"hash/crc32"
 	"io"
 
 	protoio "github.com/cosmos/gogoproto/io"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/klauspost/compress/zstd"
 
 	"cosmossdk.io/errors"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 )
 
 const (
@@ -17,8 +22,15 @@ const (
 	snapshotBufferSize = int(snapshotChunkSize)
 	// Do not change compression level without new snapshot format (must be uniform across nodes)
 	snapshotCompressionLevel = 7
+
+	// DefaultZstdLevel is the default zstd compression level of FormatZstd
+	// snapshots. Snapshots taken at different levels have different hashes, thus
+	// the nodes serving snapshots of a chain should use the same level.
+	DefaultZstdLevel = 3
 )
 
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
 type WriteCloser interface {
 	protoio.WriteCloser
 
@@ -118,3 +130,234 @@ func (sr *StreamReader) Close() error {
 	}
 	return err
 }
+
+// ZstdStreamWriter set up a stream pipeline to serialize snapshot items in the
+// FormatZstd format:
+// Exported Items -> delimited Protobuf -> fixed-size chunks -> zstd -> chan io.ReadCloser
+type ZstdStreamWriter struct {
+	chunkWriter *zstdChunkWriter
+	protoWriter protoio.WriteCloser
+}
+
+// NewZstdStreamWriter set up a stream pipeline to serialize snapshot items, the
+// chunks being compressed with the given zstd level. A zero level uses
+// DefaultZstdLevel.
+func NewZstdStreamWriter(ch chan<- io.ReadCloser, level int) (*ZstdStreamWriter, error) {
+	if level == 0 {
+		level = DefaultZstdLevel
+	}
+	encoder, err := zstd.NewWriter(nil,
+		zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)),
+		zstd.WithEncoderConcurrency(1),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "zstd failure")
+	}
+
+	chunkWriter := &zstdChunkWriter{
+		ch:      ch,
+		encoder: encoder,
+		buf:     make([]byte, 0, snapshotChunkSize),
+	}
+	return &ZstdStreamWriter{
+		chunkWriter: chunkWriter,
+		protoWriter: protoio.NewDelimitedWriter(chunkWriter),
+	}, nil
+}
+
+// WriteMsg implements protoio.Write interface
+func (sw *ZstdStreamWriter) WriteMsg(msg proto.Message) error {
+	return sw.protoWriter.WriteMsg(msg)
+}
+
+// Close implements io.Closer interface
+func (sw *ZstdStreamWriter) Close() error {
+	if err := sw.protoWriter.Close(); err != nil {
+		sw.chunkWriter.CloseWithError(err)
+		return err
+	}
+	return sw.chunkWriter.Close()
+}
+
+// CloseWithError pass error to the reader
+func (sw *ZstdStreamWriter) CloseWithError(err error) {
+	sw.chunkWriter.CloseWithError(err)
+}
+
+// Checksums returns the CRC-32C checksums of the chunks written so far, before
+// compression.
+func (sw *ZstdStreamWriter) Checksums() []uint32 {
+	return sw.chunkWriter.checksums
+}
+
+// zstdChunkWriter splits an input stream into fixed-size chunks, and writes them
+// compressed to a sequence of io.ReadClosers via a channel.
+type zstdChunkWriter struct {
+	ch        chan<- io.ReadCloser
+	encoder   *zstd.Encoder
+	buf       []byte
+	checksums []uint32
+	closed    bool
+}
+
+// Write implements io.Writer.
+func (w *zstdChunkWriter) Write(data []byte) (int, error) {
+	if w.closed {
+		return 0, errors.Wrap(storeerrors.ErrLogic, "cannot write to closed ChunkWriter")
+	}
+	nTotal := 0
+	for len(data) > 0 {
+		n := min(len(data), int(snapshotChunkSize)-len(w.buf))
+		w.buf = append(w.buf, data[:n]...)
+		nTotal += n
+		data = data[n:]
+		if len(w.buf) == int(snapshotChunkSize) {
+			w.flush()
+		}
+	}
+	return nTotal, nil
+}
+
+// flush compresses the buffered data into a new chunk.
+func (w *zstdChunkWriter) flush() {
+	if len(w.buf) == 0 {
+		return
+	}
+	w.checksums = append(w.checksums, crc32.Checksum(w.buf, crc32cTable))
+	w.ch <- io.NopCloser(bytes.NewReader(w.encoder.EncodeAll(w.buf, nil)))
+	w.buf = w.buf[:0]
+}
+
+// Close implements io.Closer.
+func (w *zstdChunkWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.flush()
+	w.closed = true
+	close(w.ch)
+	return w.encoder.Close()
+}
+
+// CloseWithError closes the writer and sends an error to the reader.
+func (w *zstdChunkWriter) CloseWithError(err error) {
+	if w.closed {
+		return
+	}
+	w.closed = true
+	pr, pw := io.Pipe()
+	w.ch <- pr
+	close(w.ch)
+	_ = pw.CloseWithError(err) // CloseWithError always returns nil
+	_ = w.encoder.Close()
+}
+
+// ZstdStreamReader set up a restore stream pipeline of FormatZstd snapshots:
+// chan io.ReadCloser -> zstd -> checksum verification -> delimited Protobuf -> ExportNode
+type ZstdStreamReader struct {
+	chunkReader *zstdChunkReader
+	protoReader protoio.ReadCloser
+}
+
+// NewZstdStreamReader set up a restore stream pipeline. The decompressed chunks
+// are verified against the given checksums, unless none are given.
+func NewZstdStreamReader(chunks <-chan io.ReadCloser, checksums []uint32) (*ZstdStreamReader, error) {
+	decoder, err := zstd.NewReader(nil,
+		zstd.WithDecoderConcurrency(1),
+		zstd.WithDecoderMaxMemory(snapshotChunkSize),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "zstd failure")
+	}
+
+	chunkReader := &zstdChunkReader{
+		ch:        chunks,
+		decoder:   decoder,
+		checksums: checksums,
+	}
+	return &ZstdStreamReader{
+		chunkReader: chunkReader,
+		protoReader: protoio.NewDelimitedReader(chunkReader, snapshotMaxItemSize),
+	}, nil
+}
+
+// ReadMsg implements protoio.Reader interface
+func (sr *ZstdStreamReader) ReadMsg(msg proto.Message) error {
+	return sr.protoReader.ReadMsg(msg)
+}
+
+// Close implements io.Closer interface
+func (sr *ZstdStreamReader) Close() error {
+	var err error
+	if err1 := sr.protoReader.Close(); err1 != nil {
+		err = err1
+	}
+	if err2 := sr.chunkReader.Close(); err2 != nil {
+		err = err2
+	}
+	return err
+}
+
+// zstdChunkReader reads compressed chunks from a channel of io.ReadClosers and
+// outputs them decompressed as an io.Reader.
+type zstdChunkReader struct {
+	ch        <-chan io.ReadCloser
+	decoder   *zstd.Decoder
+	checksums []uint32
+	index     int
+	reader    *bytes.Reader
+}
+
+// next decompresses and verifies the next chunk from the channel, or returns
+// io.EOF if there are no more chunks.
+func (r *zstdChunkReader) next() error {
+	chunk, ok := <-r.ch
+	if !ok {
+		return io.EOF
+	}
+	body, err := io.ReadAll(chunk)
+	_ = chunk.Close()
+	if err != nil {
+		return err
+	}
+
+	data, err := r.decoder.DecodeAll(body, nil)
+	if err != nil {
+		return errors.Wrapf(err, "zstd failure in chunk %d", r.index)
+	}
+	if r.checksums != nil {
+		if r.index >= len(r.checksums) {
+			return errors.Wrapf(snapshotstypes.ErrChunkChecksumMismatch, "no checksum for chunk %d", r.index)
+		}
+		if checksum := crc32.Checksum(data, crc32cTable); checksum != r.checksums[r.index] {
+			return errors.Wrapf(snapshotstypes.ErrChunkChecksumMismatch,
+				"chunk %d: expected %08x, got %08x", r.index, r.checksums[r.index], checksum)
+		}
+	}
+
+	r.index++
+	r.reader = bytes.NewReader(data)
+	return nil
+}
+
+// Read implements io.Reader.
+func (r *zstdChunkReader) Read(p []byte) (int, error) {
+	for r.reader == nil || r.reader.Len() == 0 {
+		if err := r.next(); err != nil {
+			return 0, err
+		}
+	}
+	return r.reader.Read(p)
+}
+
+// Close implements io.Closer.
+func (r *zstdChunkReader) Close() error {
+	var err error
+	for reader := range r.ch {
+		if e := reader.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	r.decoder.Close()
+	return err
+}
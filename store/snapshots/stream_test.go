@@ -0,0 +1,70 @@
+package snapshots_test
+
+import (
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/v2/snapshots"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+func TestZstdStream(t *testing.T) {
+	// the items exceed the chunk size, thus span several chunks
+	r := rand.New(rand.NewSource(1))
+	items := make([][]byte, 3)
+	for i := range items {
+		items[i] = make([]byte, 4e6)
+		_, _ = r.Read(items[i])
+	}
+
+	ch := make(chan io.ReadCloser)
+	streamWriter, err := snapshots.NewZstdStreamWriter(ch, 0)
+	require.NoError(t, err)
+	go func() {
+		for _, item := range items {
+			if err := snapshotstypes.WriteExtensionPayload(streamWriter, item); err != nil {
+				streamWriter.CloseWithError(err)
+				return
+			}
+		}
+		_ = streamWriter.Close()
+	}()
+
+	chunks := readChunks(ch)
+	require.Len(t, chunks, 2)
+	checksums := streamWriter.Checksums()
+	require.Len(t, checksums, 2)
+
+	readItems := func(checksums []uint32) ([][]byte, error) {
+		streamReader, err := snapshots.NewZstdStreamReader(makeChunks(chunks), checksums)
+		require.NoError(t, err)
+		defer streamReader.Close()
+
+		var res [][]byte
+		for {
+			var item snapshotstypes.SnapshotItem
+			if err := streamReader.ReadMsg(&item); err == io.EOF {
+				return res, nil
+			} else if err != nil {
+				return nil, err
+			}
+			res = append(res, item.GetExtensionPayload().Payload)
+		}
+	}
+
+	res, err := readItems(checksums)
+	require.NoError(t, err)
+	require.Equal(t, items, res)
+
+	// the checksums are optional
+	res, err = readItems(nil)
+	require.NoError(t, err)
+	require.Equal(t, items, res)
+
+	checksums[1]++
+	_, err = readItems(checksums)
+	require.ErrorIs(t, err, snapshotstypes.ErrChunkChecksumMismatch)
+}
@@ -11,6 +11,10 @@ var (
 	// ErrChunkHashMismatch is returned when chunk hash verification failed.
 	ErrChunkHashMismatch = errors.New("chunk hash verification failed")
 
+	// ErrChunkChecksumMismatch is returned when the checksum of a decompressed
+	// chunk does not match the snapshot metadata.
+	ErrChunkChecksumMismatch = errors.New("chunk checksum verification failed")
+
 	// ErrInvalidMetadata is returned when the snapshot metadata is invalid.
 	ErrInvalidMetadata = errors.New("invalid snapshot metadata")
 
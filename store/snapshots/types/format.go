@@ -1,6 +1,28 @@
 package types
 
+import "slices"
+
+const (
+	// FormatZlib is the snapshot format compressing the stream of snapshot items
+	// with zlib, the stream being split into fixed-size chunks.
+	FormatZlib uint32 = 3
+
+	// FormatZstd is the snapshot format splitting the stream of snapshot items into
+	// fixed-size chunks compressed independently with zstd. The snapshot metadata
+	// contains the CRC-32C checksums of the decompressed chunks.
+	FormatZstd uint32 = 4
+)
+
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
-const CurrentFormat uint32 = 3
+const CurrentFormat = FormatZlib
+
+// SupportedFormats are the snapshot formats which can be restored, in order of
+// preference.
+var SupportedFormats = []uint32{FormatZstd, FormatZlib}
+
+// IsSupportedFormat returns whether snapshots of the given format can be restored.
+func IsSupportedFormat(format uint32) bool {
+	return slices.Contains(SupportedFormats, format)
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -103,6 +104,9 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// CRC-32C checksums of the decompressed chunks, set by the snapshot formats
+	// compressing each chunk independently.
+	ChunkChecksums []uint32 `protobuf:"fixed32,2,rep,packed,name=chunk_checksums,json=chunkChecksums,proto3" json:"chunk_checksums,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -145,6 +149,13 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetChunkChecksums() []uint32 {
+	if m != nil {
+		return m.ChunkChecksums
+	}
+	return nil
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 //
 // Since: cosmos-sdk 0.46
@@ -499,38 +510,42 @@ func init() {
 }
 
 var fileDescriptor_3d5cca1aa5b69183 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x8e, 0xd7, 0xb4, 0x74, 0x2f, 0x41, 0xea, 0xac, 0x81, 0x02, 0x87, 0x2c, 0x84, 0x03, 0x91,
-	0x80, 0x94, 0x65, 0x1c, 0xb9, 0x50, 0x98, 0x94, 0x09, 0x90, 0x26, 0x4f, 0xe2, 0xc0, 0x65, 0xf2,
-	0x56, 0xd3, 0x44, 0x6d, 0xe2, 0xaa, 0xf6, 0x22, 0xfa, 0x2f, 0xf8, 0x23, 0xfc, 0x8f, 0x1d, 0x77,
-	0xe4, 0x34, 0x50, 0xfb, 0x47, 0x90, 0xed, 0x26, 0xa0, 0x6d, 0x45, 0xdb, 0xed, 0x7d, 0x2f, 0xdf,
-	0xf7, 0xf9, 0xf9, 0xcb, 0x33, 0x44, 0xa7, 0x5c, 0x14, 0x5c, 0xf4, 0x85, 0xe4, 0x33, 0xd6, 0x17,
-	0x25, 0x9d, 0x8a, 0x8c, 0x4b, 0xd1, 0xaf, 0x76, 0x1b, 0x10, 0x4f, 0x67, 0x5c, 0x72, 0xfc, 0xc8,
-	0x30, 0x63, 0xcd, 0x8c, 0x1b, 0x66, 0x5c, 0xed, 0x3e, 0xde, 0x1e, 0xf1, 0x11, 0xd7, 0xac, 0xbe,
-	0xaa, 0x8c, 0x20, 0xfc, 0x81, 0xa0, 0x7b, 0xb4, 0xa2, 0xe1, 0x87, 0xd0, 0xc9, 0x58, 0x3e, 0xca,
-	0xa4, 0x87, 0x02, 0x14, 0xd9, 0x64, 0x85, 0x54, 0xff, 0x2b, 0x9f, 0x15, 0x54, 0x7a, 0x1b, 0x01,
-	0x8a, 0xee, 0x93, 0x15, 0x52, 0xfd, 0xd3, 0xec, 0xac, 0x1c, 0x0b, 0xaf, 0x65, 0xfa, 0x06, 0x61,
-	0x0c, 0x76, 0x46, 0x45, 0xe6, 0xd9, 0x01, 0x8a, 0x5c, 0xa2, 0x6b, 0xbc, 0x0f, 0xdd, 0x82, 0x49,
-	0x3a, 0xa4, 0x92, 0x7a, 0xed, 0x00, 0x45, 0x4e, 0xf2, 0x34, 0x5e, 0x3b, 0x6c, 0xfc, 0x69, 0x45,
-	0x1d, 0xd8, 0xe7, 0x97, 0x3b, 0x16, 0x69, 0xa4, 0xe1, 0x4b, 0xe8, 0xd6, 0xdf, 0xf0, 0x13, 0x70,
-	0xf5, 0x81, 0xc7, 0xea, 0x00, 0x26, 0x3c, 0x14, 0xb4, 0x22, 0x97, 0x38, 0xba, 0x97, 0xea, 0x56,
-	0xf8, 0x6b, 0x03, 0xdc, 0xfa, 0x7a, 0x07, 0x92, 0x15, 0xf8, 0x3d, 0xb4, 0xf5, 0x71, 0xfa, 0x86,
-	0x4e, 0xf2, 0xe2, 0x3f, 0x33, 0xd4, 0xba, 0x23, 0xf5, 0x49, 0x89, 0x53, 0x8b, 0x18, 0x31, 0xfe,
-	0x00, 0x76, 0x4e, 0xab, 0x89, 0x8e, 0xc3, 0x49, 0x9e, 0xdf, 0xc2, 0xe4, 0xe0, 0xed, 0xe7, 0x8f,
-	0xca, 0x63, 0xd0, 0x5d, 0x5c, 0xee, 0xd8, 0x0a, 0xa5, 0x16, 0xd1, 0x26, 0xf8, 0x10, 0x36, 0xd9,
-	0x37, 0xc9, 0x4a, 0x91, 0xf3, 0x52, 0x07, 0xe9, 0x24, 0xaf, 0x6e, 0xe1, 0xb8, 0x5f, 0x6b, 0x54,
-	0x1e, 0xa9, 0x45, 0xfe, 0x9a, 0xe0, 0x13, 0xd8, 0x6a, 0xc0, 0xf1, 0x94, 0xce, 0x27, 0x9c, 0x0e,
-	0xf5, 0xcf, 0x70, 0x92, 0xbd, 0xbb, 0x38, 0x1f, 0x1a, 0x69, 0x6a, 0x91, 0x1e, 0xbb, 0xd2, 0x1b,
-	0x74, 0xc0, 0xce, 0x25, 0x2b, 0xc2, 0x67, 0xb0, 0x75, 0x2d, 0x28, 0xb5, 0x00, 0x25, 0x2d, 0x4c,
-	0xc8, 0x9b, 0x44, 0xd7, 0xe1, 0x04, 0x7a, 0x57, 0xc3, 0xc0, 0x3d, 0x68, 0x8d, 0xd9, 0x5c, 0xd3,
-	0x5c, 0xa2, 0x4a, 0xbc, 0x0d, 0xed, 0x8a, 0x4e, 0xce, 0x98, 0x8e, 0xd6, 0x25, 0x06, 0x60, 0x0f,
-	0xee, 0x55, 0x6c, 0xd6, 0x04, 0xd4, 0x22, 0x35, 0xfc, 0x67, 0x65, 0xd5, 0xfd, 0xda, 0xf5, 0xca,
-	0x86, 0xef, 0xe0, 0xc1, 0x8d, 0x41, 0xdd, 0x34, 0xda, 0xba, 0xfd, 0x0e, 0x5f, 0x83, 0xb7, 0x2e,
-	0x13, 0x35, 0x52, 0x9d, 0xac, 0x19, 0xbf, 0x86, 0x83, 0x37, 0xe7, 0x0b, 0x1f, 0x5d, 0x2c, 0x7c,
-	0xf4, 0x7b, 0xe1, 0xa3, 0xef, 0x4b, 0xdf, 0xba, 0x58, 0xfa, 0xd6, 0xcf, 0xa5, 0x6f, 0x7d, 0x09,
-	0x4d, 0xf6, 0x62, 0x38, 0x8e, 0x73, 0x7e, 0xed, 0x35, 0xcb, 0xf9, 0x94, 0x89, 0x93, 0x8e, 0x7e,
-	0x97, 0x7b, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xef, 0xe9, 0x8e, 0x10, 0xf4, 0x03, 0x00, 0x00,
+	// 556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0x8e, 0xdb, 0xb4, 0xeb, 0x9c, 0xc0, 0x3a, 0x33, 0x50, 0xd8, 0x21, 0x0b, 0xe1, 0x40, 0x24,
+	0x58, 0xba, 0x75, 0x88, 0x03, 0xda, 0x85, 0xc2, 0xa4, 0x56, 0x80, 0x34, 0x79, 0xd2, 0x84, 0xb8,
+	0x54, 0x5e, 0x6b, 0x9a, 0xaa, 0x4d, 0x5d, 0xd5, 0x6e, 0x45, 0x8f, 0xbc, 0x01, 0x2f, 0xc2, 0x8d,
+	0x87, 0xd8, 0x71, 0xe2, 0xc4, 0x69, 0x42, 0xed, 0x2b, 0xf0, 0x00, 0xc8, 0x76, 0x12, 0xd0, 0x96,
+	0xa2, 0xed, 0xf6, 0x7f, 0x9f, 0xff, 0xef, 0xcb, 0xef, 0xcf, 0xb1, 0x61, 0xd0, 0x61, 0x3c, 0x66,
+	0xbc, 0xc6, 0x05, 0x9b, 0xd0, 0x1a, 0x1f, 0x91, 0x31, 0x8f, 0x98, 0xe0, 0xb5, 0xd9, 0x7e, 0x06,
+	0xc2, 0xf1, 0x84, 0x09, 0x86, 0x1e, 0xea, 0xce, 0x50, 0x75, 0x86, 0x59, 0x67, 0x38, 0xdb, 0xdf,
+	0xde, 0xea, 0xb1, 0x1e, 0x53, 0x5d, 0x35, 0x59, 0x69, 0xc1, 0x76, 0x22, 0x68, 0xeb, 0x85, 0x44,
+	0xad, 0x80, 0xff, 0x0d, 0xc0, 0xca, 0x49, 0xe2, 0x80, 0x1e, 0xc0, 0x72, 0x44, 0xfb, 0xbd, 0x48,
+	0x38, 0xc0, 0x03, 0x81, 0x89, 0x13, 0x24, 0xf9, 0x4f, 0x6c, 0x12, 0x13, 0xe1, 0x14, 0x3c, 0x10,
+	0xdc, 0xc1, 0x09, 0x92, 0x7c, 0x27, 0x9a, 0x8e, 0x06, 0xdc, 0x29, 0x6a, 0x5e, 0x23, 0x84, 0xa0,
+	0x19, 0x11, 0x1e, 0x39, 0xa6, 0x07, 0x02, 0x1b, 0xab, 0x1a, 0x1d, 0xc1, 0x4a, 0x4c, 0x05, 0xe9,
+	0x12, 0x41, 0x9c, 0x92, 0x07, 0x02, 0xab, 0xfe, 0x38, 0x5c, 0xb9, 0x8f, 0xf0, 0x7d, 0xd2, 0xda,
+	0x30, 0xcf, 0x2f, 0x77, 0x0c, 0x9c, 0x49, 0xfd, 0x53, 0x58, 0x49, 0xd7, 0xd0, 0x23, 0x68, 0xab,
+	0x0f, 0xb6, 0xe5, 0x07, 0x28, 0x77, 0x80, 0x57, 0x0c, 0x6c, 0x6c, 0x29, 0xae, 0xa9, 0x28, 0xf4,
+	0x04, 0x6e, 0xe8, 0x96, 0x4e, 0x44, 0x3b, 0x03, 0x3e, 0x8d, 0xb9, 0x53, 0xf0, 0x8a, 0xc1, 0x1a,
+	0xbe, 0xab, 0xe8, 0xd7, 0x29, 0xeb, 0xff, 0x2e, 0x40, 0x3b, 0xcd, 0xa1, 0x25, 0x68, 0x8c, 0xde,
+	0xc0, 0x92, 0x9a, 0x4b, 0x45, 0x61, 0xd5, 0x9f, 0xfd, 0x67, 0xd8, 0x54, 0x77, 0x22, 0x97, 0xa4,
+	0xb8, 0x69, 0x60, 0x2d, 0x46, 0x6f, 0xa1, 0xd9, 0x27, 0xb3, 0xa1, 0xca, 0xcd, 0xaa, 0x3f, 0xbd,
+	0x81, 0x49, 0xeb, 0xd5, 0xe9, 0x3b, 0xe9, 0xd1, 0xa8, 0x2c, 0x2e, 0x77, 0x4c, 0x89, 0x9a, 0x06,
+	0x56, 0x26, 0xe8, 0x18, 0xae, 0xd3, 0xcf, 0x82, 0x8e, 0x78, 0x9f, 0x8d, 0x54, 0xe2, 0x56, 0x7d,
+	0xef, 0x06, 0x8e, 0x47, 0xa9, 0x46, 0x06, 0xd7, 0x34, 0xf0, 0x5f, 0x13, 0x74, 0x06, 0x37, 0x33,
+	0xd0, 0x1e, 0x93, 0xf9, 0x90, 0x91, 0xae, 0x3a, 0x35, 0xab, 0x7e, 0x70, 0x1b, 0xe7, 0x63, 0x2d,
+	0x6d, 0x1a, 0xb8, 0x4a, 0xaf, 0x70, 0x2f, 0xef, 0xfd, 0xf8, 0xbe, 0xbb, 0xa1, 0xbd, 0x76, 0x79,
+	0x77, 0xe0, 0xed, 0x85, 0xcf, 0x5f, 0x34, 0xca, 0xd0, 0xec, 0x0b, 0x1a, 0xfb, 0x87, 0x70, 0xf3,
+	0x5a, 0x7a, 0xf2, 0xf7, 0x19, 0x91, 0x58, 0x27, 0xbf, 0x8e, 0x55, 0x9d, 0xeb, 0xe2, 0x7f, 0x01,
+	0xb0, 0x7a, 0x35, 0x37, 0x54, 0x85, 0xc5, 0x01, 0x9d, 0x2b, 0xb1, 0x8d, 0x65, 0x89, 0xb6, 0x60,
+	0x69, 0x46, 0x86, 0x53, 0xaa, 0x4e, 0xc1, 0xc6, 0x1a, 0x20, 0x07, 0xae, 0xcd, 0xe8, 0x24, 0xcb,
+	0xb2, 0x88, 0x53, 0xf8, 0xcf, 0x35, 0x90, 0x51, 0x94, 0xd2, 0x6b, 0x90, 0x3f, 0xc3, 0x07, 0x78,
+	0x3f, 0x37, 0xe8, 0xbc, 0x5d, 0xac, 0xba, 0x48, 0xf9, 0xce, 0x2d, 0xe8, 0xac, 0x0a, 0x5a, 0x0e,
+	0x9f, 0x1e, 0x97, 0xde, 0x68, 0x0a, 0xf3, 0xe3, 0x3e, 0x3c, 0x5f, 0xb8, 0xe0, 0x62, 0xe1, 0x82,
+	0x5f, 0x0b, 0x17, 0x7c, 0x5d, 0xba, 0xc6, 0xc5, 0xd2, 0x35, 0x7e, 0x2e, 0x5d, 0xe3, 0xa3, 0xaf,
+	0x5b, 0x79, 0x77, 0x10, 0xf6, 0xd9, 0xb5, 0xb7, 0x47, 0xcc, 0xc7, 0x94, 0x9f, 0x95, 0xd5, 0x53,
+	0x71, 0xf0, 0x67, 0x00, 0x91, 0xea, 0x83, 0x07, 0xa2, 0x04, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -608,6 +623,15 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChunkChecksums) > 0 {
+		for iNdEx := len(m.ChunkChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.ChunkChecksums[iNdEx]))
+		}
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.ChunkChecksums)*4))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -925,6 +949,9 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if len(m.ChunkChecksums) > 0 {
+		n += 1 + sovSnapshot(uint64(len(m.ChunkChecksums)*4)) + len(m.ChunkChecksums)*4
+	}
 	return n
 }
 
@@ -1294,6 +1321,58 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.ChunkChecksums = append(m.ChunkChecksums, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSnapshot
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSnapshot
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSnapshot
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.ChunkChecksums) == 0 {
+					m.ChunkChecksums = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.ChunkChecksums = append(m.ChunkChecksums, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkChecksums", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -20,7 +20,54 @@ type Database interface {
 	Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error)
 	ReverseIterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error)
 
+	KeyHistory(storeKey, key []byte, fromVersion, toVersion uint64, limit int) ([]store.KeyChange, uint64, error)
+
 	Prune(version uint64) error
 
 	io.Closer
 }
+
+// HistoryEntry defines a single write of a key at a given version, as stored by
+// an SS backend. A deleted entry has no value.
+type HistoryEntry struct {
+	Key     []byte
+	Value   []byte
+	Version uint64
+	Deleted bool
+}
+
+// HistoryExporter is an optional interface a Database can implement to export
+// the full versioned history of its keys, e.g. to migrate the data to another
+// SS backend without resyncing.
+type HistoryExporter interface {
+	// ExportHistory calls fn for every entry of every key >= start of the given
+	// store key, ordered by key and then by ascending version. A nil start
+	// exports the entire store key. Iteration stops at the first error returned
+	// by fn.
+	ExportHistory(storeKey, start []byte, fn func(HistoryEntry) error) error
+}
+
+// KeyHistoryPage collects the changes of a key, in ascending order of version,
+// up to a limit. It lets a Database stop reading the history of a key once the
+// limit is reached. The changes committed at the same version are not split
+// across pages, thus the changes of the last version may exceed the limit.
+type KeyHistoryPage struct {
+	// Limit is the number of changes of the page, zero meaning no limit.
+	Limit   int
+	Changes []store.KeyChange
+	// NextVersion is the version of the first change not part of the page, it is
+	// zero until the page is full.
+	NextVersion uint64
+}
+
+// Add adds the given change to the page. It returns false if the page is full,
+// in which case the change is not added and NextVersion is set to its version.
+func (p *KeyHistoryPage) Add(change store.KeyChange) bool {
+	if n := len(p.Changes); p.Limit > 0 && n >= p.Limit && p.Changes[n-1].Version != change.Version {
+		p.NextVersion = change.Version
+		return false
+	}
+
+	p.Changes = append(p.Changes, change)
+	return true
+}
@@ -0,0 +1,35 @@
+package migrate
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/pebbledb"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+// Backend defines the type of an SS backend.
+type Backend string
+
+const (
+	BackendPebbleDB Backend = "pebbledb"
+	BackendRocksDB  Backend = "rocksdb"
+	BackendSQLite   Backend = "sqlite"
+)
+
+// OpenDatabase opens the SS backend of the given type at the given directory.
+// NOTE: RocksDB requires the rocksdb build tag.
+func OpenDatabase(backend Backend, dataDir string) (storage.Database, error) {
+	switch backend {
+	case BackendPebbleDB:
+		return pebbledb.New(dataDir)
+
+	case BackendRocksDB:
+		return openRocksDB(dataDir)
+
+	case BackendSQLite:
+		return sqlite.New(dataDir)
+	}
+
+	return nil, fmt.Errorf("unsupported SS backend: %s", backend)
+}
@@ -0,0 +1,14 @@
+//go:build !rocksdb
+// +build !rocksdb
+
+package migrate
+
+import (
+	"errors"
+
+	"cosmossdk.io/store/v2/storage"
+)
+
+func openRocksDB(string) (storage.Database, error) {
+	return nil, errors.New("rocksdb must be built with -tags rocksdb")
+}
@@ -0,0 +1,13 @@
+//go:build rocksdb
+// +build rocksdb
+
+package migrate
+
+import (
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/rocksdb"
+)
+
+func openRocksDB(dataDir string) (storage.Database, error) {
+	return rocksdb.New(dataDir)
+}
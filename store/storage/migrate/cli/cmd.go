@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/storage/migrate"
+)
+
+const (
+	flagStoreKeys   = "store-keys"
+	flagFromVersion = "from-version"
+	flagToVersion   = "to-version"
+	flagBatchSize   = "batch-size"
+	flagProgressDir = "progress-dir"
+	flagSkipVerify  = "skip-verify"
+	flagVerifyOnly  = "verify-only"
+
+	progressDBName = "ss_migration"
+)
+
+// NewMigrateCmd creates a command to migrate the state storage (SS) of a node
+// from one backend to another.
+func NewMigrateCmd() *cobra.Command {
+	var (
+		storeKeys              []string
+		fromVersion, toVersion uint64
+		batchSize              int
+		progressDir            string
+		skipVerify, verifyOnly bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "migrate-ss [source-backend] [source-dir] [target-backend] [target-dir]",
+		Short: "Migrate the state storage from one backend to another",
+		Long: `
+Migrate the versioned state storage (SS) data of the given store keys from one
+backend to another, e.g. from pebbledb to sqlite, without resyncing the node.
+Supported backends are pebbledb, rocksdb (requires the rocksdb build tag) and sqlite.
+
+All versions are migrated unless a retained window is provided. The progress is
+saved in the progress directory, thus an interrupted migration resumes where it
+stopped. Once a migration completes, running the command again only migrates the
+versions committed in the meantime. Unless skipped, the key counts and the
+per-version checksums of both backends are compared once the migration completes.
+`,
+		Example: "migrate-ss pebbledb ~/.simapp/data/ss sqlite ~/.simapp/data/ss-sqlite --store-keys bank,staking",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(storeKeys) == 0 {
+				return fmt.Errorf("--%s is required", flagStoreKeys)
+			}
+			if progressDir == "" {
+				progressDir = strings.TrimSuffix(args[3], "/") + ".migration"
+			}
+
+			logger := log.NewLogger(cmd.OutOrStdout())
+
+			if _, err := os.Stat(args[1]); err != nil {
+				return fmt.Errorf("invalid source directory: %w", err)
+			}
+			if err := os.MkdirAll(args[3], 0o755); err != nil {
+				return fmt.Errorf("failed to create target directory: %w", err)
+			}
+
+			source, err := migrate.OpenDatabase(migrate.Backend(args[0]), args[1])
+			if err != nil {
+				return fmt.Errorf("failed to open source backend: %w", err)
+			}
+			defer source.Close()
+
+			target, err := migrate.OpenDatabase(migrate.Backend(args[2]), args[3])
+			if err != nil {
+				return fmt.Errorf("failed to open target backend: %w", err)
+			}
+			defer target.Close()
+
+			progressDB, err := dbm.NewGoLevelDB(progressDBName, progressDir, nil)
+			if err != nil {
+				return fmt.Errorf("failed to open progress db: %w", err)
+			}
+			defer progressDB.Close()
+
+			keys := make([][]byte, len(storeKeys))
+			for i, storeKey := range storeKeys {
+				keys[i] = []byte(storeKey)
+			}
+
+			m, err := migrate.NewMigrator(source, target, progressDB, keys, migrate.Options{
+				FromVersion: fromVersion,
+				ToVersion:   toVersion,
+				BatchSize:   batchSize,
+			}, logger)
+			if err != nil {
+				return err
+			}
+
+			if !verifyOnly {
+				version, err := m.Migrate(cmd.Context())
+				if err != nil {
+					return err
+				}
+
+				cmd.Printf("Migrated state storage up to version %d\n", version)
+			}
+
+			if skipVerify {
+				return nil
+			}
+			if err := m.Verify(cmd.Context()); err != nil {
+				return fmt.Errorf("failed to verify the migration: %w", err)
+			}
+
+			cmd.Println("Verified the migrated state storage")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&storeKeys, flagStoreKeys, nil, "comma separated list of the store keys to migrate")
+	cmd.Flags().Uint64Var(&fromVersion, flagFromVersion, 0, "first version of the retained window to migrate, 0 migrates all versions")
+	cmd.Flags().Uint64Var(&toVersion, flagToVersion, 0, "last version to migrate, 0 defaults to the latest version of the source backend")
+	cmd.Flags().IntVar(&batchSize, flagBatchSize, 0, "number of entries written before the progress is saved")
+	cmd.Flags().StringVar(&progressDir, flagProgressDir, "", "directory of the migration progress, defaults to <target-dir>.migration")
+	cmd.Flags().BoolVar(&skipVerify, flagSkipVerify, false, "skip the verification of the migrated data")
+	cmd.Flags().BoolVar(&verifyOnly, flagVerifyOnly, false, "only verify the data migrated by the previous runs")
+	cmd.MarkFlagsMutuallyExclusive(flagSkipVerify, flagVerifyOnly)
+
+	return cmd
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/v2/storage/migrate"
+)
+
+func TestMigrateCmd(t *testing.T) {
+	dir := t.TempDir()
+	sourceDir, targetDir := filepath.Join(dir, "source"), filepath.Join(dir, "target")
+
+	source, err := migrate.OpenDatabase(migrate.BackendPebbleDB, sourceDir)
+	require.NoError(t, err)
+	for v := uint64(1); v <= 3; v++ {
+		batch, err := source.NewBatch(v)
+		require.NoError(t, err)
+		require.NoError(t, batch.Set([]byte("bank"), []byte{byte(v)}, []byte("value")))
+		require.NoError(t, batch.Write())
+	}
+	require.NoError(t, source.Close())
+
+	run := func(args ...string) (string, error) {
+		cmd := NewMigrateCmd()
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetErr(out)
+		cmd.SetArgs(args)
+
+		err := cmd.ExecuteContext(context.Background())
+		return out.String(), err
+	}
+
+	// the store keys are required
+	_, err = run("pebbledb", sourceDir, "sqlite", targetDir)
+	require.Error(t, err)
+
+	_, err = run("leveldb", sourceDir, "sqlite", targetDir, "--store-keys", "bank")
+	require.Error(t, err)
+
+	out, err := run("pebbledb", sourceDir, "sqlite", targetDir, "--store-keys", "bank")
+	require.NoError(t, err)
+	require.Contains(t, out, "Migrated state storage up to version 3")
+	require.Contains(t, out, "Verified the migrated state storage")
+
+	out, err = run("pebbledb", sourceDir, "sqlite", targetDir, "--store-keys", "bank", "--verify-only")
+	require.NoError(t, err)
+	require.NotContains(t, out, "Migrated state storage")
+	require.Contains(t, out, "Verified the migrated state storage")
+
+	target, err := migrate.OpenDatabase(migrate.BackendSQLite, targetDir)
+	require.NoError(t, err)
+	defer target.Close()
+
+	bz, err := target.Get([]byte("bank"), 3, []byte{2})
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), bz)
+}
@@ -0,0 +1,133 @@
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/store/v2/storage"
+)
+
+// checksum defines the checksum of the entries of a single version. The entry
+// hashes are combined with XOR, thus the checksum does not depend on the order
+// the entries are exported in.
+type checksum struct {
+	entries uint64
+	sum     [sha256.Size]byte
+}
+
+// digest summarizes the normalized history of a store key within a window.
+type digest struct {
+	// keys is the number of live keys at the last version of the window
+	keys uint64
+	// entries is the total number of entries
+	entries   uint64
+	checksums map[uint64]*checksum
+}
+
+// computeDigest computes the digest of the given store key within the window.
+func computeDigest(ctx context.Context, exporter storage.HistoryExporter, storeKey []byte, w window) (*digest, error) {
+	d := &digest{checksums: make(map[uint64]*checksum)}
+	n := newNormalizer(w)
+
+	err := exporter.ExportHistory(storeKey, nil, func(entry storage.HistoryEntry) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if n.key != nil && !bytes.Equal(n.key, entry.Key) {
+			d.add(n.finish()...)
+			if n.live {
+				d.keys++
+			}
+		}
+		d.add(n.add(entry)...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if n.key != nil {
+		d.add(n.finish()...)
+		if n.live {
+			d.keys++
+		}
+	}
+
+	return d, nil
+}
+
+func (d *digest) add(entries ...storage.HistoryEntry) {
+	for _, entry := range entries {
+		cs, ok := d.checksums[entry.Version]
+		if !ok {
+			cs = &checksum{}
+			d.checksums[entry.Version] = cs
+		}
+
+		h := hashEntry(entry)
+		for i := range cs.sum {
+			cs.sum[i] ^= h[i]
+		}
+		cs.entries++
+		d.entries++
+	}
+}
+
+// compare returns an error describing the first difference between the digests.
+func (d *digest) compare(other *digest) error {
+	if d.keys != other.keys {
+		return fmt.Errorf("expected %d keys, got %d", d.keys, other.keys)
+	}
+	if d.entries != other.entries {
+		return fmt.Errorf("expected %d entries, got %d", d.entries, other.entries)
+	}
+
+	versions := make([]uint64, 0, len(d.checksums))
+	for version := range d.checksums {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+
+	for _, version := range versions {
+		expected, actual := d.checksums[version], other.checksums[version]
+		if actual == nil {
+			return fmt.Errorf("no entries at version %d", version)
+		}
+		if expected.entries != actual.entries {
+			return fmt.Errorf("expected %d entries at version %d, got %d", expected.entries, version, actual.entries)
+		}
+		if expected.sum != actual.sum {
+			return fmt.Errorf("checksum mismatch at version %d: expected %X, got %X", version, expected.sum, actual.sum)
+		}
+	}
+
+	return nil
+}
+
+// hashEntry returns the hash of an entry, i.e. the hash of the length prefixed
+// key, the version, the deletion flag and the value.
+func hashEntry(entry storage.HistoryEntry) [sha256.Size]byte {
+	var buf [binary.MaxVarintLen64]byte
+
+	h := sha256.New()
+	h.Write(buf[:binary.PutUvarint(buf[:], uint64(len(entry.Key)))])
+	h.Write(entry.Key)
+	h.Write(buf[:binary.PutUvarint(buf[:], entry.Version)])
+	if entry.Deleted {
+		h.Write([]byte{1})
+	} else {
+		h.Write([]byte{0})
+		h.Write(entry.Value)
+	}
+
+	var sum [sha256.Size]byte
+	copy(sum[:], h.Sum(nil))
+
+	return sum
+}
@@ -0,0 +1,312 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"golang.org/x/sync/errgroup"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage"
+)
+
+const (
+	// defaultChannelBufferSize is the default buffer size of the entry stream
+	// between the source and the target backend.
+	defaultChannelBufferSize = 1024
+	// defaultBatchSize is the default number of entries written to the target
+	// backend before the migration progress is saved.
+	defaultBatchSize = 10_000
+)
+
+// Options defines the options of a Migrator.
+type Options struct {
+	// FromVersion defines the first version of the retained window to migrate.
+	// The state at FromVersion is written to the target backend at FromVersion,
+	// all versions below it are not migrated. Zero migrates all the versions.
+	FromVersion uint64
+	// ToVersion defines the last version to migrate. Zero defaults to the latest
+	// version of the source backend.
+	ToVersion uint64
+	// BatchSize defines the number of entries written to the target backend
+	// before the migration progress is saved.
+	BatchSize int
+}
+
+// Migrator migrates the versioned data of a set of store keys from one SS
+// backend to another, e.g. from PebbleDB to SQLite, without resyncing the node.
+//
+// The entries of the source backend are streamed to the target backend store
+// key by store key and written with their original versions. The progress is
+// saved in a separate database, such that an interrupted migration resumes where
+// it stopped. Once a migration completes, running it again with a higher target
+// version only migrates the versions committed in the meantime, which allows to
+// catch up with a source backend which is still in use.
+type Migrator struct {
+	logger    log.Logger
+	source    storage.Database
+	target    storage.Database
+	storeKeys [][]byte
+	progress  *progress
+	opts      Options
+}
+
+// NewMigrator returns a new Migrator migrating the given store keys from the
+// source to the target backend. The source backend must implement the
+// storage.HistoryExporter interface. The migration progress is kept in db.
+func NewMigrator(
+	source, target storage.Database,
+	db store.RawDB,
+	storeKeys [][]byte,
+	opts Options,
+	logger log.Logger,
+) (*Migrator, error) {
+	if _, ok := source.(storage.HistoryExporter); !ok {
+		return nil, fmt.Errorf("source backend %T does not support exporting its history", source)
+	}
+	if len(storeKeys) == 0 {
+		return nil, errors.New("no store keys provided")
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultBatchSize
+	}
+
+	// the store keys are migrated in order so the progress can be resumed
+	storeKeys = append([][]byte(nil), storeKeys...)
+	sort.Slice(storeKeys, func(i, j int) bool { return string(storeKeys[i]) < string(storeKeys[j]) })
+
+	return &Migrator{
+		logger:    logger,
+		source:    source,
+		target:    target,
+		storeKeys: storeKeys,
+		progress:  &progress{db: db},
+		opts:      opts,
+	}, nil
+}
+
+// Migrate runs the migration until all the store keys are migrated up to the
+// target version or the context is canceled. It returns the migrated version.
+func (m *Migrator) Migrate(ctx context.Context) (uint64, error) {
+	run, err := m.startRun()
+	if err != nil {
+		return 0, err
+	}
+
+	m.logger.Info("migrating state storage",
+		"from", run.from, "to", run.to, "after", run.after, "store_keys", len(m.storeKeys))
+
+	for _, storeKey := range m.storeKeys {
+		cursor, done, err := m.progress.cursor(storeKey)
+		if err != nil {
+			return 0, err
+		}
+		if done {
+			continue
+		}
+
+		if err := m.migrateStoreKey(ctx, run, storeKey, cursor); err != nil {
+			return 0, fmt.Errorf("failed to migrate store key %s: %w", storeKey, err)
+		}
+	}
+
+	if err := m.finishRun(run); err != nil {
+		return 0, err
+	}
+
+	m.logger.Info("migrated state storage", "version", run.to)
+
+	return run.to, nil
+}
+
+// Verify compares the key counts and the per-version checksums of the migrated
+// versions of the source and the target backend. It returns an error describing
+// the first mismatch, if any.
+func (m *Migrator) Verify(ctx context.Context) error {
+	from, completed, err := m.progress.completed()
+	if err != nil {
+		return err
+	}
+	if completed == 0 {
+		return errors.New("no migration has been completed yet")
+	}
+
+	targetExporter, ok := m.target.(storage.HistoryExporter)
+	if !ok {
+		return fmt.Errorf("target backend %T does not support exporting its history", m.target)
+	}
+
+	w := window{from: from, to: completed}
+	for _, storeKey := range m.storeKeys {
+		expected, err := computeDigest(ctx, m.source.(storage.HistoryExporter), storeKey, w)
+		if err != nil {
+			return fmt.Errorf("failed to compute source digest of store key %s: %w", storeKey, err)
+		}
+		actual, err := computeDigest(ctx, targetExporter, storeKey, w)
+		if err != nil {
+			return fmt.Errorf("failed to compute target digest of store key %s: %w", storeKey, err)
+		}
+
+		if err := expected.compare(actual); err != nil {
+			return fmt.Errorf("store key %s mismatch: %w", storeKey, err)
+		}
+
+		m.logger.Info("verified store key", "store_key", string(storeKey), "keys", expected.keys, "entries", expected.entries)
+	}
+
+	return nil
+}
+
+// startRun returns the run in progress, or starts a new one.
+func (m *Migrator) startRun() (window, error) {
+	run, ok, err := m.progress.run()
+	if err != nil || ok {
+		return run, err
+	}
+
+	from, completed, err := m.progress.completed()
+	if err != nil {
+		return window{}, err
+	}
+
+	to := m.opts.ToVersion
+	if to == 0 {
+		if to, err = m.source.GetLatestVersion(); err != nil {
+			return window{}, err
+		}
+	}
+
+	if completed == 0 {
+		// the first run migrates the retained window
+		run = window{from: m.opts.FromVersion, to: to}
+	} else {
+		// subsequent runs only migrate the versions committed in the meantime
+		if to <= completed {
+			return window{}, fmt.Errorf("version %d is already migrated", to)
+		}
+		run = window{from: from, after: completed, to: to}
+	}
+	if run.from > run.to {
+		return window{}, fmt.Errorf("from version %d is greater than to version %d", run.from, run.to)
+	}
+
+	return run, m.progress.setRun(run, m.storeKeys)
+}
+
+// finishRun marks the given run as completed and updates the metadata of the
+// target backend.
+func (m *Migrator) finishRun(run window) error {
+	if run.after == 0 && run.from > 1 {
+		// versions below the retained window are not available in the target
+		if err := m.target.Prune(run.from - 1); err != nil {
+			return fmt.Errorf("failed to prune target backend: %w", err)
+		}
+	}
+
+	if err := m.target.SetLatestVersion(run.to); err != nil {
+		return fmt.Errorf("failed to set the latest version of the target backend: %w", err)
+	}
+
+	return m.progress.setCompleted(run, m.storeKeys)
+}
+
+// migrateStoreKey streams the entries of the given store key from the source to
+// the target backend, starting at the given key.
+func (m *Migrator) migrateStoreKey(ctx context.Context, run window, storeKey, cursor []byte) error {
+	m.logger.Info("migrating store key", "store_key", string(storeKey), "cursor", fmt.Sprintf("%X", cursor))
+
+	chEntries := make(chan storage.HistoryEntry, defaultChannelBufferSize)
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		defer close(chEntries)
+
+		return m.source.(storage.HistoryExporter).ExportHistory(storeKey, cursor, func(entry storage.HistoryEntry) error {
+			select {
+			case chEntries <- entry:
+				return nil
+
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	})
+	eg.Go(func() error {
+		var (
+			n       = newNormalizer(run)
+			pending []storage.HistoryEntry
+			total   int
+		)
+
+		for entry := range chEntries {
+			if n.key != nil && string(n.key) != string(entry.Key) {
+				pending = append(pending, n.finish()...)
+
+				// keys before the current one are completely written once the
+				// pending entries are flushed, so the progress can be saved
+				if len(pending) >= m.opts.BatchSize {
+					if err := m.flush(storeKey, pending, entry.Key); err != nil {
+						return err
+					}
+					total += len(pending)
+					pending = pending[:0]
+				}
+			}
+
+			pending = append(pending, n.add(entry)...)
+		}
+		pending = append(pending, n.finish()...)
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := m.flush(storeKey, pending, nil); err != nil {
+			return err
+		}
+		total += len(pending)
+
+		m.logger.Info("migrated store key", "store_key", string(storeKey), "entries", total)
+
+		return nil
+	})
+
+	return eg.Wait()
+}
+
+// flush writes the given entries to the target backend, one batch per version,
+// and saves the progress of the store key, i.e. the next key to migrate. A nil
+// next key marks the store key as done.
+func (m *Migrator) flush(storeKey []byte, entries []storage.HistoryEntry, next []byte) error {
+	// the entries are ordered by key, a stable sort keeps the versions of each
+	// key in ascending order across the batches
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Version < entries[j].Version })
+
+	for i := 0; i < len(entries); {
+		version := entries[i].Version
+
+		batch, err := m.target.NewBatch(version)
+		if err != nil {
+			return err
+		}
+
+		for ; i < len(entries) && entries[i].Version == version; i++ {
+			if entries[i].Deleted {
+				err = batch.Delete(storeKey, entries[i].Key)
+			} else {
+				err = batch.Set(storeKey, entries[i].Key, entries[i].Value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := batch.Write(); err != nil {
+			return fmt.Errorf("failed to write batch of version %d: %w", version, err)
+		}
+	}
+
+	return m.progress.setCursor(storeKey, next)
+}
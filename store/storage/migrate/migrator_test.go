@@ -0,0 +1,269 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/storage"
+)
+
+var storeKeys = [][]byte{[]byte("store1"), []byte("store2")}
+
+// writeVersions writes the versions in [start, end] to the given database. Every
+// version sets ten keys, overwrites a key of the previous version and deletes a
+// key of the version before.
+func writeVersions(t *testing.T, db storage.Database, start, end uint64) {
+	t.Helper()
+
+	for v := start; v <= end; v++ {
+		batch, err := db.NewBatch(v)
+		require.NoError(t, err)
+
+		for _, storeKey := range storeKeys {
+			for i := 0; i < 10; i++ {
+				require.NoError(t, batch.Set(storeKey, []byte(fmt.Sprintf("key-%03d-%d", v, i)), []byte(fmt.Sprintf("val-%d-%d", v, i))))
+			}
+			if v > 1 {
+				require.NoError(t, batch.Set(storeKey, []byte(fmt.Sprintf("key-%03d-0", v-1)), []byte(fmt.Sprintf("updated-%d", v))))
+			}
+			if v > 2 {
+				require.NoError(t, batch.Delete(storeKey, []byte(fmt.Sprintf("key-%03d-1", v-2))))
+			}
+		}
+
+		require.NoError(t, batch.Write())
+	}
+}
+
+func openDB(t *testing.T, backend Backend) storage.Database {
+	t.Helper()
+
+	db, err := OpenDatabase(backend, t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db
+}
+
+// requireEqualState ensures the store keys of both databases are equal at the
+// given version.
+func requireEqualState(t *testing.T, expected, actual storage.Database, version uint64) {
+	t.Helper()
+
+	for _, storeKey := range storeKeys {
+		var expectedKVs, actualKVs []string
+		for _, kv := range []struct {
+			db  storage.Database
+			res *[]string
+		}{{expected, &expectedKVs}, {actual, &actualKVs}} {
+			itr, err := kv.db.Iterator(storeKey, version, nil, nil)
+			require.NoError(t, err)
+			for ; itr.Valid(); itr.Next() {
+				*kv.res = append(*kv.res, fmt.Sprintf("%s=%s", itr.Key(), itr.Value()))
+			}
+			require.NoError(t, itr.Close())
+		}
+
+		require.NotEmpty(t, expectedKVs)
+		require.Equal(t, expectedKVs, actualKVs, "store key %s at version %d", storeKey, version)
+	}
+}
+
+func TestMigrator(t *testing.T) {
+	for _, tc := range []struct {
+		source, target Backend
+	}{
+		{BackendPebbleDB, BackendSQLite},
+		{BackendSQLite, BackendPebbleDB},
+		{BackendPebbleDB, BackendPebbleDB},
+	} {
+		t.Run(fmt.Sprintf("%s to %s", tc.source, tc.target), func(t *testing.T) {
+			source := openDB(t, tc.source)
+			target := openDB(t, tc.target)
+			writeVersions(t, source, 1, 10)
+
+			m, err := NewMigrator(source, target, dbm.NewMemDB(), storeKeys, Options{BatchSize: 7}, log.NewNopLogger())
+			require.NoError(t, err)
+
+			version, err := m.Migrate(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, uint64(10), version)
+			require.NoError(t, m.Verify(context.Background()))
+
+			latest, err := target.GetLatestVersion()
+			require.NoError(t, err)
+			require.Equal(t, uint64(10), latest)
+			for v := uint64(1); v <= 10; v++ {
+				requireEqualState(t, source, target, v)
+			}
+
+			// catch up with the versions committed in the meantime
+			writeVersions(t, source, 11, 15)
+			version, err = m.Migrate(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, uint64(15), version)
+			require.NoError(t, m.Verify(context.Background()))
+			for v := uint64(1); v <= 15; v++ {
+				requireEqualState(t, source, target, v)
+			}
+
+			_, err = m.Migrate(context.Background())
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestMigratorWindow(t *testing.T) {
+	source := openDB(t, BackendPebbleDB)
+	target := openDB(t, BackendSQLite)
+	writeVersions(t, source, 1, 10)
+
+	m, err := NewMigrator(source, target, dbm.NewMemDB(), storeKeys, Options{FromVersion: 5, ToVersion: 8}, log.NewNopLogger())
+	require.NoError(t, err)
+
+	version, err := m.Migrate(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), version)
+	require.NoError(t, m.Verify(context.Background()))
+
+	for v := uint64(5); v <= 8; v++ {
+		requireEqualState(t, source, target, v)
+	}
+
+	// versions below the window are pruned and versions above it are not migrated
+	_, err = target.Get(storeKeys[0], 4, []byte("key-004-0"))
+	require.Error(t, err)
+	bz, err := target.Get(storeKeys[0], 10, []byte("key-009-0"))
+	require.NoError(t, err)
+	require.Nil(t, bz)
+}
+
+// failingDatabase fails to create batches once the limit is reached.
+type failingDatabase struct {
+	storage.Database
+	limit int
+}
+
+func (db *failingDatabase) NewBatch(version uint64) (store.Batch, error) {
+	if db.limit == 0 {
+		return nil, errors.New("failed to create batch")
+	}
+	db.limit--
+
+	return db.Database.NewBatch(version)
+}
+
+func TestMigratorResume(t *testing.T) {
+	source := openDB(t, BackendSQLite)
+	target := openDB(t, BackendPebbleDB)
+	writeVersions(t, source, 1, 10)
+
+	progressDB := dbm.NewMemDB()
+
+	m, err := NewMigrator(source, &failingDatabase{Database: target, limit: 12}, progressDB, storeKeys, Options{BatchSize: 20}, log.NewNopLogger())
+	require.NoError(t, err)
+	_, err = m.Migrate(context.Background())
+	require.Error(t, err)
+
+	// the first store key is migrated partially
+	cursor, done, err := m.progress.cursor(storeKeys[0])
+	require.NoError(t, err)
+	require.False(t, done)
+	require.NotEmpty(t, cursor)
+
+	// resume the migration, the source may have moved on in the meantime
+	writeVersions(t, source, 11, 12)
+	m, err = NewMigrator(source, target, progressDB, storeKeys, Options{BatchSize: 20}, log.NewNopLogger())
+	require.NoError(t, err)
+
+	version, err := m.Migrate(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), version)
+	require.NoError(t, m.Verify(context.Background()))
+	for v := uint64(1); v <= 10; v++ {
+		requireEqualState(t, source, target, v)
+	}
+}
+
+func TestMigratorCancel(t *testing.T) {
+	source := openDB(t, BackendPebbleDB)
+	target := openDB(t, BackendSQLite)
+	writeVersions(t, source, 1, 5)
+
+	m, err := NewMigrator(source, target, dbm.NewMemDB(), storeKeys, Options{}, log.NewNopLogger())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = m.Migrate(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestNormalizer(t *testing.T) {
+	entry := func(version uint64, deleted bool) storage.HistoryEntry {
+		e := storage.HistoryEntry{Key: []byte("key"), Version: version, Deleted: deleted}
+		if !deleted {
+			e.Value = []byte(fmt.Sprintf("val%d", version))
+		}
+		return e
+	}
+
+	history := []storage.HistoryEntry{
+		entry(1, true), // deleting a non-existent key is dropped
+		entry(2, false),
+		entry(3, true),
+		entry(4, true), // deleting a deleted key is dropped
+		entry(5, false),
+		entry(7, false),
+		entry(9, true),
+	}
+
+	testCases := []struct {
+		name     string
+		w        window
+		expected []storage.HistoryEntry
+	}{
+		{
+			"all versions",
+			window{to: 10},
+			[]storage.HistoryEntry{entry(2, false), entry(3, true), entry(5, false), entry(7, false), entry(9, true)},
+		},
+		{
+			"retained window",
+			window{from: 6, to: 8},
+			[]storage.HistoryEntry{{Key: []byte("key"), Value: []byte("val5"), Version: 6}, entry(7, false)},
+		},
+		{
+			"retained window of a deleted key",
+			window{from: 4, to: 10},
+			[]storage.HistoryEntry{entry(5, false), entry(7, false), entry(9, true)},
+		},
+		{
+			"subsequent run",
+			window{from: 6, after: 7, to: 10},
+			[]storage.HistoryEntry{entry(9, true)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			n := newNormalizer(tc.w)
+
+			var res []storage.HistoryEntry
+			for _, e := range history {
+				res = append(res, n.add(e)...)
+			}
+			res = append(res, n.finish()...)
+
+			require.Equal(t, tc.expected, res)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package migrate
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/store/v2"
+)
+
+const (
+	runKey       = "m/run"       // the window of the run in progress
+	completedKey = "m/completed" // the from version and the last migrated version
+	cursorKeyFmt = "m/cursor/%s" // m/cursor/<storeKey>
+
+	cursorInProgress byte = 0
+	cursorDone       byte = 1
+)
+
+// progress persists the progress of a migration, so it can be resumed after an
+// interruption.
+type progress struct {
+	db store.RawDB
+}
+
+// run returns the window of the run in progress, if any.
+func (p *progress) run() (window, bool, error) {
+	bz, err := p.db.Get([]byte(runKey))
+	if err != nil || bz == nil {
+		return window{}, false, err
+	}
+	if len(bz) != 24 {
+		return window{}, false, fmt.Errorf("invalid migration run length: %d", len(bz))
+	}
+
+	return window{
+		from:  binary.BigEndian.Uint64(bz[0:8]),
+		after: binary.BigEndian.Uint64(bz[8:16]),
+		to:    binary.BigEndian.Uint64(bz[16:24]),
+	}, true, nil
+}
+
+// setRun starts a new run, resetting the cursors of the given store keys.
+func (p *progress) setRun(run window, storeKeys [][]byte) error {
+	batch := p.db.NewBatch()
+	defer batch.Close()
+
+	bz := make([]byte, 24)
+	binary.BigEndian.PutUint64(bz[0:8], run.from)
+	binary.BigEndian.PutUint64(bz[8:16], run.after)
+	binary.BigEndian.PutUint64(bz[16:24], run.to)
+	if err := batch.Set([]byte(runKey), bz); err != nil {
+		return err
+	}
+
+	for _, storeKey := range storeKeys {
+		if err := batch.Delete(cursorKey(storeKey)); err != nil {
+			return err
+		}
+	}
+
+	return batch.WriteSync()
+}
+
+// completed returns the from version and the last version migrated by the
+// completed runs, the latter is zero if no run completed yet.
+func (p *progress) completed() (uint64, uint64, error) {
+	bz, err := p.db.Get([]byte(completedKey))
+	if err != nil || bz == nil {
+		return 0, 0, err
+	}
+	if len(bz) != 16 {
+		return 0, 0, fmt.Errorf("invalid migration completed length: %d", len(bz))
+	}
+
+	return binary.BigEndian.Uint64(bz[0:8]), binary.BigEndian.Uint64(bz[8:16]), nil
+}
+
+// setCompleted marks the given run as completed.
+func (p *progress) setCompleted(run window, storeKeys [][]byte) error {
+	batch := p.db.NewBatch()
+	defer batch.Close()
+
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz[0:8], run.from)
+	binary.BigEndian.PutUint64(bz[8:16], run.to)
+	if err := batch.Set([]byte(completedKey), bz); err != nil {
+		return err
+	}
+	if err := batch.Delete([]byte(runKey)); err != nil {
+		return err
+	}
+
+	for _, storeKey := range storeKeys {
+		if err := batch.Delete(cursorKey(storeKey)); err != nil {
+			return err
+		}
+	}
+
+	return batch.WriteSync()
+}
+
+// cursor returns the next key to migrate of the given store key in the run in
+// progress, and whether the store key is done.
+func (p *progress) cursor(storeKey []byte) ([]byte, bool, error) {
+	bz, err := p.db.Get(cursorKey(storeKey))
+	if err != nil || len(bz) == 0 {
+		return nil, false, err
+	}
+
+	return bz[1:], bz[0] == cursorDone, nil
+}
+
+// setCursor saves the next key to migrate of the given store key, a nil key
+// marks the store key as done.
+func (p *progress) setCursor(storeKey, next []byte) error {
+	bz := []byte{cursorInProgress}
+	if next == nil {
+		bz[0] = cursorDone
+	}
+	bz = append(bz, next...)
+
+	batch := p.db.NewBatch()
+	defer batch.Close()
+
+	if err := batch.Set(cursorKey(storeKey), bz); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+func cursorKey(storeKey []byte) []byte {
+	return []byte(fmt.Sprintf(cursorKeyFmt, storeKey))
+}
@@ -0,0 +1,98 @@
+package migrate
+
+import (
+	"bytes"
+
+	"cosmossdk.io/store/v2/storage"
+)
+
+// window defines the versions migrated by a run.
+type window struct {
+	// from defines the first version of the retained window, the state at from
+	// is written at from. Zero retains all the versions.
+	from uint64
+	// after defines the last version migrated by a previous run, only versions
+	// greater than after are written. Zero if there is no previous run.
+	after uint64
+	// to defines the last version to migrate.
+	to uint64
+}
+
+// normalizer converts the history of a key exported by a backend into the entries
+// to write to the target backend for a window.
+//
+// Backends differ in how they record deletions of keys which do not exist at
+// the time, e.g. PebbleDB records them while SQLite does not, thus such deletions
+// are dropped. The history of a key must be added in ascending version order.
+type normalizer struct {
+	window
+
+	key  []byte
+	live bool
+	// base is the latest entry of the key at or below the from version, which is
+	// written at the from version
+	base *storage.HistoryEntry
+}
+
+func newNormalizer(w window) *normalizer {
+	return &normalizer{window: w}
+}
+
+// add adds the next entry of the history of the current key, or of a new key
+// if the current key is finished, and returns the entries to write.
+func (n *normalizer) add(entry storage.HistoryEntry) []storage.HistoryEntry {
+	if !bytes.Equal(n.key, entry.Key) {
+		n.key = entry.Key
+		n.live = false
+		n.base = nil
+	}
+
+	if entry.Version > n.to {
+		return nil
+	}
+
+	if entry.Deleted {
+		if !n.live {
+			return nil
+		}
+		n.live = false
+	} else {
+		n.live = true
+	}
+
+	switch {
+	case n.after > 0 && entry.Version <= n.after:
+		// already migrated by a previous run
+		return nil
+
+	case n.after == 0 && n.from > 0 && entry.Version <= n.from:
+		if entry.Deleted {
+			n.base = nil
+		} else {
+			n.base = &storage.HistoryEntry{Key: entry.Key, Value: entry.Value, Version: n.from}
+		}
+
+		return nil
+	}
+
+	return append(n.flushBase(), entry)
+}
+
+// finish finishes the current key and returns the entries to write.
+func (n *normalizer) finish() []storage.HistoryEntry {
+	entries := n.flushBase()
+	n.key = nil
+
+	return entries
+}
+
+func (n *normalizer) flushBase() []storage.HistoryEntry {
+	if n.base == nil {
+		return nil
+	}
+
+	entries := []storage.HistoryEntry{*n.base}
+	n.base = nil
+
+	return entries
+}
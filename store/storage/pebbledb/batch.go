@@ -1,6 +1,7 @@
 package pebbledb
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"github.com/cockroachdb/pebble"
 
 	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
 )
 
 var _ store.Batch = (*Batch)(nil)
@@ -65,6 +67,70 @@ func (b *Batch) Delete(storeKey, key []byte) error {
 	return b.set(storeKey, b.version, key, []byte(tombstoneVal))
 }
 
+// DeleteRange writes a tombstone, at the version of the batch, for every key in
+// the range [start, end) of the given store key which is live at the version of
+// the batch, including the keys set earlier in the batch. PebbleDB range
+// deletions are not used as they would remove the history of the keys.
+func (b *Batch) DeleteRange(storeKey, start, end []byte) error {
+	if len(start) == 0 || len(end) == 0 {
+		return storeerrors.ErrKeyEmpty
+	}
+	if bytes.Compare(start, end) >= 0 {
+		return nil
+	}
+
+	// the keys set earlier in the batch, collected first as the batch cannot be
+	// read while being written to
+	prefix := storePrefix(storeKey)
+	var keys [][]byte
+	reader := b.batch.Reader()
+	for {
+		kind, ukey, _, ok, err := reader.Next()
+		if err != nil {
+			return fmt.Errorf("failed to read PebbleDB batch: %w", err)
+		}
+		if !ok {
+			break
+		}
+		if kind != pebble.InternalKeyKindSet {
+			continue
+		}
+
+		prefixedKey, _, ok := SplitMVCCKey(ukey)
+		if !ok || !bytes.HasPrefix(prefixedKey, prefix) {
+			continue
+		}
+		key := prefixedKey[len(prefix):]
+		if bytes.Compare(key, start) >= 0 && bytes.Compare(key, end) < 0 {
+			keys = append(keys, bytes.Clone(key))
+		}
+	}
+	for _, key := range keys {
+		if err := b.Delete(storeKey, key); err != nil {
+			return err
+		}
+	}
+
+	// the keys live in the committed versions, the tombstones are written while
+	// iterating s.t. the keys of the range are not held in memory
+	itr, err := b.storage.NewIter(&pebble.IterOptions{
+		LowerBound: MVCCEncode(prependStoreKey(storeKey, start), 0),
+		UpperBound: MVCCEncode(prependStoreKey(storeKey, end), 0),
+	})
+	if err != nil {
+		return err
+	}
+
+	liveItr := newPebbleDBIterator(itr, prefix, start, end, b.version, 0, false)
+	for ; liveItr.Valid(); liveItr.Next() {
+		if err := b.Delete(storeKey, liveItr.Key()); err != nil {
+			return errors.Join(err, liveItr.Close())
+		}
+	}
+
+	return liveItr.Close()
+}
+
 func (b *Batch) Write() (err error) {
 	defer func() {
 		err = errors.Join(err, b.batch.Close())
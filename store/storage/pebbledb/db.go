@@ -14,6 +14,7 @@ import (
 	"cosmossdk.io/store/v2"
 	storeerrors "cosmossdk.io/store/v2/errors"
 	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/util"
 )
 
 const (
@@ -28,7 +29,11 @@ const (
 	tombstoneVal     = "TOMBSTONE"
 )
 
-var _ storage.Database = (*Database)(nil)
+var (
+	_ storage.Database        = (*Database)(nil)
+	_ storage.HistoryExporter = (*Database)(nil)
+	_ store.LimitedPruner     = (*Database)(nil)
+)
 
 type Database struct {
 	storage *pebble.DB
@@ -196,6 +201,12 @@ func (db *Database) Get(storeKey []byte, targetVersion uint64, key []byte) ([]by
 //
 // See: https://github.com/cockroachdb/cockroach/blob/33623e3ee420174a4fd3226d1284b03f0e3caaac/pkg/storage/mvcc.go#L3182
 func (db *Database) Prune(version uint64) error {
+	return db.PruneLimited(version, nil)
+}
+
+// PruneLimited implements store.LimitedPruner, wait is called prior to committing
+// every batch of deletions.
+func (db *Database) PruneLimited(version uint64, wait func(n int) error) error {
 	itr, err := db.storage.NewIter(&pebble.IterOptions{LowerBound: []byte("s/k:")})
 	if err != nil {
 		return err
@@ -240,6 +251,11 @@ func (db *Database) Prune(version uint64) error {
 
 			batchCounter++
 			if batchCounter >= PruneCommitBatchSize {
+				if wait != nil {
+					if err := wait(batchCounter); err != nil {
+						return err
+					}
+				}
 				if err := batch.Commit(&pebble.WriteOptions{Sync: db.sync}); err != nil {
 					return err
 				}
@@ -259,6 +275,11 @@ func (db *Database) Prune(version uint64) error {
 
 	// commit any leftover delete ops in batch
 	if batchCounter > 0 {
+		if wait != nil {
+			if err := wait(batchCounter); err != nil {
+				return err
+			}
+		}
 		if err := batch.Commit(&pebble.WriteOptions{Sync: db.sync}); err != nil {
 			return err
 		}
@@ -315,6 +336,110 @@ func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end
 	return newPebbleDBIterator(itr, storePrefix(storeKey), start, end, version, db.earliestVersion, true), nil
 }
 
+// KeyHistory returns the changes of the given key committed between fromVersion
+// and toVersion, both inclusive, by iterating over the MVCC versions of the key
+// until the limit is reached. Note, a deletion is stored as a tombstoned entry at
+// the version of the deletion.
+func (db *Database) KeyHistory(storeKey, key []byte, fromVersion, toVersion uint64, limit int) ([]store.KeyChange, uint64, error) {
+	if fromVersion < db.earliestVersion {
+		return nil, 0, storeerrors.ErrVersionPruned{EarliestVersion: db.earliestVersion}
+	}
+
+	prefixedKey := prependStoreKey(storeKey, key)
+	itr, err := db.storage.NewIter(&pebble.IterOptions{
+		LowerBound: MVCCEncode(prefixedKey, fromVersion),
+		UpperBound: MVCCEncode(util.CopyIncr(prefixedKey), 0),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	defer itr.Close()
+
+	page := storage.KeyHistoryPage{Limit: limit}
+	for itr.First(); itr.Valid(); itr.Next() {
+		keyBz, verBz, ok := SplitMVCCKey(itr.Key())
+		if !ok {
+			return nil, 0, fmt.Errorf("invalid PebbleDB MVCC key: %s", itr.Key())
+		}
+		// the bounds include the keys prefixed by the given key
+		if !bytes.Equal(keyBz, prefixedKey) {
+			break
+		}
+
+		version, err := decodeUint64Ascending(verBz)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to decode key version: %w", err)
+		}
+		if version > toVersion {
+			break
+		}
+
+		valBz, tombBz, ok := SplitMVCCKey(itr.Value())
+		if !ok {
+			return nil, 0, fmt.Errorf("invalid PebbleDB MVCC value: %s", itr.Value())
+		}
+
+		change := store.KeyChange{Version: version, Deleted: len(tombBz) > 0}
+		if !change.Deleted {
+			change.Value = valBz
+		}
+		if !page.Add(change) {
+			break
+		}
+	}
+
+	return page.Changes, page.NextVersion, itr.Error()
+}
+
+// ExportHistory implements storage.HistoryExporter. Note, a deletion is stored as
+// a tombstoned entry at the version of the deletion.
+func (db *Database) ExportHistory(storeKey, start []byte, fn func(storage.HistoryEntry) error) error {
+	prefix := storePrefix(storeKey)
+
+	itr, err := db.storage.NewIter(&pebble.IterOptions{
+		LowerBound: MVCCEncode(prependStoreKey(storeKey, start), 0),
+		UpperBound: util.CopyIncr(prefix),
+	})
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	for itr.First(); itr.Valid(); itr.Next() {
+		keyBz, verBz, ok := SplitMVCCKey(itr.Key())
+		if !ok {
+			return fmt.Errorf("invalid PebbleDB MVCC key: %s", itr.Key())
+		}
+
+		var version uint64
+		if len(verBz) > 0 {
+			if version, err = decodeUint64Ascending(verBz); err != nil {
+				return fmt.Errorf("failed to decode key version: %w", err)
+			}
+		}
+
+		valBz, tombBz, ok := SplitMVCCKey(itr.Value())
+		if !ok {
+			return fmt.Errorf("invalid PebbleDB MVCC value: %s", itr.Value())
+		}
+
+		entry := storage.HistoryEntry{
+			Key:     keyBz[len(prefix):],
+			Version: version,
+			Deleted: len(tombBz) > 0,
+		}
+		if !entry.Deleted {
+			entry.Value = valBz
+		}
+
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+
+	return itr.Error()
+}
+
 func storePrefix(storeKey []byte) []byte {
 	return append([]byte(StorePrefixTpl), storeKey...)
 }
@@ -4,11 +4,14 @@
 package rocksdb
 
 import (
+	"bytes"
 	"encoding/binary"
 
 	"github.com/linxGnu/grocksdb"
 
 	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/storage/util"
 )
 
 var _ store.Batch = (*Batch)(nil)
@@ -61,6 +64,64 @@ func (b Batch) Delete(storeKey []byte, key []byte) error {
 	return nil
 }
 
+// DeleteRange writes a tombstone, at the version of the batch, for every key in
+// the range [start, end) of the given store key which is live at the version of
+// the batch, including the keys set earlier in the batch. RocksDB range
+// deletions are not used as they would remove the history of the keys.
+func (b Batch) DeleteRange(storeKey, start, end []byte) error {
+	if len(start) == 0 || len(end) == 0 {
+		return errors.ErrKeyEmpty
+	}
+	if bytes.Compare(start, end) >= 0 {
+		return nil
+	}
+
+	// the keys set earlier in the batch, which are suffixed by their timestamp,
+	// collected first as the batch cannot be iterated while being written to
+	prefix := storePrefix(storeKey)
+	var keys [][]byte
+	batchItr := b.batch.NewIterator()
+	for batchItr.Next() {
+		record := batchItr.Record()
+		if record.Type != grocksdb.WriteBatchCFValueRecord && record.Type != grocksdb.WriteBatchValueRecord {
+			continue
+		}
+		if len(record.Key) < TimestampSize {
+			continue
+		}
+
+		prefixedKey := record.Key[:len(record.Key)-TimestampSize]
+		if !bytes.HasPrefix(prefixedKey, prefix) {
+			continue
+		}
+		key := prefixedKey[len(prefix):]
+		if bytes.Compare(key, start) >= 0 && bytes.Compare(key, end) < 0 {
+			keys = append(keys, bytes.Clone(key))
+		}
+	}
+	if err := batchItr.Error(); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := b.Delete(storeKey, key); err != nil {
+			return err
+		}
+	}
+
+	// the keys live in the committed versions, the tombstones are written while
+	// iterating s.t. the keys of the range are not held in memory
+	prefixedStart, prefixedEnd := util.IterateWithPrefix(prefix, start, end)
+	itr := newRocksDBIterator(b.storage.NewIteratorCF(newTSReadOptions(b.version), b.cfHandle), prefix, prefixedStart, prefixedEnd, false)
+	for ; itr.Valid(); itr.Next() {
+		if err := b.Delete(storeKey, itr.Key()); err != nil {
+			_ = itr.Close()
+			return err
+		}
+	}
+
+	return itr.Close()
+}
+
 func (b Batch) Write() error {
 	defer b.batch.Destroy()
 	return b.storage.Write(defaultWriteOpts, b.batch)
@@ -7,7 +7,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
-
+	"math"
 	"slices"
 
 	"github.com/linxGnu/grocksdb"
@@ -22,12 +22,18 @@ import (
 const (
 	TimestampSize = 8
 
+	// RocksDB internal value types of deletion markers
+	valueTypeDeletion              byte = 0x0
+	valueTypeSingleDeletion        byte = 0x7
+	valueTypeDeletionWithTimestamp byte = 0x14
+
 	StorePrefixTpl   = "s/k:%s/"
 	latestVersionKey = "s/latest"
 )
 
 var (
-	_ storage.Database = (*Database)(nil)
+	_ storage.Database        = (*Database)(nil)
+	_ storage.HistoryExporter = (*Database)(nil)
 
 	defaultWriteOpts = grocksdb.NewDefaultWriteOptions()
 	defaultReadOpts  = grocksdb.NewDefaultReadOptions()
@@ -197,6 +203,156 @@ func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end
 	return newRocksDBIterator(itr, prefix, start, end, true), nil
 }
 
+// KeyHistory returns the changes of the given key committed between fromVersion
+// and toVersion, both inclusive. Similar to ExportHistory, the iterator timestamps
+// are bounded to the given versions. RocksDB orders the versions of a key from
+// newest to oldest and does not support reverse iteration with an iterator start
+// timestamp, thus the whole range is iterated, however only the oldest changes
+// needed to fill the page are retained.
+func (db *Database) KeyHistory(storeKey, key []byte, fromVersion, toVersion uint64, limit int) ([]store.KeyChange, uint64, error) {
+	if fromVersion < db.tsLow {
+		return nil, 0, errors.ErrVersionPruned{EarliestVersion: db.tsLow}
+	}
+
+	var startTS, endTS [TimestampSize]byte
+	binary.LittleEndian.PutUint64(startTS[:], fromVersion)
+	binary.LittleEndian.PutUint64(endTS[:], toVersion)
+
+	readOpts := grocksdb.NewDefaultReadOptions()
+	defer readOpts.Destroy()
+	readOpts.SetTimestamp(endTS[:])
+	readOpts.SetIterStartTimestamp(startTS[:])
+
+	itr := db.storage.NewIteratorCF(readOpts, db.cfHandle)
+	defer itr.Close()
+
+	prefixedKey := prependStoreKey(storeKey, key)
+
+	// changes are ordered from newest to oldest
+	var changes []store.KeyChange
+	for itr.Seek(prefixedKey); itr.Valid(); itr.Next() {
+		internalKey := copyAndFreeSlice(itr.Key())
+		if len(internalKey) < TimestampSize+8 {
+			return nil, 0, fmt.Errorf("invalid RocksDB internal key: %X", internalKey)
+		}
+
+		n := len(internalKey) - 8 - TimestampSize
+		if !bytes.Equal(internalKey[:n], prefixedKey) {
+			break
+		}
+
+		change := store.KeyChange{Version: binary.LittleEndian.Uint64(internalKey[n : n+TimestampSize])}
+
+		// the lowest byte of the packed sequence number denotes the value type
+		switch internalKey[n+TimestampSize] {
+		case valueTypeDeletion, valueTypeSingleDeletion, valueTypeDeletionWithTimestamp:
+			change.Deleted = true
+
+		default:
+			change.Value = copyAndFreeSlice(itr.Value())
+		}
+
+		changes = append(changes, change)
+
+		// the newest change is dropped once the one following it is newer than
+		// the page, which ends at the version of the limit-th oldest change
+		for limit > 0 && len(changes) > limit+1 && changes[1].Version != changes[len(changes)-limit].Version {
+			changes = changes[1:]
+		}
+	}
+	if err := itr.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	slices.Reverse(changes)
+
+	page := storage.KeyHistoryPage{Limit: limit}
+	for _, change := range changes {
+		if !page.Add(change) {
+			break
+		}
+	}
+
+	return page.Changes, page.NextVersion, nil
+}
+
+// ExportHistory implements storage.HistoryExporter. It iterates over all the
+// versions of every key by setting the iterator start timestamp, in which case
+// RocksDB returns the internal keys, i.e. <user_key><timestamp><seq_and_type>,
+// including deletion markers. Note, RocksDB orders the versions of a key from
+// newest to oldest, thus they are reversed before being exported.
+func (db *Database) ExportHistory(storeKey, start []byte, fn func(storage.HistoryEntry) error) error {
+	prefix := storePrefix(storeKey)
+	lower, upper := util.IterateWithPrefix(prefix, start, nil)
+
+	var startTS, endTS [TimestampSize]byte
+	binary.LittleEndian.PutUint64(endTS[:], math.MaxUint64)
+
+	readOpts := grocksdb.NewDefaultReadOptions()
+	defer readOpts.Destroy()
+	readOpts.SetTimestamp(endTS[:])
+	readOpts.SetIterStartTimestamp(startTS[:])
+
+	itr := db.storage.NewIteratorCF(readOpts, db.cfHandle)
+	defer itr.Close()
+
+	var (
+		curKey  []byte
+		entries []storage.HistoryEntry
+	)
+	flush := func() error {
+		for i := len(entries) - 1; i >= 0; i-- {
+			if err := fn(entries[i]); err != nil {
+				return err
+			}
+		}
+		entries = entries[:0]
+
+		return nil
+	}
+
+	for itr.Seek(lower); itr.Valid(); itr.Next() {
+		internalKey := copyAndFreeSlice(itr.Key())
+		if len(internalKey) < len(prefix)+TimestampSize+8 {
+			return fmt.Errorf("invalid RocksDB internal key: %X", internalKey)
+		}
+
+		n := len(internalKey) - 8 - TimestampSize
+		userKey := internalKey[:n]
+		if bytes.Compare(userKey, upper) >= 0 {
+			break
+		}
+
+		if !bytes.Equal(userKey, curKey) {
+			if err := flush(); err != nil {
+				return err
+			}
+			curKey = userKey
+		}
+
+		entry := storage.HistoryEntry{
+			Key:     userKey[len(prefix):],
+			Version: binary.LittleEndian.Uint64(internalKey[n : n+TimestampSize]),
+		}
+
+		// the lowest byte of the packed sequence number denotes the value type
+		switch internalKey[n+TimestampSize] {
+		case valueTypeDeletion, valueTypeSingleDeletion, valueTypeDeletionWithTimestamp:
+			entry.Deleted = true
+
+		default:
+			entry.Value = copyAndFreeSlice(itr.Value())
+		}
+
+		entries = append(entries, entry)
+	}
+	if err := itr.Err(); err != nil {
+		return err
+	}
+
+	return flush()
+}
+
 // newTSReadOptions returns ReadOptions used in the RocksDB column family read.
 func newTSReadOptions(version uint64) *grocksdb.ReadOptions {
 	var ts [TimestampSize]byte
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
 )
 
 var _ store.Batch = (*Batch)(nil)
@@ -14,12 +15,16 @@ type batchAction int
 const (
 	batchActionSet batchAction = 0
 	batchActionDel batchAction = 1
+
+	batchActionDelRange batchAction = 2
 )
 
 type batchOp struct {
 	action     batchAction
 	storeKey   []byte
 	key, value []byte
+	// end is the exclusive end of the range of a range deletion
+	end []byte
 }
 
 type Batch struct {
@@ -74,6 +79,19 @@ func (b *Batch) Delete(storeKey, key []byte) error {
 	return nil
 }
 
+// DeleteRange tombstones every key in the range [start, end) of the given store
+// key which is live at the version of the batch, including the keys set earlier
+// in the batch.
+func (b *Batch) DeleteRange(storeKey, start, end []byte) error {
+	if len(start) == 0 || len(end) == 0 {
+		return storeerrors.ErrKeyEmpty
+	}
+
+	b.size += len(start) + len(end)
+	b.ops = append(b.ops, batchOp{action: batchActionDelRange, storeKey: storeKey, key: start, end: end})
+	return nil
+}
+
 func (b *Batch) Write() error {
 	_, err := b.tx.Exec(reservedUpsertStmt, reservedStoreKey, keyLatestHeight, b.version, 0, b.version)
 	if err != nil {
@@ -93,6 +111,12 @@ func (b *Batch) Write() error {
 			if err != nil {
 				return fmt.Errorf("failed to exec SQL statement: %w", err)
 			}
+
+		case batchActionDelRange:
+			_, err := b.tx.Exec(delRangeStmt, b.version, op.storeKey, op.key, op.end, b.version)
+			if err != nil {
+				return fmt.Errorf("failed to exec SQL statement: %w", err)
+			}
 		}
 	}
 
@@ -17,6 +17,10 @@ import (
 )
 
 const (
+	// PruneChunkSize defines the number of rows deleted in a single transaction
+	// when pruning is throttled.
+	PruneChunkSize = 1000
+
 	driverName       = "sqlite3"
 	dbName           = "file:ss.db?cache=shared&mode=rwc&_journal_mode=WAL"
 	reservedStoreKey = "_RESERVED_"
@@ -41,9 +45,25 @@ const (
 		SELECT id FROM state_storage WHERE store_key = ? AND key = ? AND version <= ? ORDER BY version DESC LIMIT 1
 	) AND tombstone = 0;
 	`
+	// delRangeStmt tombstones the latest row of every key of the range, relying
+	// on SQLite returning the row of the maximum version along with MAX()
+	delRangeStmt = `
+	UPDATE state_storage SET tombstone = ?
+	WHERE id IN (
+		SELECT id FROM (
+			SELECT id, tombstone, MAX(version) FROM state_storage
+			WHERE store_key = ? AND key >= ? AND key < ? AND version <= ?
+			GROUP BY key
+		) WHERE tombstone = 0
+	);
+	`
 )
 
-var _ storage.Database = (*Database)(nil)
+var (
+	_ storage.Database        = (*Database)(nil)
+	_ storage.HistoryExporter = (*Database)(nil)
+	_ store.LimitedPruner     = (*Database)(nil)
+)
 
 type Database struct {
 	storage *sql.DB
@@ -216,6 +236,87 @@ func (db *Database) Prune(version uint64) error {
 	return nil
 }
 
+// PruneLimited implements store.LimitedPruner. Contrary to Prune, the rows are
+// deleted in chunks of PruneChunkSize rows, each in its own transaction, so that
+// writes are not blocked while waiting. The prune height is only set once all
+// the chunks are deleted.
+func (db *Database) PruneLimited(version uint64, wait func(n int) error) error {
+	if wait == nil {
+		return db.Prune(version)
+	}
+
+	// the rows are visited in id order, resuming after the last deleted row, s.t.
+	// each chunk does not scan the rows already visited by the previous ones
+	pruneStmt := `DELETE FROM state_storage WHERE id IN (
+		SELECT id FROM state_storage s1
+		WHERE s1.id > ? AND s1.version < (
+			SELECT max(version) FROM state_storage t2 WHERE
+			t2.store_key = s1.store_key AND
+			t2.key = s1.key AND
+			t2.version <= ?
+		) AND s1.store_key != ?
+		ORDER BY s1.id
+		LIMIT ?
+	) RETURNING id;
+	`
+
+	var cursor int64
+	for {
+		if err := wait(PruneChunkSize); err != nil {
+			return err
+		}
+
+		n, lastID, err := db.pruneChunk(pruneStmt, cursor, version)
+		if err != nil {
+			return err
+		}
+		if n < PruneChunkSize {
+			break
+		}
+
+		cursor = lastID
+	}
+
+	if err := db.setPruneHeight(version); err != nil {
+		return err
+	}
+
+	db.earliestVersion = version + 1
+
+	return nil
+}
+
+// pruneChunk deletes a chunk of rows after the given cursor and returns the
+// number of deleted rows along with the largest deleted id.
+func (db *Database) pruneChunk(pruneStmt string, cursor int64, version uint64) (n int, lastID int64, err error) {
+	rows, err := db.storage.Query(pruneStmt, cursor, version, reservedStoreKey, PruneChunkSize)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to exec SQL statement: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return 0, 0, fmt.Errorf("failed to scan pruned row: %w", err)
+		}
+
+		n++
+		lastID = max(lastID, id)
+	}
+
+	return n, lastID, rows.Err()
+}
+
+func (db *Database) setPruneHeight(version uint64) error {
+	_, err := db.storage.Exec(reservedUpsertStmt, reservedStoreKey, keyPruneHeight, version, 0, version)
+	if err != nil {
+		return fmt.Errorf("failed to exec SQL statement: %w", err)
+	}
+
+	return nil
+}
+
 func (db *Database) Iterator(storeKey []byte, version uint64, start, end []byte) (corestore.Iterator, error) {
 	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
 		return nil, storeerrors.ErrKeyEmpty
@@ -240,6 +341,145 @@ func (db *Database) ReverseIterator(storeKey []byte, version uint64, start, end
 	return newIterator(db, storeKey, version, start, end, true)
 }
 
+// KeyHistory returns the changes of the given key committed between fromVersion
+// and toVersion, both inclusive. Note, a deletion is stored as the tombstone of
+// the latest row of the key, thus a row may yield a write, a deletion or both.
+// The rows are selected in batches bounded by the limit, keyed by version, until
+// the limit is reached.
+func (db *Database) KeyHistory(storeKey, key []byte, fromVersion, toVersion uint64, limit int) ([]store.KeyChange, uint64, error) {
+	if fromVersion < db.earliestVersion {
+		return nil, 0, storeerrors.ErrVersionPruned{EarliestVersion: db.earliestVersion}
+	}
+
+	stmt, err := db.storage.Prepare(`
+	SELECT value, version, tombstone FROM state_storage
+	WHERE store_key = ? AND key = ? AND version >= ? AND (
+		version BETWEEN ? AND ? OR (tombstone > 0 AND tombstone BETWEEN ? AND ?)
+	)
+	ORDER BY version ASC LIMIT ?;
+	`)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to prepare SQL statement: %w", err)
+	}
+
+	defer stmt.Close()
+
+	// every row yields at least one change of the range, thus a batch of
+	// limit+1 rows fills the page unless changes share a version; a negative
+	// LIMIT selects all the rows
+	batchSize := limit + 1
+	if limit == 0 {
+		batchSize = -1
+	}
+
+	page := storage.KeyHistoryPage{Limit: limit}
+	for cursor := uint64(0); ; {
+		n, last, full, err := db.keyHistoryBatch(stmt, storeKey, key, fromVersion, toVersion, cursor, batchSize, &page)
+		if err != nil {
+			return nil, 0, err
+		}
+		if full || batchSize < 0 || n < batchSize {
+			break
+		}
+
+		cursor = last + 1
+	}
+
+	return page.Changes, page.NextVersion, nil
+}
+
+// keyHistoryBatch adds the changes of the rows of the key with a version >=
+// cursor to the page, returning the number of rows read, the version of the last
+// one and whether the page is full.
+func (db *Database) keyHistoryBatch(
+	stmt *sql.Stmt,
+	storeKey, key []byte,
+	fromVersion, toVersion, cursor uint64,
+	batchSize int,
+	page *storage.KeyHistoryPage,
+) (n int, last uint64, full bool, err error) {
+	rows, err := stmt.Query(storeKey, key, cursor, fromVersion, toVersion, fromVersion, toVersion, batchSize)
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			value              []byte
+			version, tombstone uint64
+		)
+		if err := rows.Scan(&value, &version, &tombstone); err != nil {
+			return 0, 0, false, fmt.Errorf("failed to scan row: %w", err)
+		}
+		n, last = n+1, version
+
+		if version >= fromVersion && version <= toVersion {
+			if !page.Add(store.KeyChange{Version: version, Value: value}) {
+				return n, last, true, nil
+			}
+		}
+		if tombstone >= fromVersion && tombstone <= toVersion && tombstone > 0 {
+			if !page.Add(store.KeyChange{Version: tombstone, Deleted: true}) {
+				return n, last, true, nil
+			}
+		}
+	}
+
+	return n, last, false, rows.Err()
+}
+
+// ExportHistory implements storage.HistoryExporter. Note, a deletion is stored as
+// the tombstone of the latest row of the key, thus it is exported as a separate
+// entry at the version of the tombstone.
+func (db *Database) ExportHistory(storeKey, start []byte, fn func(storage.HistoryEntry) error) error {
+	// NOTE: an empty blob is bound as NULL, thus the lower bound is omitted
+	// altogether when not provided
+	query := "SELECT key, value, version, tombstone FROM state_storage WHERE store_key = ?"
+	args := []any{storeKey}
+	if len(start) > 0 {
+		query += " AND key >= ?"
+		args = append(args, start)
+	}
+
+	stmt, err := db.storage.Prepare(query + " ORDER BY key, version ASC;")
+	if err != nil {
+		return fmt.Errorf("failed to prepare SQL statement: %w", err)
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.Query(args...)
+	if err != nil {
+		return fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			key, value         []byte
+			version, tombstone uint64
+		)
+		if err := rows.Scan(&key, &value, &version, &tombstone); err != nil {
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if err := fn(storage.HistoryEntry{Key: key, Value: value, Version: version}); err != nil {
+			return err
+		}
+
+		if tombstone > 0 {
+			if err := fn(storage.HistoryEntry{Key: key, Version: tombstone, Deleted: true}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return rows.Err()
+}
+
 func (db *Database) PrintRowsDebug() {
 	stmt, err := db.storage.Prepare("SELECT store_key, key, value, version, tombstone FROM state_storage")
 	if err != nil {
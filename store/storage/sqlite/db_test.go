@@ -199,3 +199,42 @@ func TestParallelWriteAndPruning(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []byte(fmt.Sprintf("val-%d-%03d", version-1, 0)), val)
 }
+
+func TestPruneLimited(t *testing.T) {
+	db, err := New(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	// more rows than a chunk are prunable, interleaved with rows which are not
+	numKeys := 2*PruneChunkSize + 500
+	for v := uint64(1); v <= 3; v++ {
+		batch, err := db.NewBatch(v)
+		require.NoError(t, err)
+		for i := 0; i < numKeys; i++ {
+			if v == 3 && i%2 == 1 {
+				continue
+			}
+			require.NoError(t, batch.Set(storeKey1, []byte(fmt.Sprintf("key%05d", i)), []byte(fmt.Sprintf("val%03d", v))))
+		}
+		require.NoError(t, batch.Write())
+	}
+
+	chunks := 0
+	require.NoError(t, db.PruneLimited(2, func(int) error {
+		chunks++
+		return nil
+	}))
+	require.Equal(t, 3, chunks)
+
+	var rows int
+	require.NoError(t, db.storage.QueryRow("SELECT COUNT(*) FROM state_storage WHERE store_key = ?", storeKey1).Scan(&rows))
+	// the version 2 of every key along with the version 3 of half of the keys
+	require.Equal(t, numKeys+numKeys/2, rows)
+
+	// the keys not written at version 3 are still served from version 2
+	for _, i := range []int{1, numKeys - 1} {
+		bz, err := db.Get(storeKey1, 3, []byte(fmt.Sprintf("key%05d", i)))
+		require.NoError(t, err)
+		require.Equal(t, []byte("val002"), bz)
+	}
+}
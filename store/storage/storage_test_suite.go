@@ -10,6 +10,7 @@ import (
 
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
 )
 
 const (
@@ -183,6 +184,153 @@ func (s *StorageTestSuite) TestDatabase_ApplyChangeset() {
 	}
 }
 
+func (s *StorageTestSuite) TestDatabase_ApplyChangesetDeleteRange() {
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	storeKey2Bytes := []byte("store2")
+	cs := corestore.NewChangeset()
+	for i := 0; i < 20; i++ {
+		cs.Add(storeKey1Bytes, []byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("val%03d", i)), false)
+		cs.Add(storeKey2Bytes, []byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("val%03d", i)), false)
+	}
+	s.Require().NoError(db.ApplyChangeset(1, cs))
+
+	// the range deletion applies to the keys set earlier in the changeset, but not
+	// to the keys set after it
+	cs = corestore.NewChangeset()
+	cs.Add(storeKey1Bytes, []byte("key0105"), []byte("val0105"), false)
+	cs.Add(storeKey1Bytes, []byte("key008"), nil, true)
+	cs.AddDeleteRange(storeKey1Bytes, []byte("key005"), []byte("key015"))
+	cs.Add(storeKey1Bytes, []byte("key007"), []byte("updated"), false)
+	s.Require().NoError(db.ApplyChangeset(2, cs))
+
+	cs = corestore.NewChangeset()
+	cs.Add(storeKey1Bytes, []byte("key010"), []byte("recreated"), false)
+	s.Require().NoError(db.ApplyChangeset(3, cs))
+
+	keys := func(storeKey []byte, version uint64) []string {
+		itr, err := db.Iterator(storeKey, version, nil, nil)
+		s.Require().NoError(err)
+		defer itr.Close()
+
+		var res []string
+		for ; itr.Valid(); itr.Next() {
+			res = append(res, fmt.Sprintf("%s=%s", itr.Key(), itr.Value()))
+		}
+		return res
+	}
+
+	var all, remaining []string
+	for i := 0; i < 20; i++ {
+		kv := fmt.Sprintf("key%03d=val%03d", i, i)
+		all = append(all, kv)
+		if i < 5 || i >= 15 {
+			remaining = append(remaining, kv)
+		} else if i == 7 {
+			remaining = append(remaining, "key007=updated")
+		}
+	}
+
+	// the history of the removed keys is retained
+	s.Require().Equal(all, keys(storeKey1Bytes, 1))
+	s.Require().Equal(remaining, keys(storeKey1Bytes, 2))
+	s.Require().Equal(all, keys(storeKey2Bytes, 2))
+
+	bz, err := db.Get(storeKey1Bytes, 2, []byte("key010"))
+	s.Require().NoError(err)
+	s.Require().Nil(bz)
+	bz, err = db.Get(storeKey1Bytes, 3, []byte("key010"))
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("recreated"), bz)
+	ok, err := db.Has(storeKey1Bytes, 2, []byte("key0105"))
+	s.Require().NoError(err)
+	s.Require().False(ok)
+}
+
+func (s *StorageTestSuite) TestDatabase_KeyHistory() {
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	key := []byte("key")
+	changesets := []func(cs *corestore.Changeset){
+		func(cs *corestore.Changeset) { cs.Add(storeKey1Bytes, key, []byte("value1"), false) },
+		// keys prefixed by the key and other store keys are not part of its history
+		func(cs *corestore.Changeset) {
+			cs.Add(storeKey1Bytes, []byte("key1"), []byte("other"), false)
+			cs.Add([]byte("store2"), key, []byte("other"), false)
+		},
+		func(cs *corestore.Changeset) { cs.Add(storeKey1Bytes, key, []byte("value3"), false) },
+		func(cs *corestore.Changeset) { cs.Add(storeKey1Bytes, key, nil, true) },
+		func(cs *corestore.Changeset) { cs.Add(storeKey1Bytes, key, []byte("value5"), false) },
+		func(cs *corestore.Changeset) { cs.AddDeleteRange(storeKey1Bytes, []byte("k"), []byte("l")) },
+	}
+	for i, fn := range changesets {
+		cs := corestore.NewChangeset()
+		fn(cs)
+		s.Require().NoError(db.ApplyChangeset(uint64(i+1), cs))
+	}
+
+	history, next, err := db.KeyHistory(storeKey1Bytes, key, 1, 6, 0)
+	s.Require().NoError(err)
+	s.Require().Zero(next)
+	s.Require().Equal([]store.KeyChange{
+		{Version: 1, Value: []byte("value1")},
+		{Version: 3, Value: []byte("value3")},
+		{Version: 4, Deleted: true},
+		{Version: 5, Value: []byte("value5")},
+		{Version: 6, Deleted: true},
+	}, history)
+
+	history, next, err = db.KeyHistory(storeKey1Bytes, key, 2, 4, 0)
+	s.Require().NoError(err)
+	s.Require().Zero(next)
+	s.Require().Equal([]store.KeyChange{
+		{Version: 3, Value: []byte("value3")},
+		{Version: 4, Deleted: true},
+	}, history)
+
+	history, _, err = db.KeyHistory(storeKey1Bytes, []byte("missing"), 1, 6, 2)
+	s.Require().NoError(err)
+	s.Require().Empty(history)
+
+	_, _, err = db.KeyHistory(storeKey1Bytes, key, 4, 3, 0)
+	s.Require().Error(err)
+
+	// the history is paginated by the limit, the next version being the version
+	// of the first change not returned
+	var paged []store.KeyChange
+	for from := uint64(1); from != 0; {
+		history, next, err = db.KeyHistory(storeKey1Bytes, key, from, 6, 2)
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(history), 2)
+		paged = append(paged, history...)
+		from = next
+	}
+	s.Require().Equal([]store.KeyChange{
+		{Version: 1, Value: []byte("value1")},
+		{Version: 3, Value: []byte("value3")},
+		{Version: 4, Deleted: true},
+		{Version: 5, Value: []byte("value5")},
+		{Version: 6, Deleted: true},
+	}, paged)
+
+	history, next, err = db.KeyHistory(storeKey1Bytes, key, 1, 6, 3)
+	s.Require().NoError(err)
+	s.Require().Len(history, 3)
+	s.Require().Equal(uint64(5), next)
+
+	// the history of pruned versions is not available
+	s.Require().NoError(db.Prune(2))
+	_, _, err = db.KeyHistory(storeKey1Bytes, key, 2, 6, 0)
+	s.Require().ErrorAs(err, &storeerrors.ErrVersionPruned{})
+	history, _, err = db.KeyHistory(storeKey1Bytes, key, 3, 5, 0)
+	s.Require().NoError(err)
+	s.Require().Len(history, 3)
+}
+
 func (s *StorageTestSuite) TestDatabase_IteratorEmptyDomain() {
 	db, err := s.NewDB(s.T().TempDir())
 	s.Require().NoError(err)
@@ -6,6 +6,7 @@ import (
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
 	"cosmossdk.io/store/v2/snapshots"
 )
 
@@ -17,6 +18,7 @@ const (
 var (
 	_ store.VersionedDatabase      = (*StorageStore)(nil)
 	_ snapshots.StorageSnapshotter = (*StorageStore)(nil)
+	_ store.LimitedPruner          = (*StorageStore)(nil)
 )
 
 // StorageStore is a wrapper around the store.VersionedDatabase interface.
@@ -60,7 +62,11 @@ func (ss *StorageStore) ApplyChangeset(version uint64, cs *corestore.Changeset)
 
 	for _, pairs := range cs.Changes {
 		for _, kvPair := range pairs.StateChanges {
-			if kvPair.Remove {
+			if kvPair.IsRangeDelete() {
+				if err := b.DeleteRange(pairs.Actor, kvPair.Key, kvPair.RangeEnd); err != nil {
+					return err
+				}
+			} else if kvPair.Remove {
 				if err := b.Delete(pairs.Actor, kvPair.Key); err != nil {
 					return err
 				}
@@ -105,11 +111,38 @@ func (ss *StorageStore) ReverseIterator(storeKey []byte, version uint64, start,
 	return ss.db.ReverseIterator(storeKey, version, start, end)
 }
 
+// KeyHistory returns at most limit changes of the given key committed between
+// fromVersion and toVersion, both inclusive, in ascending order of version, and
+// the version of the next change if any.
+func (ss *StorageStore) KeyHistory(storeKey, key []byte, fromVersion, toVersion uint64, limit int) ([]store.KeyChange, uint64, error) {
+	if len(key) == 0 {
+		return nil, 0, storeerrors.ErrKeyEmpty
+	}
+	if fromVersion > toVersion {
+		return nil, 0, fmt.Errorf("from version %d is greater than to version %d", fromVersion, toVersion)
+	}
+	if limit < 0 {
+		return nil, 0, fmt.Errorf("negative limit %d", limit)
+	}
+
+	return ss.db.KeyHistory(storeKey, key, fromVersion, toVersion, limit)
+}
+
 // Prune prunes the store up to the given version.
 func (ss *StorageStore) Prune(version uint64) error {
 	return ss.db.Prune(version)
 }
 
+// PruneLimited implements store.LimitedPruner. If the underlying database does
+// not support throttling, it is pruned regardless of wait.
+func (ss *StorageStore) PruneLimited(version uint64, wait func(n int) error) error {
+	if pruner, ok := ss.db.(store.LimitedPruner); ok {
+		return pruner.PruneLimited(version, wait)
+	}
+
+	return ss.db.Prune(version)
+}
+
 // Restore restores the store from the given channel.
 func (ss *StorageStore) Restore(version uint64, chStorage <-chan *corestore.StateChanges) error {
 	latestVersion, err := ss.db.GetLatestVersion()
@@ -0,0 +1,89 @@
+package streaming
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"sync"
+
+	protoio "github.com/cosmos/gogoproto/io"
+)
+
+// maxEventSize is the maximum size of an encoded ChangesetEvent read by
+// ReadFile.
+const maxEventSize = 1 << 30
+
+var _ Sink = (*FileSink)(nil)
+
+// FileSink is a Sink appending the changesets to a file, each encoded as a
+// length-prefixed protobuf ChangesetEvent, i.e. the uvarint size of the message
+// followed by the message. The file is synced after every changeset, and may
+// contain the same version more than once after a restart.
+type FileSink struct {
+	mtx    sync.Mutex
+	file   *os.File
+	writer protoio.Writer
+}
+
+// NewFileSink returns a FileSink appending to the file at the given path, which
+// is created if it does not exist.
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileSink{
+		file:   file,
+		writer: protoio.NewDelimitedWriter(file),
+	}, nil
+}
+
+// ListenChangeset implements the Sink interface.
+func (f *FileSink) ListenChangeset(_ context.Context, event *ChangesetEvent) error {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if err := f.writer.WriteMsg(event); err != nil {
+		return err
+	}
+
+	return f.file.Sync()
+}
+
+// Close closes the underlying file.
+func (f *FileSink) Close() error {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	return f.file.Close()
+}
+
+// ReadFile reads the changesets written by a FileSink to the file at the given
+// path, calling fn for each of them in order. A truncated trailing changeset,
+// e.g. written partially before a crash, is ignored.
+func ReadFile(path string, fn func(event *ChangesetEvent) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := protoio.NewDelimitedReader(bufio.NewReader(file), maxEventSize)
+	for {
+		event := &ChangesetEvent{}
+		err := reader.ReadMsg(event)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package streaming
+
+import (
+	"context"
+
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+)
+
+var (
+	_ Sink                = (*GRPCSink)(nil)
+	_ ChangesetSinkServer = (*grpcServer)(nil)
+)
+
+// GRPCSink is a Sink delivering the changesets to a plugin implementing the
+// ChangesetSink gRPC service, e.g. an indexer running in a separate process.
+type GRPCSink struct {
+	client ChangesetSinkClient
+}
+
+// NewGRPCSink returns a GRPCSink delivering the changesets over the given
+// connection.
+func NewGRPCSink(conn grpc1.ClientConn) *GRPCSink {
+	return &GRPCSink{client: NewChangesetSinkClient(conn)}
+}
+
+// ListenChangeset implements the Sink interface.
+func (g *GRPCSink) ListenChangeset(ctx context.Context, event *ChangesetEvent) error {
+	_, err := g.client.ListenChangeset(ctx, &ListenChangesetRequest{Changeset: event})
+	return err
+}
+
+// NewGRPCServer returns a ChangesetSinkServer serving the given Sink. It allows
+// plugins to expose a Sink implementation over gRPC to a GRPCSink.
+func NewGRPCServer(sink Sink) ChangesetSinkServer {
+	return &grpcServer{sink: sink}
+}
+
+type grpcServer struct {
+	sink Sink
+}
+
+func (s *grpcServer) ListenChangeset(ctx context.Context, req *ListenChangesetRequest) (*ListenChangesetResponse, error) {
+	if err := s.sink.ListenChangeset(ctx, req.Changeset); err != nil {
+		return nil, err
+	}
+
+	return &ListenChangesetResponse{}, nil
+}
@@ -0,0 +1,445 @@
+package streaming
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/metrics"
+	"cosmossdk.io/store/v2/storage/util"
+)
+
+const (
+	eventKeyPrefix  = "e/" // e/<version>
+	cursorKeyPrefix = "c/" // c/<sink>
+	publishedKey    = "p"
+
+	// DefaultMaxPending is the default maximum number of versions published but
+	// not yet delivered to every sink.
+	DefaultMaxPending = 100
+	// DefaultRetryInterval is the default delay before retrying a failed delivery.
+	DefaultRetryInterval = 100 * time.Millisecond
+	// DefaultMaxRetryInterval is the default maximum delay between retries.
+	DefaultMaxRetryInterval = 30 * time.Second
+)
+
+// ErrClosed is returned when publishing to a closed Manager.
+var ErrClosed = errors.New("streaming manager is closed")
+
+// Options defines the options of a Manager.
+type Options struct {
+	// MaxPending is the maximum number of versions published but not yet
+	// delivered to every sink, Publish blocks once it is reached. Zero uses
+	// DefaultMaxPending.
+	MaxPending uint64
+	// RetryInterval is the delay before retrying a failed delivery, it doubles
+	// after every failure up to MaxRetryInterval. Zero uses the defaults.
+	RetryInterval    time.Duration
+	MaxRetryInterval time.Duration
+}
+
+// Manager delivers the changesets committed to the root store to a set of named
+// sinks, each of them served by a dedicated goroutine.
+//
+// Changesets are persisted in a write-ahead log before being committed and the
+// version delivered to every sink is persisted once the sink acknowledges it,
+// thus the changesets not delivered before a restart are delivered again on
+// Start, which provides at-least-once delivery. A changeset is removed from the
+// log once delivered to every sink. A slow or failing sink exerts backpressure on
+// the commit path once MaxPending versions are pending. Failed deliveries, as well
+// as failures to read the write-ahead log or persist a cursor, are retried with an
+// exponential backoff, so that a sink never stops delivering while open.
+type Manager struct {
+	logger    log.Logger
+	telemetry metrics.StoreMetrics
+	db        store.RawDB
+	opts      Options
+
+	names []string
+	sinks map[string]Sink
+
+	mtx  sync.Mutex
+	cond *sync.Cond
+	// published is the latest version published to the sinks
+	published uint64
+	// cursors are the latest versions delivered to each sink
+	cursors map[string]uint64
+	closed  bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewManager returns a new Manager delivering changesets to the given named
+// sinks. The write-ahead log and the delivery cursors are stored in the given
+// database. The Manager must be started with Start and closed with Close.
+func NewManager(db store.RawDB, sinks map[string]Sink, opts Options, logger log.Logger, telemetry metrics.StoreMetrics) *Manager {
+	if opts.MaxPending == 0 {
+		opts.MaxPending = DefaultMaxPending
+	}
+	if opts.RetryInterval == 0 {
+		opts.RetryInterval = DefaultRetryInterval
+	}
+	if opts.MaxRetryInterval == 0 {
+		opts.MaxRetryInterval = DefaultMaxRetryInterval
+	}
+
+	names := make([]string, 0, len(sinks))
+	for name := range sinks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	m := &Manager{
+		logger:    logger.With("module", "streaming"),
+		telemetry: telemetry,
+		db:        db,
+		opts:      opts,
+		names:     names,
+		sinks:     sinks,
+		cursors:   make(map[string]uint64, len(sinks)),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+	m.cond = sync.NewCond(&m.mtx)
+
+	return m
+}
+
+// Start recovers the state of the previous run and starts delivering the
+// changesets. The given version is the latest version committed to the root
+// store, the prepared changesets up to and including it are published, the
+// others are discarded as their commit did not complete. Sinks without a cursor,
+// i.e. newly registered ones, receive the changesets committed after Start.
+func (m *Manager) Start(committedVersion uint64) error {
+	published, err := m.getUint64([]byte(publishedKey))
+	if err != nil {
+		return err
+	}
+	if published > committedVersion {
+		return fmt.Errorf("published version %d is greater than the committed version %d", published, committedVersion)
+	}
+
+	batch := m.db.NewBatch()
+	defer batch.Close()
+
+	// the changesets prepared up to the committed version are published, i.e.
+	// the previous run stopped between the commit and Publish
+	if err := batch.Set([]byte(publishedKey), encodeUint64(committedVersion)); err != nil {
+		return err
+	}
+
+	for _, name := range m.names {
+		found, err := m.db.Has(cursorKey(name))
+		if err != nil {
+			return err
+		}
+		cursor, err := m.getUint64(cursorKey(name))
+		if err != nil {
+			return err
+		}
+		if !found || cursor > committedVersion {
+			cursor = committedVersion
+			if err := batch.Set(cursorKey(name), encodeUint64(cursor)); err != nil {
+				return err
+			}
+		}
+		m.cursors[name] = cursor
+	}
+	m.published = committedVersion
+
+	// discard the changesets delivered to every sink, e.g. left behind by a
+	// removed sink, and the ones prepared but never committed
+	if err := m.deleteEvents(batch, 0, m.minCursor()); err != nil {
+		return err
+	}
+	if err := m.deleteEvents(batch, committedVersion+1, math.MaxUint64); err != nil {
+		return err
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	for _, name := range m.names {
+		m.wg.Add(1)
+		go m.run(name, m.sinks[name])
+	}
+
+	return nil
+}
+
+// deleteEvents deletes the changesets of the versions in [start, end] from the
+// write-ahead log.
+func (m *Manager) deleteEvents(batch store.RawBatch, start, end uint64) error {
+	if start > end {
+		return nil
+	}
+
+	itr, err := m.db.Iterator(eventKey(start), util.CopyIncr(eventKey(end)))
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		if err := batch.Delete(slices.Clone(itr.Key())); err != nil {
+			return err
+		}
+	}
+
+	return itr.Error()
+}
+
+// Prepare adds the given changeset to the write-ahead log. It must be called
+// before committing the changeset, and followed by Publish once committed.
+func (m *Manager) Prepare(event *ChangesetEvent) error {
+	bz, err := event.Marshal()
+	if err != nil {
+		return err
+	}
+
+	batch := m.db.NewBatch()
+	defer batch.Close()
+
+	if err := batch.Set(eventKey(event.Version), bz); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// Publish makes the prepared changeset of the given version available to the
+// sinks. It blocks while MaxPending versions are pending delivery to any sink.
+func (m *Manager) Publish(version uint64) error {
+	batch := m.db.NewBatch()
+	defer batch.Close()
+
+	if err := batch.Set([]byte(publishedKey), encodeUint64(version)); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.closed {
+		return ErrClosed
+	}
+
+	m.published = version
+	m.cond.Broadcast()
+
+	for !m.closed && m.published-m.minCursor() > m.opts.MaxPending {
+		m.cond.Wait()
+	}
+
+	return nil
+}
+
+// DeliveredVersion returns the latest version delivered to the given sink.
+func (m *Manager) DeliveredVersion(name string) uint64 {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.cursors[name]
+}
+
+// Close stops delivering changesets, canceling the deliveries in progress. The
+// changesets not delivered yet are delivered on the next Start.
+func (m *Manager) Close() error {
+	m.mtx.Lock()
+	if m.closed {
+		m.mtx.Unlock()
+		return nil
+	}
+	m.closed = true
+	m.cond.Broadcast()
+	m.mtx.Unlock()
+
+	m.cancel()
+	m.wg.Wait()
+
+	return nil
+}
+
+// run delivers the published changesets to the given sink in order of version.
+func (m *Manager) run(name string, sink Sink) {
+	defer m.wg.Done()
+
+	for {
+		m.mtx.Lock()
+		for !m.closed && m.cursors[name] >= m.published {
+			m.cond.Wait()
+		}
+		if m.closed {
+			m.mtx.Unlock()
+			return
+		}
+		version := m.cursors[name] + 1
+		m.mtx.Unlock()
+
+		// a sink which stops on an error would never advance its cursor, and block
+		// the commit path once MaxPending versions are pending, thus every step is
+		// retried until it succeeds or the Manager is closed
+		var event *ChangesetEvent
+		err := m.retry(name, version, "failed to load changeset", func() (err error) {
+			event, err = m.getEvent(version)
+			return err
+		})
+		if err != nil {
+			return
+		}
+
+		// versions committed without a prepared changeset, e.g. before the sink
+		// was registered, are skipped
+		if event != nil {
+			err := m.retry(name, version, "failed to deliver changeset", func() error {
+				return sink.ListenChangeset(m.ctx, event)
+			})
+			if err != nil {
+				return
+			}
+		}
+
+		err = m.retry(name, version, "failed to acknowledge changeset", func() error {
+			return m.ack(name, version)
+		})
+		if err != nil {
+			return
+		}
+	}
+}
+
+// retry calls fn, retrying with an exponential backoff until it succeeds or the
+// Manager is closed. Failures are logged with the given message.
+func (m *Manager) retry(name string, version uint64, msg string, fn func() error) error {
+	backoff := m.opts.RetryInterval
+	for {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+		if m.ctx.Err() != nil {
+			return m.ctx.Err()
+		}
+
+		m.logger.Error(msg, "sink", name, "version", version, "retry_in", backoff, "err", err)
+		if m.telemetry != nil {
+			m.telemetry.IncrCounter(1, "streaming", name, "errors")
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-m.ctx.Done():
+			timer.Stop()
+			return m.ctx.Err()
+		}
+
+		backoff = min(2*backoff, m.opts.MaxRetryInterval)
+	}
+}
+
+// ack persists the cursor of the given sink and removes the changesets
+// delivered to every sink from the write-ahead log. The cursor is only advanced
+// once persisted, s.t. a failed ack can be retried.
+func (m *Manager) ack(name string, version uint64) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	prevMin, prevCursor := m.minCursor(), m.cursors[name]
+	m.cursors[name] = version
+	newMin := m.minCursor()
+	m.cursors[name] = prevCursor
+
+	batch := m.db.NewBatch()
+	defer batch.Close()
+
+	if err := batch.Set(cursorKey(name), encodeUint64(version)); err != nil {
+		return err
+	}
+	for v := newMin; v > prevMin; v-- {
+		if err := batch.Delete(eventKey(v)); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	m.cursors[name] = version
+
+	m.cond.Broadcast()
+
+	if m.telemetry != nil {
+		m.telemetry.SetGauge(float32(version), "streaming", name, "delivered_version")
+	}
+
+	return nil
+}
+
+// minCursor returns the latest version delivered to every sink. The caller must
+// hold the lock.
+func (m *Manager) minCursor() uint64 {
+	if len(m.names) == 0 {
+		return m.published
+	}
+
+	cursor := m.cursors[m.names[0]]
+	for _, name := range m.names[1:] {
+		cursor = min(cursor, m.cursors[name])
+	}
+
+	return cursor
+}
+
+func (m *Manager) getEvent(version uint64) (*ChangesetEvent, error) {
+	bz, err := m.db.Get(eventKey(version))
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	event := &ChangesetEvent{}
+	if err := event.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (m *Manager) getUint64(key []byte) (uint64, error) {
+	bz, err := m.db.Get(key)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid value of key %s", key)
+	}
+
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+func eventKey(version uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte(eventKeyPrefix), version)
+}
+
+func cursorKey(name string) []byte {
+	return []byte(cursorKeyPrefix + name)
+}
+
+func encodeUint64(v uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, v)
+}
@@ -0,0 +1,257 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	coreheader "cosmossdk.io/core/header"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	dbm "cosmossdk.io/store/v2/db"
+)
+
+var testOpts = Options{RetryInterval: time.Millisecond, MaxRetryInterval: 10 * time.Millisecond}
+
+// mockSink records the delivered versions, failing the first failures
+// deliveries and blocking while block is set.
+type mockSink struct {
+	mtx      sync.Mutex
+	failures int
+	block    chan struct{}
+	events   []*ChangesetEvent
+}
+
+func (s *mockSink) ListenChangeset(ctx context.Context, event *ChangesetEvent) error {
+	if s.block != nil {
+		select {
+		case <-s.block:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("failed to deliver")
+	}
+	s.events = append(s.events, event)
+
+	return nil
+}
+
+func (s *mockSink) versions() []uint64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	versions := make([]uint64, len(s.events))
+	for i, event := range s.events {
+		versions[i] = event.Version
+	}
+
+	return versions
+}
+
+// faultyDB fails the first getFailures reads of changesets and the first
+// writeFailures non-synced batch writes, i.e. acknowledgements.
+type faultyDB struct {
+	store.RawDB
+
+	getFailures   atomic.Int32
+	writeFailures atomic.Int32
+}
+
+func (db *faultyDB) Get(key []byte) ([]byte, error) {
+	if strings.HasPrefix(string(key), eventKeyPrefix) && db.getFailures.Add(-1) >= 0 {
+		return nil, errors.New("failed to read")
+	}
+
+	return db.RawDB.Get(key)
+}
+
+func (db *faultyDB) NewBatch() store.RawBatch {
+	return faultyBatch{RawBatch: db.RawDB.NewBatch(), db: db}
+}
+
+type faultyBatch struct {
+	store.RawBatch
+
+	db *faultyDB
+}
+
+func (b faultyBatch) Write() error {
+	if b.db.writeFailures.Add(-1) >= 0 {
+		return errors.New("failed to write")
+	}
+
+	return b.RawBatch.Write()
+}
+
+func testEvent(version uint64) *ChangesetEvent {
+	cs := corestore.NewChangeset()
+	cs.Add([]byte("store1"), []byte(fmt.Sprintf("key%d", version)), []byte("value"), false)
+	cs.Add([]byte("store2"), []byte("removed"), nil, true)
+
+	return NewChangesetEvent(version, &coreheader.Info{Height: int64(version), ChainID: "test"}, cs)
+}
+
+func publish(t *testing.T, m *Manager, start, end uint64) {
+	t.Helper()
+
+	for v := start; v <= end; v++ {
+		require.NoError(t, m.Prepare(testEvent(v)))
+		require.NoError(t, m.Publish(v))
+	}
+}
+
+func requireDelivered(t *testing.T, m *Manager, name string, version uint64) {
+	t.Helper()
+
+	require.Eventually(t, func() bool { return m.DeliveredVersion(name) == version }, 5*time.Second, time.Millisecond)
+}
+
+func TestNewChangesetEvent(t *testing.T) {
+	event := testEvent(3)
+	require.Equal(t, uint64(3), event.Version)
+	require.Equal(t, int64(3), event.Header.Height)
+	require.Equal(t, "test", event.Header.ChainId)
+	require.Equal(t, []StoreChanges{
+		{StoreKey: []byte("store1"), Pairs: []KVPair{{Key: []byte("key3"), Value: []byte("value")}}},
+		{StoreKey: []byte("store2"), Pairs: []KVPair{{Key: []byte("removed"), Remove: true}}},
+	}, event.Changes)
+
+	require.Nil(t, NewChangesetEvent(1, nil, nil).Header)
+}
+
+func TestManagerDelivery(t *testing.T) {
+	sink1, sink2 := &mockSink{}, &mockSink{failures: 3}
+	m := NewManager(dbm.NewMemDB(), map[string]Sink{"sink1": sink1, "sink2": sink2}, testOpts, log.NewNopLogger(), nil)
+	require.NoError(t, m.Start(0))
+	defer m.Close()
+
+	publish(t, m, 1, 10)
+	requireDelivered(t, m, "sink1", 10)
+	requireDelivered(t, m, "sink2", 10)
+
+	expected := []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	require.Equal(t, expected, sink1.versions())
+	require.Equal(t, expected, sink2.versions())
+	require.Equal(t, testEvent(5), sink1.events[4])
+
+	// the changesets delivered to every sink are removed
+	for v := uint64(1); v <= 10; v++ {
+		event, err := m.getEvent(v)
+		require.NoError(t, err)
+		require.Nil(t, event)
+	}
+}
+
+func TestManagerBackpressure(t *testing.T) {
+	sink := &mockSink{block: make(chan struct{})}
+	opts := testOpts
+	opts.MaxPending = 3
+	m := NewManager(dbm.NewMemDB(), map[string]Sink{"sink": sink}, opts, log.NewNopLogger(), nil)
+	require.NoError(t, m.Start(0))
+	defer m.Close()
+
+	publish(t, m, 1, 3)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		publish(t, m, 4, 4)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("publish did not block")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(sink.block)
+	<-done
+	requireDelivered(t, m, "sink", 4)
+}
+
+func TestManagerDBError(t *testing.T) {
+	db := &faultyDB{RawDB: dbm.NewMemDB()}
+	sink := &mockSink{}
+	opts := testOpts
+	opts.MaxPending = 2
+	m := NewManager(db, map[string]Sink{"sink": sink}, opts, log.NewNopLogger(), nil)
+	require.NoError(t, m.Start(0))
+	defer m.Close()
+
+	// the sink keeps delivering after failing to load and acknowledge changesets,
+	// thus publishing does not block forever
+	db.getFailures.Store(3)
+	db.writeFailures.Store(3)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		publish(t, m, 1, 5)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("publish blocked")
+	}
+	requireDelivered(t, m, "sink", 5)
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, sink.versions())
+}
+
+func TestManagerRestart(t *testing.T) {
+	db := dbm.NewMemDB()
+	sink := &mockSink{block: make(chan struct{})}
+	m := NewManager(db, map[string]Sink{"sink": sink}, testOpts, log.NewNopLogger(), nil)
+	require.NoError(t, m.Start(0))
+
+	publish(t, m, 1, 5)
+	// the changeset of version 6 is committed but not published, and the one of
+	// version 7 is not committed
+	require.NoError(t, m.Prepare(testEvent(6)))
+	require.NoError(t, m.Prepare(testEvent(7)))
+	require.NoError(t, m.Close())
+	require.Empty(t, sink.versions())
+
+	// the pending changesets are delivered again on restart, and a new sink
+	// receives the changesets committed after the restart
+	sink, newSink := &mockSink{}, &mockSink{}
+	m = NewManager(db, map[string]Sink{"sink": sink, "new": newSink}, testOpts, log.NewNopLogger(), nil)
+	require.NoError(t, m.Start(6))
+	defer m.Close()
+
+	requireDelivered(t, m, "sink", 6)
+	require.Equal(t, []uint64{1, 2, 3, 4, 5, 6}, sink.versions())
+	require.Equal(t, uint64(6), m.DeliveredVersion("new"))
+
+	publish(t, m, 7, 8)
+	requireDelivered(t, m, "sink", 8)
+	requireDelivered(t, m, "new", 8)
+	require.Equal(t, []uint64{7, 8}, newSink.versions())
+	require.Equal(t, testEvent(7), newSink.events[0])
+}
+
+func TestManagerClose(t *testing.T) {
+	sink := &mockSink{failures: 1000}
+	m := NewManager(dbm.NewMemDB(), map[string]Sink{"sink": sink}, Options{RetryInterval: time.Hour}, log.NewNopLogger(), nil)
+	require.NoError(t, m.Start(0))
+
+	publish(t, m, 1, 1)
+	require.NoError(t, m.Close())
+	require.Zero(t, m.DeliveredVersion("sink"))
+	require.ErrorIs(t, m.Publish(2), ErrClosed)
+}
@@ -0,0 +1,50 @@
+package streaming
+
+import (
+	"context"
+
+	coreheader "cosmossdk.io/core/header"
+	corestore "cosmossdk.io/core/store"
+)
+
+// Sink defines a consumer of the changesets committed to the root store, e.g.
+// an external indexer.
+//
+// Changesets are delivered in order of version and at least once, i.e. a
+// changeset may be delivered again after a failed delivery or a restart, thus
+// implementations must handle changesets idempotently. A Sink returning an error
+// receives the same changeset again, after a backoff, until it succeeds.
+type Sink interface {
+	ListenChangeset(ctx context.Context, event *ChangesetEvent) error
+}
+
+// NewChangesetEvent returns the ChangesetEvent of the given changeset committed
+// at the given version. The header is optional.
+func NewChangesetEvent(version uint64, header *coreheader.Info, cs *corestore.Changeset) *ChangesetEvent {
+	event := &ChangesetEvent{Version: version}
+	if header != nil {
+		event.Header = &Header{
+			Height:  header.Height,
+			Hash:    header.Hash,
+			Time:    header.Time,
+			ChainId: header.ChainID,
+			AppHash: header.AppHash,
+		}
+	}
+
+	if cs == nil {
+		return event
+	}
+
+	event.Changes = make([]StoreChanges, 0, len(cs.Changes))
+	for _, changes := range cs.Changes {
+		pairs := make([]KVPair, 0, len(changes.StateChanges))
+		for _, kv := range changes.StateChanges {
+			pairs = append(pairs, KVPair{Key: kv.Key, Value: kv.Value, Remove: kv.Remove, RangeEnd: kv.RangeEnd})
+		}
+
+		event.Changes = append(event.Changes, StoreChanges{StoreKey: changes.Actor, Pairs: pairs})
+	}
+
+	return event
+}
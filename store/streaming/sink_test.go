@@ -0,0 +1,73 @@
+package streaming
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func readFile(t *testing.T, path string) []*ChangesetEvent {
+	t.Helper()
+
+	var events []*ChangesetEvent
+	require.NoError(t, ReadFile(path, func(event *ChangesetEvent) error {
+		events = append(events, event)
+		return nil
+	}))
+
+	return events
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "changesets.pb")
+
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.ListenChangeset(context.Background(), testEvent(1)))
+	require.NoError(t, sink.ListenChangeset(context.Background(), testEvent(2)))
+	require.NoError(t, sink.Close())
+
+	// reopening the file appends to it
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.ListenChangeset(context.Background(), testEvent(3)))
+	require.NoError(t, sink.Close())
+
+	events := readFile(t, path)
+	require.Equal(t, []*ChangesetEvent{testEvent(1), testEvent(2), testEvent(3)}, events)
+
+	// a truncated trailing changeset is ignored
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-3))
+	require.Len(t, readFile(t, path), 2)
+}
+
+func TestGRPCSink(t *testing.T) {
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	receiver := &mockSink{failures: 1}
+	RegisterChangesetSinkServer(server, NewGRPCServer(receiver))
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	sink := NewGRPCSink(conn)
+	require.Error(t, sink.ListenChangeset(context.Background(), testEvent(1)))
+	require.NoError(t, sink.ListenChangeset(context.Background(), testEvent(1)))
+	require.Equal(t, []uint64{1}, receiver.versions())
+	require.Equal(t, testEvent(1), receiver.events[0])
+}
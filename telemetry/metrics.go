@@ -84,6 +84,46 @@ type Config struct {
 	// DatadogHostname defines the hostname to use when emitting metrics to
 	// Datadog. Only utilized if MetricsSink is set to "dogstatsd".
 	DatadogHostname string `mapstructure:"datadog-hostname"`
+
+	// OTLPMetricsEndpoint, when set, enables an OpenTelemetry metrics sink
+	// exporting the metrics to this OTLP gRPC endpoint. It is used in addition
+	// to the metrics sink and the Prometheus sink, if enabled.
+	OTLPMetricsEndpoint string `mapstructure:"otlp-metrics-endpoint"`
+
+	// OTLPMetricsInsecure disables the transport security of the OTLP gRPC
+	// connection of the OpenTelemetry metrics sink.
+	OTLPMetricsInsecure bool `mapstructure:"otlp-metrics-insecure"`
+
+	// OTLPMetricsInterval defines the interval in seconds of the exports of the
+	// OpenTelemetry metrics sink. Defaults to 10 seconds.
+	OTLPMetricsInterval int64 `mapstructure:"otlp-metrics-interval"`
+
+	// HistogramBuckets defines the bucket boundaries of the histograms of the
+	// OpenTelemetry metrics sink, e.g. of the durations measured with
+	// MeasureSince in milliseconds. The default boundaries of OpenTelemetry are
+	// used if empty.
+	HistogramBuckets []float64 `mapstructure:"histogram-buckets"`
+
+	// TraceExporter defines the OpenTelemetry exporter of the traces of the
+	// application. Tracing is disabled when empty.
+	// Supported exporters are "otlp" and "file".
+	TraceExporter string `mapstructure:"trace-exporter"`
+
+	// TraceEndpoint defines the OTLP gRPC endpoint to export traces to.
+	// Only utilized if TraceExporter is set to "otlp".
+	TraceEndpoint string `mapstructure:"trace-endpoint"`
+
+	// TraceInsecure disables the transport security of the OTLP gRPC connection.
+	// Only utilized if TraceExporter is set to "otlp".
+	TraceInsecure bool `mapstructure:"trace-insecure"`
+
+	// TraceFile defines the file the traces are written to, as JSON.
+	// Only utilized if TraceExporter is set to "file".
+	TraceFile string `mapstructure:"trace-file"`
+
+	// TraceSampleRatio defines the ratio of the traces that are sampled, between
+	// 0 and 1.
+	TraceSampleRatio float64 `mapstructure:"trace-sample-ratio"`
 }
 
 // Metrics defines a wrapper around application telemetry functionality. It allows
@@ -162,6 +202,15 @@ func New(cfg Config) (_ *Metrics, rerr error) {
 		fanout = append(fanout, promSink)
 	}
 
+	if cfg.OTLPMetricsEndpoint != "" {
+		otelSink, err := NewOTLPMetricSink(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create otlp metrics sink: %w", err)
+		}
+
+		fanout = append(fanout, otelSink)
+	}
+
 	if _, err := metrics.NewGlobal(metricsConf, fanout); err != nil {
 		return nil, err
 	}
@@ -169,6 +218,12 @@ func New(cfg Config) (_ *Metrics, rerr error) {
 	return m, nil
 }
 
+// Shutdown shuts the sinks down, flushing the metrics not exported yet, e.g. by
+// the OpenTelemetry metrics sink. Metrics are not emitted after a shutdown.
+func (m *Metrics) Shutdown() {
+	metrics.Shutdown()
+}
+
 // Gather collects all registered metrics and returns a GatherResponse where the
 // metrics are encoded depending on the type. Metrics are either encoded via
 // Prometheus or JSON if in-memory.
@@ -0,0 +1,245 @@
+package telemetry
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+const (
+	// defaultOTLPMetricsInterval is the default interval of the exports of the
+	// OTLP metrics sink.
+	defaultOTLPMetricsInterval = 10 * time.Second
+
+	// otelShutdownTimeout is the maximum duration of the flush of the metrics
+	// not exported yet when the OTLP metrics sink is shut down.
+	otelShutdownTimeout = 5 * time.Second
+)
+
+var _ metrics.ShutdownSink = (*OTelSink)(nil)
+
+// OTelSink is a go-metrics sink recording the metrics with OpenTelemetry
+// instruments. Counters are recorded as counters, gauges as gauges and samples,
+// e.g. the measures of the MeasureSince wrappers, as histograms. The metric key
+// is joined with dots and the labels are recorded as attributes.
+//
+// Keys emitted with EmitKey are ignored.
+type OTelSink struct {
+	provider *sdkmetric.MeterProvider
+	meter    metric.Meter
+	buckets  []float64
+
+	mu         sync.Mutex
+	counters   map[string]metric.Float64Counter
+	histograms map[string]metric.Float64Histogram
+	gauges     map[string]*otelGauge
+}
+
+// otelGauge holds the last value of a gauge for each set of attributes, which
+// are observed when the metrics are collected. It has its own lock as the
+// meter provider holds its lock while observing the gauges.
+type otelGauge struct {
+	mu     sync.Mutex
+	values map[attribute.Distinct]gaugeValue
+}
+
+type gaugeValue struct {
+	attrs attribute.Set
+	value float64
+}
+
+// NewOTLPMetricSink returns an OTelSink exporting the metrics to the OTLP gRPC
+// endpoint of the given config.
+func NewOTLPMetricSink(cfg Config) (*OTelSink, error) {
+	opts := []otlpmetricgrpc.Option{otlpmetricgrpc.WithEndpoint(cfg.OTLPMetricsEndpoint)}
+	if cfg.OTLPMetricsInsecure {
+		opts = append(opts, otlpmetricgrpc.WithInsecure())
+	}
+
+	exporter, err := otlpmetricgrpc.New(context.Background(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	interval := defaultOTLPMetricsInterval
+	if cfg.OTLPMetricsInterval > 0 {
+		interval = time.Duration(cfg.OTLPMetricsInterval) * time.Second
+	}
+
+	return NewOTelSink(cfg, sdkmetric.NewPeriodicReader(exporter, sdkmetric.WithInterval(interval)))
+}
+
+// NewOTelSink returns an OTelSink whose metrics are collected by the given reader.
+// The histograms use the bucket boundaries of the config, or the default ones of
+// OpenTelemetry if not set.
+func NewOTelSink(cfg Config, reader sdkmetric.Reader) (*OTelSink, error) {
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(attribute.String("service.name", cfg.ServiceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader), sdkmetric.WithResource(res))
+	return &OTelSink{
+		provider:   provider,
+		meter:      provider.Meter(tracerName),
+		buckets:    cfg.HistogramBuckets,
+		counters:   map[string]metric.Float64Counter{},
+		histograms: map[string]metric.Float64Histogram{},
+		gauges:     map[string]*otelGauge{},
+	}, nil
+}
+
+// SetGauge implements metrics.MetricSink.
+func (s *OTelSink) SetGauge(key []string, val float32) {
+	s.SetGaugeWithLabels(key, val, nil)
+}
+
+// SetGaugeWithLabels implements metrics.MetricSink.
+func (s *OTelSink) SetGaugeWithLabels(key []string, val float32, labels []metrics.Label) {
+	s.SetPrecisionGaugeWithLabels(key, float64(val), labels)
+}
+
+// SetPrecisionGauge implements metrics.PrecisionGaugeMetricSink.
+func (s *OTelSink) SetPrecisionGauge(key []string, val float64) {
+	s.SetPrecisionGaugeWithLabels(key, val, nil)
+}
+
+// SetPrecisionGaugeWithLabels implements metrics.PrecisionGaugeMetricSink.
+func (s *OTelSink) SetPrecisionGaugeWithLabels(key []string, val float64, labels []metrics.Label) {
+	name := otelMetricName(key)
+	attrs := otelAttributes(labels)
+
+	s.mu.Lock()
+	gauge, ok := s.gauges[name]
+	if !ok {
+		gauge = &otelGauge{values: map[attribute.Distinct]gaugeValue{}}
+		_, err := s.meter.Float64ObservableGauge(name, metric.WithFloat64Callback(func(_ context.Context, o metric.Float64Observer) error {
+			gauge.mu.Lock()
+			defer gauge.mu.Unlock()
+
+			for _, v := range gauge.values {
+				o.Observe(v.value, metric.WithAttributeSet(v.attrs))
+			}
+			return nil
+		}))
+		if err != nil {
+			s.mu.Unlock()
+			return
+		}
+		s.gauges[name] = gauge
+	}
+	s.mu.Unlock()
+
+	gauge.mu.Lock()
+	gauge.values[attrs.Equivalent()] = gaugeValue{attrs: attrs, value: val}
+	gauge.mu.Unlock()
+}
+
+// EmitKey implements metrics.MetricSink, keys are ignored.
+func (s *OTelSink) EmitKey([]string, float32) {}
+
+// IncrCounter implements metrics.MetricSink.
+func (s *OTelSink) IncrCounter(key []string, val float32) {
+	s.IncrCounterWithLabels(key, val, nil)
+}
+
+// IncrCounterWithLabels implements metrics.MetricSink.
+func (s *OTelSink) IncrCounterWithLabels(key []string, val float32, labels []metrics.Label) {
+	name := otelMetricName(key)
+
+	s.mu.Lock()
+	counter, ok := s.counters[name]
+	if !ok {
+		var err error
+		counter, err = s.meter.Float64Counter(name)
+		if err != nil {
+			s.mu.Unlock()
+			return
+		}
+		s.counters[name] = counter
+	}
+	s.mu.Unlock()
+
+	counter.Add(context.Background(), float64(val), metric.WithAttributeSet(otelAttributes(labels)))
+}
+
+// AddSample implements metrics.MetricSink.
+func (s *OTelSink) AddSample(key []string, val float32) {
+	s.AddSampleWithLabels(key, val, nil)
+}
+
+// AddSampleWithLabels implements metrics.MetricSink.
+func (s *OTelSink) AddSampleWithLabels(key []string, val float32, labels []metrics.Label) {
+	name := otelMetricName(key)
+
+	s.mu.Lock()
+	histogram, ok := s.histograms[name]
+	if !ok {
+		var opts []metric.Float64HistogramOption
+		if len(s.buckets) > 0 {
+			opts = append(opts, metric.WithExplicitBucketBoundaries(s.buckets...))
+		}
+
+		var err error
+		histogram, err = s.meter.Float64Histogram(name, opts...)
+		if err != nil {
+			s.mu.Unlock()
+			return
+		}
+		s.histograms[name] = histogram
+	}
+	s.mu.Unlock()
+
+	histogram.Record(context.Background(), float64(val), metric.WithAttributeSet(otelAttributes(labels)))
+}
+
+// Shutdown implements metrics.ShutdownSink, it exports the metrics not exported
+// yet and shuts the meter provider down.
+func (s *OTelSink) Shutdown() {
+	ctx, cancel := context.WithTimeout(context.Background(), otelShutdownTimeout)
+	defer cancel()
+
+	_ = s.provider.Shutdown(ctx)
+}
+
+// otelMetricName returns the OpenTelemetry instrument name of a metric key.
+// The characters not allowed in instrument names are replaced by underscores,
+// and the leading characters other than letters are trimmed, e.g. the leading
+// slash of the gRPC method names of the query metrics.
+func otelMetricName(key []string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9',
+			r == '_', r == '.', r == '-', r == '/':
+			return r
+		default:
+			return '_'
+		}
+	}, strings.Join(key, "."))
+
+	// instrument names must start with a letter
+	name = strings.TrimLeft(name, "0123456789_.-/")
+	if name == "" {
+		return "unknown"
+	}
+
+	return name
+}
+
+// otelAttributes returns the attribute set of go-metrics labels.
+func otelAttributes(labels []metrics.Label) attribute.Set {
+	kvs := make([]attribute.KeyValue, len(labels))
+	for i, l := range labels {
+		kvs[i] = attribute.String(l.Name, l.Value)
+	}
+
+	return attribute.NewSet(kvs...)
+}
@@ -0,0 +1,65 @@
+package telemetry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestOTelSink(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	sink, err := NewOTelSink(Config{ServiceName: "test", HistogramBuckets: []float64{1, 10, 100}}, reader)
+	require.NoError(t, err)
+
+	labels := []metrics.Label{NewLabel("chain_id", "test-1")}
+	sink.IncrCounterWithLabels([]string{"tx", "count"}, 1, labels)
+	sink.IncrCounterWithLabels([]string{"tx", "count"}, 2, labels)
+	sink.SetGaugeWithLabels([]string{"tx", "gas", "used"}, 100, labels)
+	sink.SetGaugeWithLabels([]string{"tx", "gas", "used"}, 200, labels)
+	sink.AddSampleWithLabels([]string{"/cosmos.bank.v1beta1.Query/Balance"}, 5, labels)
+	sink.AddSample([]string{"/cosmos.bank.v1beta1.Query/Balance"}, 50)
+	sink.EmitKey([]string{"ignored"}, 1)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+
+	got := map[string]metricdata.Aggregation{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		got[m.Name] = m.Data
+	}
+	require.Len(t, got, 3)
+	attrs := attribute.NewSet(attribute.String("chain_id", "test-1"))
+
+	counter := got["tx.count"].(metricdata.Sum[float64])
+	require.True(t, counter.IsMonotonic)
+	require.Len(t, counter.DataPoints, 1)
+	require.Equal(t, 3.0, counter.DataPoints[0].Value)
+	require.Equal(t, attrs, counter.DataPoints[0].Attributes)
+
+	gauge := got["tx.gas.used"].(metricdata.Gauge[float64])
+	require.Len(t, gauge.DataPoints, 1)
+	require.Equal(t, 200.0, gauge.DataPoints[0].Value)
+	require.Equal(t, attrs, gauge.DataPoints[0].Attributes)
+
+	histogram := got["cosmos.bank.v1beta1.Query/Balance"].(metricdata.Histogram[float64])
+	require.Len(t, histogram.DataPoints, 2)
+	for _, dp := range histogram.DataPoints {
+		require.Equal(t, []float64{1, 10, 100}, dp.Bounds)
+		require.Equal(t, uint64(1), dp.Count)
+	}
+
+	sink.Shutdown()
+}
+
+func TestOTelMetricName(t *testing.T) {
+	require.Equal(t, "tx.gas.used", otelMetricName([]string{"tx", "gas", "used"}))
+	require.Equal(t, "cosmos.bank.v1beta1.Query/Balance", otelMetricName([]string{"/cosmos.bank.v1beta1.Query/Balance"}))
+	require.Equal(t, "a_b", otelMetricName([]string{"a b"}))
+	require.Equal(t, "unknown", otelMetricName([]string{"/"}))
+}
@@ -0,0 +1,124 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Trace exporter types.
+const (
+	TraceExporterOTLP = "otlp"
+	TraceExporterFile = "file"
+)
+
+// tracerName is the name of the tracer of the spans started by StartSpan.
+const tracerName = "github.com/cosmos/cosmos-sdk"
+
+// StartSpan starts a span with the given name and attributes, child of the span
+// of the given context if any, and returns a context holding the new span. The
+// span must be ended by the caller.
+//
+// The span is started by the global tracer provider, spans are thus not
+// recorded unless tracing is enabled with EnableTracing.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records the given error, if any, in the span and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// EnableTracing creates an OpenTelemetry tracer provider exporting the traces to
+// the exporter of the given config, and registers it as the global tracer
+// provider. The returned function flushes the traces not exported yet and shuts
+// the tracer provider down, it must be called before the application exits.
+//
+// The trace context is propagated using the W3C Trace Context format.
+func EnableTracing(ctx context.Context, cfg Config) (shutdown func(context.Context) error, err error) {
+	if cfg.TraceExporter == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	if cfg.TraceSampleRatio < 0 || cfg.TraceSampleRatio > 1 {
+		return nil, fmt.Errorf("trace sample ratio must be between 0 and 1, got %f", cfg.TraceSampleRatio)
+	}
+
+	var exporter sdktrace.SpanExporter
+	switch cfg.TraceExporter {
+	case TraceExporterOTLP:
+		if cfg.TraceEndpoint == "" {
+			return nil, errors.New("trace endpoint must be set for the otlp trace exporter")
+		}
+
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.TraceEndpoint)}
+		if cfg.TraceInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create otlp trace exporter: %w", err)
+		}
+
+	case TraceExporterFile:
+		if cfg.TraceFile == "" {
+			return nil, errors.New("trace file must be set for the file trace exporter")
+		}
+
+		file, err := os.OpenFile(cfg.TraceFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open trace file: %w", err)
+		}
+
+		stdoutExporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to create file trace exporter: %w", err), file.Close())
+		}
+		exporter = fileExporter{SpanExporter: stdoutExporter, file: file}
+
+	default:
+		return nil, fmt.Errorf("unsupported trace exporter: %s", cfg.TraceExporter)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(attribute.String("service.name", cfg.ServiceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.TraceSampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return provider.Shutdown, nil
+}
+
+// fileExporter is a span exporter writing the spans to a file, closed on shutdown.
+type fileExporter struct {
+	sdktrace.SpanExporter
+	file *os.File
+}
+
+func (e fileExporter) Shutdown(ctx context.Context) error {
+	return errors.Join(e.SpanExporter.Shutdown(ctx), e.file.Close())
+}
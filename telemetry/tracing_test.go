@@ -0,0 +1,62 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestEnableTracing_Disabled(t *testing.T) {
+	shutdown, err := EnableTracing(context.Background(), Config{})
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+}
+
+func TestEnableTracing_File(t *testing.T) {
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+
+	traceFile := filepath.Join(t.TempDir(), "traces.json")
+	shutdown, err := EnableTracing(context.Background(), Config{
+		ServiceName:      "test",
+		TraceExporter:    TraceExporterFile,
+		TraceFile:        traceFile,
+		TraceSampleRatio: 1,
+	})
+	require.NoError(t, err)
+
+	ctx, parent := StartSpan(context.Background(), "parent")
+	_, child := StartSpan(ctx, "child")
+	EndSpan(child, errors.New("child failed"))
+	EndSpan(parent, nil)
+	require.Equal(t, parent.SpanContext().TraceID(), child.SpanContext().TraceID())
+
+	require.NoError(t, shutdown(context.Background()))
+
+	bz, err := os.ReadFile(traceFile)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"Name":"parent"`)
+	require.Contains(t, string(bz), `"Name":"child"`)
+	require.Contains(t, string(bz), "child failed")
+}
+
+func TestEnableTracing_InvalidConfig(t *testing.T) {
+	testCases := map[string]Config{
+		"unknown exporter": {TraceExporter: "unknown", TraceSampleRatio: 1},
+		"no otlp endpoint": {TraceExporter: TraceExporterOTLP, TraceSampleRatio: 1},
+		"no trace file":    {TraceExporter: TraceExporterFile, TraceSampleRatio: 1},
+		"invalid ratio":    {TraceExporter: TraceExporterFile, TraceFile: "traces.json", TraceSampleRatio: 2},
+		"negative ratio":   {TraceExporter: TraceExporterFile, TraceFile: "traces.json", TraceSampleRatio: -1},
+	}
+	for name, cfg := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := EnableTracing(context.Background(), cfg)
+			require.Error(t, err)
+		})
+	}
+}
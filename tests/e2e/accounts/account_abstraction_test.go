@@ -7,9 +7,14 @@ import (
 	"testing"
 
 	"cosmossdk.io/simapp"
+	"cosmossdk.io/x/accounts"
+	rotationv1 "cosmossdk.io/x/accounts/testing/rotation/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 )
@@ -21,55 +26,87 @@ var (
 	aliceAddr   = secp256k1.GenPrivKey().PubKey().Address()
 )
 
-/*
 func TestAccountAbstraction(t *testing.T) {
 	app := setupApp(t)
 	ak := app.AccountsKeeper
 	ctx := sdk.NewContext(app.CommitMultiStore(), false, app.Logger())
+	msgServer := accounts.NewMsgServer(ak)
 
 	_, aaAddr, err := ak.Init(ctx, "aa_minimal", accCreator, &rotationv1.MsgInit{
 		PubKeyBytes: privKey.PubKey().Bytes(),
 	}, nil)
 	require.NoError(t, err)
 
-	_, aaFullAddr, err := ak.Init(ctx, "aa_full", accCreator, &rotationv1.MsgInit{
-		PubKeyBytes: privKey.PubKey().Bytes(),
-	}, nil)
-	require.NoError(t, err)
-
-	aaAddrStr, err := app.AuthKeeper.AddressCodec().BytesToString(aaAddr)
-	require.NoError(t, err)
-
-	aaFullAddrStr, err := app.AuthKeeper.AddressCodec().BytesToString(aaFullAddr)
-	require.NoError(t, err)
-
 	// let's give aa some coins.
-	require.NoError(t, testutil.FundAccount(ctx, app.BankKeeper, aaAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100000000000))))
-	require.NoError(t, testutil.FundAccount(ctx, app.BankKeeper, aaFullAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100000000000))))
-
-	bundlerAddrStr, err := app.AuthKeeper.AddressCodec().BytesToString(bundlerAddr)
-	require.NoError(t, err)
-
-	aliceAddrStr, err := app.AuthKeeper.AddressCodec().BytesToString(aliceAddr)
-	require.NoError(t, err)
-
-	t.Run("ok - pay bundler not implemented", func(t *testing.T) {})
-	t.Run("pay bundle impersonation", func(t *testing.T) {})
-	t.Run("auth failure", func(t *testing.T) {})
-	t.Run("pay bundle failure", func(t *testing.T) {})
-	t.Run("exec message failure", func(t *testing.T) {})
-
-	t.Run("implements bundler payment - fail ", func(t *testing.T) {})
-
-	t.Run("implements execution - fail", func(t *testing.T) {})
-
-	t.Run("implements bundler payment and execution - success", func(t *testing.T) {})
+	fundAccount(t, app, ctx, aaAddr, "1000stake")
+
+	aaAddrStr := bechify(t, app, aaAddr)
+	bundlerAddrStr := bechify(t, app, bundlerAddr)
+	aliceAddrStr := bechify(t, app, aliceAddr)
+
+	// bundledTx makes a tx of the aa account sending coins to alice.
+	bundledTx := func(t *testing.T, fee, amount string) *tx.TxRaw {
+		t.Helper()
+		bodyBytes, err := app.AppCodec().Marshal(&tx.TxBody{Messages: intoAny(t, &banktypes.MsgSend{
+			FromAddress: aaAddrStr,
+			ToAddress:   aliceAddrStr,
+			Amount:      coins(t, amount),
+		})})
+		require.NoError(t, err)
+		authInfoBytes, err := app.AppCodec().Marshal(&tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{{}},
+			Fee:         &tx.Fee{Amount: coins(t, fee)},
+		})
+		require.NoError(t, err)
+		return &tx.TxRaw{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes, Signatures: [][]byte{[]byte("signature")}}
+	}
 
-	t.Run("Simulate - OK", func(t *testing.T) {})
+	executeBundle := func(t *testing.T, txs ...*tx.TxRaw) []*accountsv1.BundledTxResponse {
+		t.Helper()
+		resp, err := msgServer.ExecuteBundle(ctx, &accountsv1.MsgExecuteBundle{Bundler: bundlerAddrStr, Txs: txs})
+		require.NoError(t, err)
+		require.Len(t, resp.Responses, len(txs))
+		return resp.Responses
+	}
 
-	t.Run("Simulate - Fail empty user operation", func(t *testing.T) {})
+	t.Run("ok - the bundler is paid and the messages executed", func(t *testing.T) {
+		resp := executeBundle(t, bundledTx(t, "10stake", "100stake"))
+		require.Empty(t, resp[0].Error)
+		require.Len(t, resp[0].ExecResponses, 1)
+
+		balanceIs(t, ctx, app, aaAddr, "890stake")
+		balanceIs(t, ctx, app, sdk.AccAddress(bundlerAddr), "10stake")
+		balanceIs(t, ctx, app, sdk.AccAddress(aliceAddr), "100stake")
+	})
+
+	t.Run("exec message failure - the bundler is still paid", func(t *testing.T) {
+		resp := executeBundle(t, bundledTx(t, "10stake", "1000stake"))
+		require.Contains(t, resp[0].Error, accounts.ErrExecution.Error())
+
+		balanceIs(t, ctx, app, aaAddr, "880stake")
+		balanceIs(t, ctx, app, sdk.AccAddress(bundlerAddr), "20stake")
+		balanceIs(t, ctx, app, sdk.AccAddress(aliceAddr), "100stake")
+	})
+
+	t.Run("pay bundler failure - nothing is executed", func(t *testing.T) {
+		resp := executeBundle(t, bundledTx(t, "1000stake", "100stake"))
+		require.Contains(t, resp[0].Error, accounts.ErrBundlerPayment.Error())
+
+		balanceIs(t, ctx, app, aaAddr, "880stake")
+		balanceIs(t, ctx, app, sdk.AccAddress(bundlerAddr), "20stake")
+		balanceIs(t, ctx, app, sdk.AccAddress(aliceAddr), "100stake")
+	})
+
+	t.Run("a failed tx does not fail the bundle", func(t *testing.T) {
+		resp := executeBundle(t, bundledTx(t, "1000stake", "100stake"), bundledTx(t, "10stake", "100stake"))
+		require.NotEmpty(t, resp[0].Error)
+		require.Empty(t, resp[1].Error)
+
+		balanceIs(t, ctx, app, aaAddr, "770stake")
+		balanceIs(t, ctx, app, sdk.AccAddress(bundlerAddr), "30stake")
+		balanceIs(t, ctx, app, sdk.AccAddress(aliceAddr), "200stake")
+	})
 }
-*/
 
 func intoAny(t *testing.T, msgs ...gogoproto.Message) (anys []*codectypes.Any) {
 	t.Helper()
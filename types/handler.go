@@ -1,5 +1,13 @@
 package types
 
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
 // AnteHandler authenticates transactions, before their internal messages are
 // executed. The provided ctx is expected to contain all relevant information
 // needed to process the transaction, e.g. fee payment information. If new data
@@ -39,6 +47,9 @@ type PostDecorator interface {
 // transactions to be processed with an infinite gasmeter and open a DOS attack vector.
 // Use `ante.SetUpContextDecorator` or a custom Decorator with similar functionality.
 // Returns nil when no AnteDecorator are supplied.
+//
+// When the span of the given Context is recorded, each AnteDecorator is traced
+// in a span named after its type.
 func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 	if len(chain) == 0 {
 		return nil
@@ -51,8 +62,22 @@ func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 	}
 	for i := 0; i < len(chain); i++ {
 		ii := i
+		spanName := fmt.Sprintf("%T", chain[ii])
 		handlerChain[ii] = func(ctx Context, tx Tx, _ bool) (Context, error) {
-			return chain[ii].AnteHandle(ctx, tx, ctx.ExecMode() == ExecModeSimulate, handlerChain[ii+1])
+			simulate := ctx.ExecMode() == ExecModeSimulate
+			if ctx.Context() == nil || !trace.SpanFromContext(ctx.Context()).IsRecording() {
+				return chain[ii].AnteHandle(ctx, tx, simulate, handlerChain[ii+1])
+			}
+
+			parent := trace.SpanFromContext(ctx.Context())
+			goCtx, span := telemetry.StartSpan(ctx.Context(), spanName)
+			newCtx, err := chain[ii].AnteHandle(ctx.WithContext(goCtx), tx, simulate, handlerChain[ii+1])
+			telemetry.EndSpan(span, err)
+			if newCtx.Context() != nil {
+				// the span of the decorator is ended, restore the parent span
+				newCtx = newCtx.WithContext(trace.ContextWithSpan(newCtx.Context(), parent))
+			}
+			return newCtx, err
 		}
 	}
 
@@ -1,10 +1,16 @@
 package types_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -64,3 +70,28 @@ func TestChainPostDecorators(t *testing.T) {
 	)(ctx, tx, true, true)
 	require.NoError(t, err)
 }
+
+func TestChainAnteDecoratorsTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+	goCtx, span := otel.Tracer("test").Start(context.Background(), "runTx")
+
+	ctx, tx := sdk.Context{}.WithContext(goCtx), sdk.Tx(nil)
+	mockCtrl := gomock.NewController(t)
+	mockAnteDecorator := mock.NewMockAnteDecorator(mockCtrl)
+	mockAnteDecorator.EXPECT().AnteHandle(gomock.Any(), gomock.Eq(tx), false, gomock.Any()).
+		DoAndReturn(func(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+			return next(ctx, tx, simulate)
+		}).Times(1)
+
+	newCtx, err := sdk.ChainAnteDecorators(mockAnteDecorator)(ctx, tx, false)
+	require.NoError(t, err)
+	// the span of the returned context is the span of the caller
+	require.Equal(t, span.SpanContext(), trace.SpanContextFromContext(newCtx.Context()))
+
+	ended := recorder.Ended()
+	require.Len(t, ended, 1)
+	require.Equal(t, "*mock.MockAnteDecorator", ended[0].Name())
+	require.Equal(t, span.SpanContext().SpanID(), ended[0].Parent().SpanID())
+}
@@ -0,0 +1,511 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+
+	"github.com/huandu/skiplist"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Mempool  = (*FeeMarketMempool)(nil)
+	_ Iterator = (*feeMarketIterator)(nil)
+)
+
+// DefaultMinReplacementBump is the default minimum priority increase, in
+// percent, required to replace a transaction with the same sender and nonce.
+const DefaultMinReplacementBump = 10
+
+type (
+	// FeeMarketMempoolConfig defines the configuration used to configure the
+	// FeeMarketMempool.
+	FeeMarketMempoolConfig struct {
+		// GetTxPriority returns the priority of a transaction, typically its fee
+		// per unit of gas. Transactions are ordered, replaced and evicted by it.
+		GetTxPriority func(ctx context.Context, tx sdk.Tx) int64
+
+		// MaxTx is the maximum number of transactions the mempool holds, 0 means
+		// no limit. When full, transactions are evicted from the sender chain with
+		// the lowest priority, if it is lower than the priority of the inserted
+		// transaction, otherwise the insert fails.
+		MaxTx int
+
+		// MaxTxsPerSender is the maximum number of transactions a single sender
+		// may have in the mempool, 0 means no limit.
+		MaxTxsPerSender int
+
+		// MinReplacementBump is the minimum priority increase, in percent, that a
+		// transaction must have over the one with the same sender and nonce it
+		// replaces. The increase must be strictly positive in any case.
+		MinReplacementBump uint64
+
+		// TTL is the number of blocks a transaction stays in the mempool after
+		// being inserted, 0 means transactions never expire. The block height is
+		// read from the sdk.Context passed to Insert and Select. An expired
+		// transaction expires the transactions of its sender with a higher nonce.
+		TTL int64
+
+		// SignerExtractor is an implementation which retrieves signer data from a sdk.Tx
+		SignerExtractor SignerExtractionAdapter
+	}
+
+	// FeeMarketMempool is a mempool implementation ordering transactions by
+	// priority across senders and by nonce within a sender, like the
+	// PriorityNonceMempool, but which makes room for higher paying transactions
+	// when full instead of rejecting them. It supports replace-by-fee, per
+	// sender limits and expiry of transactions by block height.
+	FeeMarketMempool struct {
+		mtx     sync.Mutex
+		cfg     FeeMarketMempoolConfig
+		senders map[string]*skiplist.SkipList
+		count   int
+		events  eventBroadcaster
+
+		// chainIndex orders the senders by the priority of their chain, lowest
+		// first, and chainPriorities holds the indexed priority of each sender.
+		chainIndex      *skiplist.SkipList
+		chainPriorities map[string]int64
+		// senderPriorities counts the transactions of each sender by priority,
+		// lowest first, so that the priority of a chain is its first key.
+		senderPriorities map[string]*skiplist.SkipList
+		// heightIndex orders the transactions by insertion height, oldest first.
+		heightIndex *skiplist.SkipList
+	}
+
+	// chainKey is the key of a sender in the chain index.
+	chainKey struct {
+		priority int64
+		sender   string
+	}
+
+	// heightKey is the key of a transaction in the height index.
+	heightKey struct {
+		height int64
+		sender string
+		nonce  uint64
+	}
+
+	// feeMarketTx is a transaction stored in the FeeMarketMempool.
+	feeMarketTx struct {
+		tx       sdk.Tx
+		sender   string
+		nonce    uint64
+		priority int64
+		// height is the block height at which the tx was inserted
+		height int64
+	}
+)
+
+// NewDefaultTxFee returns a GetTxPriority function using ctx.Priority, which
+// the default ante handler sets to the gas price of the transaction.
+func NewDefaultTxFee() func(context.Context, sdk.Tx) int64 {
+	return func(goCtx context.Context, _ sdk.Tx) int64 {
+		return sdk.UnwrapSDKContext(goCtx).Priority()
+	}
+}
+
+// DefaultFeeMarketMempoolConfig returns a FeeMarketMempoolConfig with no
+// limits and the default replacement bump.
+func DefaultFeeMarketMempoolConfig() FeeMarketMempoolConfig {
+	return FeeMarketMempoolConfig{
+		GetTxPriority:      NewDefaultTxFee(),
+		MinReplacementBump: DefaultMinReplacementBump,
+		SignerExtractor:    NewDefaultSignerExtractionAdapter(),
+	}
+}
+
+// NewFeeMarketMempool returns a new FeeMarketMempool with the given configuration.
+func NewFeeMarketMempool(cfg FeeMarketMempoolConfig) *FeeMarketMempool {
+	if cfg.GetTxPriority == nil {
+		cfg.GetTxPriority = NewDefaultTxFee()
+	}
+	if cfg.SignerExtractor == nil {
+		cfg.SignerExtractor = NewDefaultSignerExtractionAdapter()
+	}
+
+	return &FeeMarketMempool{
+		cfg:     cfg,
+		senders: make(map[string]*skiplist.SkipList),
+		chainIndex: skiplist.New(skiplist.GreaterThanFunc(func(a, b any) int {
+			keyA, keyB := a.(chainKey), b.(chainKey)
+			if res := skiplist.Int64.Compare(keyA.priority, keyB.priority); res != 0 {
+				return res
+			}
+			return skiplist.String.Compare(keyA.sender, keyB.sender)
+		})),
+		chainPriorities:  make(map[string]int64),
+		senderPriorities: make(map[string]*skiplist.SkipList),
+		heightIndex: skiplist.New(skiplist.GreaterThanFunc(func(a, b any) int {
+			keyA, keyB := a.(heightKey), b.(heightKey)
+			if res := skiplist.Int64.Compare(keyA.height, keyB.height); res != 0 {
+				return res
+			}
+			if res := skiplist.String.Compare(keyA.sender, keyB.sender); res != 0 {
+				return res
+			}
+			return skiplist.Uint64.Compare(keyA.nonce, keyB.nonce)
+		})),
+	}
+}
+
+// Insert attempts to insert a Tx into the mempool, returning an error if
+// unsuccessful. Sender and nonce are derived from the transaction's first
+// signature.
+//
+// A transaction with the same sender and nonce as an existing one replaces it
+// only if its priority is higher by at least MinReplacementBump percent.
+// Otherwise ErrReplacementUnderpriced is returned. Expired transactions are
+// purged before the capacity of the mempool is checked.
+func (mp *FeeMarketMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
+	if err != nil {
+		return err
+	}
+	if len(sigs) == 0 {
+		return fmt.Errorf("tx must have at least one signer")
+	}
+
+	height := blockHeight(ctx)
+	mp.purgeExpired(height)
+
+	sig := sigs[0]
+	ftx := &feeMarketTx{
+		tx:       tx,
+		sender:   sig.Signer.String(),
+		nonce:    sig.Sequence,
+		priority: mp.cfg.GetTxPriority(ctx, tx),
+		height:   height,
+	}
+
+	senderTxs, ok := mp.senders[ftx.sender]
+	if ok {
+		if existing := senderTxs.Get(ftx.nonce); existing != nil {
+			old := existing.Value.(*feeMarketTx)
+			if !mp.canReplace(old.priority, ftx.priority) {
+				return fmt.Errorf("%w: old priority %d, new priority %d, min bump %d%%",
+					ErrReplacementUnderpriced, old.priority, ftx.priority, mp.cfg.MinReplacementBump)
+			}
+
+			mp.removeTx(old)
+			mp.addTx(ftx)
+			mp.updateChain(ftx.sender)
+			mp.events.publish(EventEvict, old.info())
+			mp.events.publish(EventInsert, ftx.info())
+			return nil
+		}
+
+		if mp.cfg.MaxTxsPerSender > 0 && senderTxs.Len() >= mp.cfg.MaxTxsPerSender {
+			return fmt.Errorf("%w: sender %s has %d txs", ErrSenderTxLimit, ftx.sender, senderTxs.Len())
+		}
+	}
+
+	if mp.cfg.MaxTx > 0 && mp.count >= mp.cfg.MaxTx {
+		if !mp.evict(ftx) {
+			return ErrMempoolTxMaxCapacity
+		}
+	}
+
+	mp.addTx(ftx)
+	mp.updateChain(ftx.sender)
+	mp.events.publish(EventInsert, ftx.info())
+
+	return nil
+}
+
+// addTx adds ftx to the chain of its sender and to the height index.
+func (mp *FeeMarketMempool) addTx(ftx *feeMarketTx) {
+	senderTxs, ok := mp.senders[ftx.sender]
+	if !ok {
+		senderTxs = skiplist.New(skiplist.Uint64)
+		mp.senders[ftx.sender] = senderTxs
+		mp.senderPriorities[ftx.sender] = skiplist.New(skiplist.Int64)
+	}
+
+	senderTxs.Set(ftx.nonce, ftx)
+	priorities := mp.senderPriorities[ftx.sender]
+	count := 0
+	if e := priorities.Get(ftx.priority); e != nil {
+		count = e.Value.(int)
+	}
+	priorities.Set(ftx.priority, count+1)
+	mp.heightIndex.Set(heightKey{height: ftx.height, sender: ftx.sender, nonce: ftx.nonce}, ftx)
+	mp.count++
+}
+
+// removeTx removes ftx from the chain of its sender and from the height index.
+// The chain index must be updated by the caller.
+func (mp *FeeMarketMempool) removeTx(ftx *feeMarketTx) {
+	mp.senders[ftx.sender].Remove(ftx.nonce)
+	priorities := mp.senderPriorities[ftx.sender]
+	if count := priorities.Get(ftx.priority).Value.(int); count > 1 {
+		priorities.Set(ftx.priority, count-1)
+	} else {
+		priorities.Remove(ftx.priority)
+	}
+	mp.heightIndex.Remove(heightKey{height: ftx.height, sender: ftx.sender, nonce: ftx.nonce})
+	mp.count--
+}
+
+// updateChain reindexes the chain of the given sender by its priority, removing
+// the sender if it has no transactions left. The chain is only reindexed if
+// its priority changed.
+func (mp *FeeMarketMempool) updateChain(sender string) {
+	oldPriority, indexed := mp.chainPriorities[sender]
+	if mp.senders[sender].Len() == 0 {
+		if indexed {
+			mp.chainIndex.Remove(chainKey{priority: oldPriority, sender: sender})
+			delete(mp.chainPriorities, sender)
+		}
+		delete(mp.senders, sender)
+		delete(mp.senderPriorities, sender)
+		return
+	}
+
+	priority := mp.senderPriorities[sender].Front().Key().(int64)
+	if indexed && priority == oldPriority {
+		return
+	}
+	if indexed {
+		mp.chainIndex.Remove(chainKey{priority: oldPriority, sender: sender})
+	}
+	mp.chainIndex.Set(chainKey{priority: priority, sender: sender}, sender)
+	mp.chainPriorities[sender] = priority
+}
+
+// canReplace returns whether a transaction with priority np may replace one
+// with priority op.
+func (mp *FeeMarketMempool) canReplace(op, np int64) bool {
+	if np <= op {
+		return false
+	}
+
+	// compute the bump in float64 to avoid overflowing on large priorities
+	minPriority := float64(op) + math.Abs(float64(op))*float64(mp.cfg.MinReplacementBump)/100
+	return float64(np) >= minPriority
+}
+
+// evict removes transactions from the tail of the lowest priority sender chain
+// until there is room for ftx, as long as that chain has a lower priority than
+// ftx and doesn't belong to its sender. It returns whether room was made.
+//
+// The priority of a chain is the lowest priority of its transactions, as the
+// chain can't be included in a block at a better rate than that. The chains are
+// indexed by their cached priority, thus the victim is found in logarithmic
+// time.
+func (mp *FeeMarketMempool) evict(ftx *feeMarketTx) bool {
+	for mp.count >= mp.cfg.MaxTx {
+		// the lowest chain, skipping the one of the sender of ftx
+		e := mp.chainIndex.Front()
+		if e != nil && e.Value.(string) == ftx.sender {
+			e = e.Next()
+		}
+		if e == nil || e.Key().(chainKey).priority >= ftx.priority {
+			return false
+		}
+
+		victim := e.Value.(string)
+		evicted := mp.senders[victim].Back().Value.(*feeMarketTx)
+		mp.removeTx(evicted)
+		mp.updateChain(victim)
+		mp.events.publish(EventEvict, evicted.info())
+	}
+
+	return true
+}
+
+// PurgeExpired removes the transactions which expired at the given block
+// height and returns how many were removed. It's a no-op if no TTL is set.
+func (mp *FeeMarketMempool) PurgeExpired(height int64) int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.purgeExpired(height)
+}
+
+// purgeExpired walks the height index from the oldest transaction until one
+// has not expired. The transactions of the same sender with a higher nonce than
+// an expired one are purged along with it, as they can't be included in a block
+// without it.
+func (mp *FeeMarketMempool) purgeExpired(height int64) int {
+	if mp.cfg.TTL <= 0 {
+		return 0
+	}
+
+	purged := 0
+	for e := mp.heightIndex.Front(); e != nil; e = mp.heightIndex.Front() {
+		expired := e.Value.(*feeMarketTx)
+		if height < expired.height+mp.cfg.TTL {
+			break
+		}
+
+		senderTxs := mp.senders[expired.sender]
+		for back := senderTxs.Back(); back != nil && back.Key().(uint64) >= expired.nonce; back = senderTxs.Back() {
+			ftx := back.Value.(*feeMarketTx)
+			mp.removeTx(ftx)
+			mp.events.publish(EventEvict, ftx.info())
+			purged++
+		}
+		mp.updateChain(expired.sender)
+	}
+
+	return purged
+}
+
+// Select returns an iterator over the transactions of the mempool, ordered by
+// priority across senders and by nonce within a sender: at each step, the
+// next transaction of the sender whose next transaction has the highest
+// priority is returned. The passed in list of transactions is ignored.
+//
+// Expired transactions are purged from the mempool first. The iterator walks
+// the chains of the senders lazily, as the PriorityNonceMempool does.
+//
+// NOTE: It is not safe to use this iterator while modifying the mempool, except
+// for removing the current transaction, which ends the iteration over the
+// transactions of its sender.
+func (mp *FeeMarketMempool) Select(ctx context.Context, _ [][]byte) Iterator {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.purgeExpired(blockHeight(ctx))
+	if mp.count == 0 {
+		return nil
+	}
+
+	heads := make(senderHeads, 0, len(mp.senders))
+	for _, senderTxs := range mp.senders {
+		heads = append(heads, senderTxs.Front())
+	}
+	heap.Init(&heads)
+
+	return &feeMarketIterator{heads: heads}
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *FeeMarketMempool) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.count
+}
+
+// Remove removes a transaction from the mempool, returning ErrTxNotFound if
+// there is no transaction with the same sender and nonce.
+func (mp *FeeMarketMempool) Remove(tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	sigs, err := mp.cfg.SignerExtractor.GetSigners(tx)
+	if err != nil {
+		return err
+	}
+	if len(sigs) == 0 {
+		return fmt.Errorf("attempted to remove a tx with no signatures")
+	}
+
+	sig := sigs[0]
+	sender := sig.Signer.String()
+	senderTxs, ok := mp.senders[sender]
+	if !ok {
+		return ErrTxNotFound
+	}
+	e := senderTxs.Get(sig.Sequence)
+	if e == nil {
+		return ErrTxNotFound
+	}
+
+	removed := e.Value.(*feeMarketTx)
+	mp.removeTx(removed)
+	mp.updateChain(sender)
+	mp.events.publish(EventRemove, removed.info())
+
+	return nil
+}
+
+// WalkTxs implements the Inspector interface.
+func (mp *FeeMarketMempool) WalkTxs(fn func(TxInfo) bool) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	walkSenders(mp.senders, func(_ string, e *skiplist.Element) bool {
+		return fn(e.Value.(*feeMarketTx).info())
+	})
+}
+
+// Subscribe implements the Inspector interface.
+func (mp *FeeMarketMempool) Subscribe(bufferSize int) (<-chan Event, func()) {
+	return mp.events.subscribe(bufferSize)
+}
+
+func (ftx *feeMarketTx) info() TxInfo {
+	return TxInfo{Tx: ftx.tx, Sender: ftx.sender, Nonce: ftx.nonce, Priority: strconv.FormatInt(ftx.priority, 10)}
+}
+
+// blockHeight returns the block height of the sdk.Context wrapped by ctx, or
+// 0 if there is none.
+func blockHeight(ctx context.Context) int64 {
+	if sdkCtx, ok := ctx.(sdk.Context); ok {
+		return sdkCtx.BlockHeight()
+	}
+	if sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context); ok {
+		return sdkCtx.BlockHeight()
+	}
+	return 0
+}
+
+// senderHeads is a max-heap of the next transaction of each sender by
+// priority, ties being broken by sender for a deterministic order.
+type senderHeads []*skiplist.Element
+
+func (h senderHeads) Len() int { return len(h) }
+
+func (h senderHeads) Less(i, j int) bool {
+	a, b := h[i].Value.(*feeMarketTx), h[j].Value.(*feeMarketTx)
+	if a.priority != b.priority {
+		return a.priority > b.priority
+	}
+	return a.sender < b.sender
+}
+
+func (h senderHeads) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *senderHeads) Push(x any) { *h = append(*h, x.(*skiplist.Element)) }
+
+func (h *senderHeads) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// feeMarketIterator iterates over the chains of the senders of the
+// FeeMarketMempool, the current transaction being the head of the heap.
+type feeMarketIterator struct {
+	heads senderHeads
+}
+
+func (i *feeMarketIterator) Next() Iterator {
+	// a removed element has no next element
+	if next := i.heads[0].Next(); next != nil {
+		i.heads[0] = next
+		heap.Fix(&i.heads, 0)
+	} else {
+		heap.Pop(&i.heads)
+	}
+
+	if i.heads.Len() == 0 {
+		return nil
+	}
+	return i
+}
+
+func (i *feeMarketIterator) Tx() sdk.Tx {
+	return i.heads[0].Value.(*feeMarketTx).tx
+}
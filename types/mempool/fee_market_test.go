@@ -0,0 +1,258 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestFeeMarketMempool_Order(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	mp := mempool.NewFeeMarketMempool(mempool.DefaultFeeMarketMempoolConfig())
+
+	txs := genRandomTxs(42, 500, 10)
+	rand.New(rand.NewSource(42)).Shuffle(len(txs), func(i, j int) { txs[i], txs[j] = txs[j], txs[i] })
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+	require.Equal(t, len(txs), mp.CountTx())
+
+	selected := fetchTxs(mp.Select(ctx, nil), 1000)
+	require.Len(t, selected, len(txs))
+	require.NoError(t, validateOrder(selected))
+
+	for _, tx := range selected {
+		require.NoError(t, mp.Remove(tx))
+	}
+	require.Equal(t, 0, mp.CountTx())
+	require.Nil(t, mp.Select(ctx, nil))
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+}
+
+func TestFeeMarketMempool_Replacement(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)[0].Address
+	mp := mempool.NewFeeMarketMempool(mempool.DefaultFeeMarketMempoolConfig())
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(100), testTx{id: 0, priority: 100, address: sa}))
+
+	// same or lower priority, and a bump below 10% are rejected
+	for _, priority := range []int64{90, 100, 109} {
+		err := mp.Insert(ctx.WithPriority(priority), testTx{id: 1, priority: priority, address: sa})
+		require.ErrorIs(t, err, mempool.ErrReplacementUnderpriced)
+	}
+
+	replacement := testTx{id: 2, priority: 110, address: sa}
+	require.NoError(t, mp.Insert(ctx.WithPriority(110), replacement))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []sdk.Tx{replacement}, fetchTxs(mp.Select(ctx, nil), 10))
+}
+
+func TestFeeMarketMempool_SenderLimit(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	cfg := mempool.DefaultFeeMarketMempoolConfig()
+	cfg.MaxTxsPerSender = 2
+	mp := mempool.NewFeeMarketMempool(cfg)
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(1), testTx{nonce: 0, priority: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(1), testTx{nonce: 1, priority: 1, address: sa}))
+	err := mp.Insert(ctx.WithPriority(1), testTx{nonce: 2, priority: 1, address: sa})
+	require.ErrorIs(t, err, mempool.ErrSenderTxLimit)
+
+	// replacements don't count against the limit
+	require.NoError(t, mp.Insert(ctx.WithPriority(2), testTx{nonce: 1, priority: 2, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(1), testTx{nonce: 0, priority: 1, address: sb}))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestFeeMarketMempool_Eviction(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	cfg := mempool.DefaultFeeMarketMempoolConfig()
+	cfg.MaxTx = 4
+	mp := mempool.NewFeeMarketMempool(cfg)
+
+	txs := []testTx{
+		{nonce: 0, priority: 50, address: sa},
+		{nonce: 1, priority: 50, address: sa},
+		{nonce: 0, priority: 20, address: sb},
+		{nonce: 1, priority: 30, address: sb},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	// not paying more than the lowest chain
+	err := mp.Insert(ctx.WithPriority(20), testTx{nonce: 0, priority: 20, address: sc})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+
+	// sb's chain has the lowest priority and loses its tail to sc
+	cTx := testTx{nonce: 0, priority: 40, address: sc}
+	require.NoError(t, mp.Insert(ctx.WithPriority(40), cTx))
+	require.Equal(t, 4, mp.CountTx())
+
+	// a sender can't evict its own txs
+	err = mp.Insert(ctx.WithPriority(10), testTx{nonce: 1, priority: 10, address: sb})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+
+	selected := fetchTxs(mp.Select(ctx, nil), 10)
+	require.Equal(t, []sdk.Tx{txs[0], txs[1], cTx, txs[2]}, selected)
+}
+
+func TestFeeMarketMempool_TTL(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	cfg := mempool.DefaultFeeMarketMempoolConfig()
+	cfg.TTL = 5
+	mp := mempool.NewFeeMarketMempool(cfg)
+
+	aTx := testTx{nonce: 0, priority: 1, address: sa}
+	bTx := testTx{nonce: 0, priority: 1, address: sb}
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(10).WithPriority(1), aTx))
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(12).WithPriority(1), bTx))
+
+	require.Len(t, fetchTxs(mp.Select(ctx.WithBlockHeight(14), nil), 10), 2)
+	require.Equal(t, []sdk.Tx{bTx}, fetchTxs(mp.Select(ctx.WithBlockHeight(15), nil), 10))
+	require.Equal(t, 1, mp.CountTx())
+
+	require.Equal(t, 1, mp.PurgeExpired(17))
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func TestFeeMarketMempool_TTLDependentNonces(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	cfg := mempool.DefaultFeeMarketMempoolConfig()
+	cfg.TTL = 5
+	mp := mempool.NewFeeMarketMempool(cfg)
+
+	// sa's nonce 1 is inserted first, its later nonces depend on it
+	aTxs := []testTx{
+		{nonce: 0, priority: 1, address: sa},
+		{nonce: 1, priority: 1, address: sa},
+		{nonce: 2, priority: 1, address: sa},
+	}
+	bTx := testTx{nonce: 0, priority: 1, address: sb}
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(10).WithPriority(1), aTxs[1]))
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(11).WithPriority(1), aTxs[0]))
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(12).WithPriority(1), aTxs[2]))
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(12).WithPriority(1), bTx))
+
+	// expiring nonce 1 purges nonce 2, which was inserted later, but not nonce 0
+	require.Equal(t, 2, mp.PurgeExpired(15))
+	require.ElementsMatch(t, []sdk.Tx{aTxs[0], bTx}, fetchTxs(mp.Select(ctx.WithBlockHeight(15), nil), 10))
+
+	// a replacement resets the expiry of the transaction
+	replacement := testTx{id: 1, nonce: 0, priority: 2, address: sa}
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(15).WithPriority(2), replacement))
+	require.Equal(t, []sdk.Tx{replacement}, fetchTxs(mp.Select(ctx.WithBlockHeight(17), nil), 10))
+	require.Equal(t, 1, mp.PurgeExpired(20))
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func TestFeeMarketMempool_EvictionIndex(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	cfg := mempool.DefaultFeeMarketMempoolConfig()
+	cfg.MaxTx = 3
+	mp := mempool.NewFeeMarketMempool(cfg)
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{nonce: 0, priority: 10, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(30), testTx{nonce: 0, priority: 30, address: sb}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(20), testTx{nonce: 0, priority: 20, address: sc}))
+
+	// replacing sa's tx raises the priority of its chain above sc's, which is
+	// then the lowest
+	require.NoError(t, mp.Insert(ctx.WithPriority(40), testTx{id: 1, nonce: 0, priority: 40, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(25), testTx{nonce: 1, priority: 25, address: sb}))
+
+	// sb's chain priority is now 25, sc's chain was evicted
+	var senders []string
+	mp.WalkTxs(func(info mempool.TxInfo) bool {
+		senders = append(senders, info.Sender)
+		return true
+	})
+	require.NotContains(t, senders, sc.String())
+	require.Equal(t, 3, mp.CountTx())
+
+	// removing sb's tail raises the priority of its chain back to 30
+	require.NoError(t, mp.Remove(testTx{nonce: 1, priority: 25, address: sb}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(35), testTx{nonce: 0, priority: 35, address: sc}))
+	err := mp.Insert(ctx.WithPriority(30), testTx{nonce: 1, priority: 30, address: sc})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+}
+
+func TestFeeMarketMempool_ChainPriorityCache(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	cfg := mempool.DefaultFeeMarketMempoolConfig()
+	cfg.MaxTx = 3
+	mp := mempool.NewFeeMarketMempool(cfg)
+
+	// sa's chain has two txs with its lowest priority, not at its tail
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{nonce: 0, priority: 10, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{nonce: 1, priority: 10, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(50), testTx{nonce: 2, priority: 50, address: sa}))
+
+	// removing one of them keeps the priority of the chain at 10, sa's tail is
+	// evicted for sb
+	require.NoError(t, mp.Remove(testTx{nonce: 1, priority: 10, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(15), testTx{nonce: 0, priority: 15, address: sb}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(12), testTx{nonce: 1, priority: 12, address: sb}))
+	require.Equal(t, 3, mp.CountTx())
+	require.ErrorIs(t, mp.Remove(testTx{nonce: 2, priority: 50, address: sa}), mempool.ErrTxNotFound)
+
+	// replacing the last one raises the priority of sa's chain above sb's
+	require.NoError(t, mp.Insert(ctx.WithPriority(20), testTx{id: 1, nonce: 0, priority: 20, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(13), testTx{nonce: 1, priority: 13, address: sa}))
+	require.ErrorIs(t, mp.Remove(testTx{nonce: 1, priority: 12, address: sb}), mempool.ErrTxNotFound)
+}
+
+func TestFeeMarketMempool_SelectRemove(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+	mp := mempool.NewFeeMarketMempool(mempool.DefaultFeeMarketMempoolConfig())
+
+	txs := []testTx{
+		{nonce: 0, priority: 30, address: sa},
+		{nonce: 1, priority: 30, address: sa},
+		{nonce: 0, priority: 20, address: sb},
+		{nonce: 1, priority: 20, address: sb},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	// removing the current tx, as PrepareProposal does for invalid txs, ends the
+	// iteration over the txs of its sender
+	var selected []sdk.Tx
+	for it := mp.Select(ctx, nil); it != nil; it = it.Next() {
+		selected = append(selected, it.Tx())
+		if len(selected) == 1 {
+			require.NoError(t, mp.Remove(it.Tx()))
+		}
+	}
+	require.Equal(t, []sdk.Tx{txs[0], txs[2], txs[3]}, selected)
+	require.Equal(t, 3, mp.CountTx())
+}
@@ -0,0 +1,137 @@
+package mempool
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/huandu/skiplist"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Inspector = (*PriorityNonceMempool[int64])(nil)
+	_ Inspector = (*SenderNonceMempool)(nil)
+	_ Inspector = (*FeeMarketMempool)(nil)
+)
+
+// Inspector is an optional interface a Mempool can implement to expose its
+// content and the changes made to it, e.g. to serve them over gRPC.
+type Inspector interface {
+	// WalkTxs calls fn for every transaction of the mempool, ordered by sender
+	// and then by nonce, until fn returns false. The mempool must not be
+	// modified from fn.
+	WalkTxs(fn func(TxInfo) bool)
+
+	// Subscribe returns a channel receiving the events of the mempool, buffered
+	// with the given size, and a function to cancel the subscription. Events are
+	// dropped for a subscriber whose buffer is full rather than blocking the
+	// mempool.
+	Subscribe(bufferSize int) (<-chan Event, func())
+}
+
+// TxInfo describes a transaction stored in a mempool.
+type TxInfo struct {
+	Tx     sdk.Tx
+	Sender string
+	Nonce  uint64
+	// Priority is the string representation of the priority of the transaction,
+	// empty for mempools which don't prioritize transactions.
+	Priority string
+}
+
+// EventType is the type of a mempool Event.
+type EventType int
+
+const (
+	// EventInsert is emitted when a transaction is inserted.
+	EventInsert EventType = iota + 1
+	// EventRemove is emitted when a transaction is removed through Remove,
+	// typically after being included in a block.
+	EventRemove
+	// EventEvict is emitted when a transaction is dropped by the mempool itself,
+	// i.e. replaced by another one with the same nonce, evicted to make room or
+	// expired.
+	EventEvict
+)
+
+// String implements the fmt.Stringer interface.
+func (t EventType) String() string {
+	switch t {
+	case EventInsert:
+		return "insert"
+	case EventRemove:
+		return "remove"
+	case EventEvict:
+		return "evict"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is a change made to a mempool.
+type Event struct {
+	Type EventType
+	Tx   TxInfo
+}
+
+// eventBroadcaster fans out the events of a mempool to its subscribers. The
+// zero value is ready to use.
+type eventBroadcaster struct {
+	mtx  sync.Mutex
+	subs map[int]chan Event
+	next int
+}
+
+func (b *eventBroadcaster) subscribe(bufferSize int) (<-chan Event, func()) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if b.subs == nil {
+		b.subs = make(map[int]chan Event)
+	}
+	id := b.next
+	b.next++
+	ch := make(chan Event, bufferSize)
+	b.subs[id] = ch
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mtx.Lock()
+			defer b.mtx.Unlock()
+			delete(b.subs, id)
+			close(ch)
+		})
+	}
+}
+
+func (b *eventBroadcaster) publish(typ EventType, info TxInfo) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for _, ch := range b.subs {
+		select {
+		case ch <- Event{Type: typ, Tx: info}:
+		default:
+		}
+	}
+}
+
+// walkSenders calls fn for every transaction of the given nonce ordered sender
+// indices, ordered by sender, until fn returns false.
+func walkSenders(senders map[string]*skiplist.SkipList, fn func(sender string, e *skiplist.Element) bool) {
+	keys := make([]string, 0, len(senders))
+	for sender := range senders {
+		keys = append(keys, sender)
+	}
+	sort.Strings(keys)
+
+	for _, sender := range keys {
+		for e := senders[sender].Front(); e != nil; e = e.Next() {
+			if !fn(sender, e) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestInspector(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+	if sa.String() > sb.String() {
+		sa, sb = sb, sa
+	}
+
+	mempools := map[string]func() mempool.Mempool{
+		"priority nonce": func() mempool.Mempool { return mempool.DefaultPriorityMempool() },
+		"sender nonce":   func() mempool.Mempool { return mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(0)) },
+		"fee market": func() mempool.Mempool {
+			return mempool.NewFeeMarketMempool(mempool.DefaultFeeMarketMempoolConfig())
+		},
+	}
+
+	for name, newMempool := range mempools {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+			mp := newMempool()
+			inspector, ok := mp.(mempool.Inspector)
+			require.True(t, ok)
+
+			events, cancel := inspector.Subscribe(10)
+
+			txs := []testTx{
+				{nonce: 1, priority: 10, address: sb},
+				{nonce: 2, priority: 30, address: sa},
+				{nonce: 1, priority: 20, address: sa},
+			}
+			for _, tx := range txs {
+				require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+			}
+
+			var walked []sdk.Tx
+			inspector.WalkTxs(func(info mempool.TxInfo) bool {
+				require.Equal(t, info.Tx.(testTx).address.String(), info.Sender)
+				require.Equal(t, info.Tx.(testTx).nonce, info.Nonce)
+				walked = append(walked, info.Tx)
+				return true
+			})
+			require.Equal(t, []sdk.Tx{txs[2], txs[1], txs[0]}, walked)
+
+			// walking stops when fn returns false
+			count := 0
+			inspector.WalkTxs(func(mempool.TxInfo) bool {
+				count++
+				return false
+			})
+			require.Equal(t, 1, count)
+
+			// a replacement evicts the replaced tx
+			replacement := testTx{id: 1, nonce: 1, priority: 100, address: sb}
+			require.NoError(t, mp.Insert(ctx.WithPriority(replacement.priority), replacement))
+			require.NoError(t, mp.Remove(txs[1]))
+
+			for _, tx := range txs {
+				event := <-events
+				require.Equal(t, mempool.EventInsert, event.Type)
+				require.Equal(t, sdk.Tx(tx), event.Tx.Tx)
+			}
+			event := <-events
+			require.Equal(t, mempool.EventEvict, event.Type)
+			require.Equal(t, sdk.Tx(txs[0]), event.Tx.Tx)
+			event = <-events
+			require.Equal(t, mempool.EventInsert, event.Type)
+			require.Equal(t, sdk.Tx(replacement), event.Tx.Tx)
+			event = <-events
+			require.Equal(t, mempool.EventRemove, event.Type)
+			require.Equal(t, sdk.Tx(txs[1]), event.Tx.Tx)
+
+			cancel()
+			_, ok = <-events
+			require.False(t, ok)
+			cancel()
+		})
+	}
+}
@@ -40,4 +40,7 @@ type Iterator interface {
 var (
 	ErrTxNotFound           = errors.New("tx not found in mempool")
 	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+
+	ErrReplacementUnderpriced = errors.New("replacement tx underpriced")
+	ErrSenderTxLimit          = errors.New("sender reached max tx limit")
 )
@@ -58,6 +58,7 @@ type (
 		senderIndices  map[string]*skiplist.SkipList
 		scores         map[txMeta[C]]txMeta[C]
 		cfg            PriorityNonceMempoolConfig[C]
+		events         eventBroadcaster
 	}
 
 	// PriorityNonceIterator defines an iterator that is used for mempool iteration
@@ -243,12 +244,13 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	// changes.
 	sk := txMeta[C]{nonce: nonce, sender: sender}
 	if oldScore, txExists := mp.scores[sk]; txExists {
-		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
+		oldTx := senderIndex.Get(key).Value.(sdk.Tx)
+		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, oldTx, tx) {
 			return fmt.Errorf(
 				"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
 				oldScore.priority,
 				priority,
-				senderIndex.Get(key).Value.(sdk.Tx),
+				oldTx,
 				tx,
 			)
 		}
@@ -260,6 +262,7 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 			weight:   oldScore.weight,
 		})
 		mp.priorityCounts[oldScore.priority]--
+		mp.events.publish(EventEvict, mp.txInfo(oldTx, sender, nonce, oldScore.priority))
 	}
 
 	mp.priorityCounts[priority]++
@@ -270,6 +273,7 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 
 	mp.scores[sk] = txMeta[C]{priority: priority}
 	mp.priorityIndex.Set(key, tx)
+	mp.events.publish(EventInsert, mp.txInfo(tx, sender, nonce, priority))
 
 	return nil
 }
@@ -456,13 +460,36 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	}
 
 	mp.priorityIndex.Remove(tk)
-	senderTxs.Remove(tk)
+	removed := senderTxs.Remove(tk)
 	delete(mp.scores, scoreKey)
 	mp.priorityCounts[score.priority]--
+	if removed != nil {
+		mp.events.publish(EventRemove, mp.txInfo(removed.Value.(sdk.Tx), sender, nonce, score.priority))
+	}
 
 	return nil
 }
 
+// WalkTxs implements the Inspector interface.
+func (mp *PriorityNonceMempool[C]) WalkTxs(fn func(TxInfo) bool) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	walkSenders(mp.senderIndices, func(sender string, e *skiplist.Element) bool {
+		key := e.Key().(txMeta[C])
+		return fn(mp.txInfo(e.Value.(sdk.Tx), sender, key.nonce, key.priority))
+	})
+}
+
+// Subscribe implements the Inspector interface.
+func (mp *PriorityNonceMempool[C]) Subscribe(bufferSize int) (<-chan Event, func()) {
+	return mp.events.subscribe(bufferSize)
+}
+
+func (mp *PriorityNonceMempool[C]) txInfo(tx sdk.Tx, sender string, nonce uint64, priority C) TxInfo {
+	return TxInfo{Tx: tx, Sender: sender, Nonce: nonce, Priority: fmt.Sprint(priority)}
+}
+
 func IsEmpty[C comparable](mempool Mempool) error {
 	mp := mempool.(*PriorityNonceMempool[C])
 	if mp.priorityIndex.Len() != 0 {
@@ -38,6 +38,7 @@ type SenderNonceMempool struct {
 	rnd        *rand.Rand
 	maxTx      int
 	existingTx map[txKey]bool
+	events     eventBroadcaster
 }
 
 type SenderNonceOptions func(*SenderNonceMempool)
@@ -146,10 +147,14 @@ func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
 		snm.senders[sender] = senderTxs
 	}
 
+	if old := senderTxs.Get(nonce); old != nil {
+		snm.events.publish(EventEvict, TxInfo{Tx: old.Value.(sdk.Tx), Sender: sender, Nonce: nonce})
+	}
 	senderTxs.Set(nonce, tx)
 
 	key := txKey{nonce: nonce, address: sender}
 	snm.existingTx[key] = true
+	snm.events.publish(EventInsert, TxInfo{Tx: tx, Sender: sender, Nonce: nonce})
 
 	return nil
 }
@@ -229,10 +234,26 @@ func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
 
 	key := txKey{nonce: nonce, address: sender}
 	delete(snm.existingTx, key)
+	snm.events.publish(EventRemove, TxInfo{Tx: res.Value.(sdk.Tx), Sender: sender, Nonce: nonce})
 
 	return nil
 }
 
+// WalkTxs implements the Inspector interface.
+func (snm *SenderNonceMempool) WalkTxs(fn func(TxInfo) bool) {
+	snm.mtx.Lock()
+	defer snm.mtx.Unlock()
+
+	walkSenders(snm.senders, func(sender string, e *skiplist.Element) bool {
+		return fn(TxInfo{Tx: e.Value.(sdk.Tx), Sender: sender, Nonce: e.Key().(uint64)})
+	})
+}
+
+// Subscribe implements the Inspector interface.
+func (snm *SenderNonceMempool) Subscribe(bufferSize int) (<-chan Event, func()) {
+	return snm.events.subscribe(bufferSize)
+}
+
 type senderNonceMempoolIterator struct {
 	rnd           *rand.Rand
 	currentTx     *skiplist.Element
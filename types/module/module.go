@@ -32,6 +32,7 @@ import (
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/maps"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	appmodulev2 "cosmossdk.io/core/appmodule/v2"
 	"cosmossdk.io/core/genesis"
@@ -110,6 +111,14 @@ type HasServices interface {
 	RegisterServices(Configurator)
 }
 
+// HasCollectionsSchema is the interface for modules exposing the schema of
+// their collections, making them queryable through the generic collections
+// query service.
+type HasCollectionsSchema interface {
+	// CollectionsSchema returns the schema of the collections of the module.
+	CollectionsSchema() collections.Schema
+}
+
 // MigrationHandler is the migration function that each module registers.
 type MigrationHandler func(sdk.Context) error
 
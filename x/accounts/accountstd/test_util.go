@@ -25,3 +25,8 @@ func NewMockContext(
 		ctx, ss, accNumber, accountAddr, sender, funds, moduleExec, moduleExecUntyped, moduleQuery,
 	), ss
 }
+
+// SetSender returns a copy of the mock account context with the given sender.
+func SetSender(ctx context.Context, sender []byte) context.Context {
+	return implementation.SetSender(ctx, sender)
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 
-	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -23,38 +22,62 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 var (
-	PubKeyPrefix   = collections.NewPrefix(0)
-	SequencePrefix = collections.NewPrefix(1)
+	// LegacyPubKeyPrefix is the prefix of the secp256k1 pubkeys of the accounts
+	// created before pubkeys of other types were supported.
+	LegacyPubKeyPrefix = collections.NewPrefix(0)
+	SequencePrefix     = collections.NewPrefix(1)
+	SessionKeysPrefix  = collections.NewPrefix(2)
+	PubKeyPrefix       = collections.NewPrefix(3)
 )
 
-func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+// NewAccount creates a base account supporting the pubkey types added by the
+// options. If no pubkey type is added, only secp256k1 pubkeys are supported.
+func NewAccount(name string, handlerMap *signing.HandlerMap, options ...Option) accountstd.AccountCreatorFunc {
 	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
-		return name, Account{
-			PubKey:          collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", codec.CollValue[secp256k1.PubKey](deps.LegacyStateCodec)),
-			Sequence:        collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
-			addrCodec:       deps.AddressCodec,
-			signingHandlers: handlerMap,
-		}, nil
+		acc := Account{
+			PubKey:           collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", codec.CollValue[codectypes.Any](deps.LegacyStateCodec)),
+			LegacyPubKey:     collections.NewItem(deps.SchemaBuilder, LegacyPubKeyPrefix, "legacy_pub_key", codec.CollValue[secp256k1.PubKey](deps.LegacyStateCodec)),
+			Sequence:         collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			SessionKeys:      collections.NewMap(deps.SchemaBuilder, SessionKeysPrefix, "session_keys", collections.BytesKey, codec.CollValue[v1.SessionKey](deps.LegacyStateCodec)),
+			addrCodec:        deps.AddressCodec,
+			hs:               deps.Environment.HeaderService,
+			supportedPubKeys: map[string]pubKeyImplementation{},
+			signingHandlers:  handlerMap,
+		}
+		applyOptions(&acc, options)
+		return name, acc, nil
 	}
 }
 
 // Account implements a base account.
 type Account struct {
-	PubKey   collections.Item[secp256k1.PubKey]
-	Sequence collections.Sequence
+	PubKey collections.Item[codectypes.Any]
+	// LegacyPubKey is the secp256k1 pubkey of an account created before pubkeys
+	// of other types were supported, it is moved to PubKey when the pubkey is
+	// set.
+	LegacyPubKey collections.Item[secp256k1.PubKey]
+	Sequence     collections.Sequence
+	// SessionKeys maps the address of a session key, derived from its pubkey,
+	// to the session key.
+	SessionKeys collections.Map[[]byte, v1.SessionKey]
 
 	addrCodec address.Codec
 	hs        header.Service
 
+	// supportedPubKeys maps the type URLs of the supported pubkey types to
+	// their implementation.
+	supportedPubKeys map[string]pubKeyImplementation
+
 	signingHandlers *signing.HandlerMap
 }
 
 func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
-	return &v1.MsgInitResponse{}, a.verifyAndSetPubKey(ctx, msg.PubKey)
+	return &v1.MsgInitResponse{}, a.SetPubKey(ctx, msg.PubKey)
 }
 
 func (a Account) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.MsgSwapPubKeyResponse, error) {
@@ -62,15 +85,39 @@ func (a Account) SwapPubKey(ctx context.Context, msg *v1.MsgSwapPubKey) (*v1.Msg
 		return nil, errors.New("unauthorized")
 	}
 
-	return &v1.MsgSwapPubKeyResponse{}, a.verifyAndSetPubKey(ctx, msg.NewPubKey)
+	return &v1.MsgSwapPubKeyResponse{}, a.SetPubKey(ctx, msg.NewPubKey)
 }
 
-func (a Account) verifyAndSetPubKey(ctx context.Context, key []byte) error {
-	_, err := dcrd_secp256k1.ParsePubKey(key)
-	if err != nil {
+// SetPubKey validates the pubkey and sets it as the pubkey of the account. It
+// does not check the sender, accounts built on top of the base account use it
+// to rotate the pubkey under their own authorization rules.
+func (a Account) SetPubKey(ctx context.Context, key *codectypes.Any) error {
+	if err := a.ValidatePubKey(key); err != nil {
 		return err
 	}
-	return a.PubKey.Set(ctx, secp256k1.PubKey{Key: key})
+	if err := a.LegacyPubKey.Remove(ctx); err != nil {
+		return err
+	}
+	return a.PubKey.Set(ctx, codectypes.Any{TypeUrl: key.TypeUrl, Value: key.Value})
+}
+
+// GetPubKey returns the pubkey of the account, reading the legacy secp256k1
+// pubkey if the account has not set its pubkey since.
+func (a Account) GetPubKey(ctx context.Context) (codectypes.Any, error) {
+	pkAny, err := a.PubKey.Get(ctx)
+	if !errors.Is(err, collections.ErrNotFound) {
+		return pkAny, err
+	}
+
+	legacyPubKey, err := a.LegacyPubKey.Get(ctx)
+	if err != nil {
+		return codectypes.Any{}, err
+	}
+	legacyAny, err := codectypes.NewAnyWithValue(&legacyPubKey)
+	if err != nil {
+		return codectypes.Any{}, err
+	}
+	return codectypes.Any{TypeUrl: legacyAny.TypeUrl, Value: legacyAny.Value}, nil
 }
 
 // Authenticate implements the authentication flow of an abstracted base account.
@@ -89,12 +136,25 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, err
 	}
 
-	gotSeq := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].Sequence
+	signerInfo := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex]
+
+	// a transaction signed by a session key sets the session key pubkey in
+	// its signer info.
+	session, sessionPubKey, err := a.signingSessionKey(ctx, signerInfo.PublicKey, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if session != nil {
+		pubKey = sessionPubKey
+		signerData.PubKey = &anypb.Any{TypeUrl: session.PubKey.TypeUrl, Value: session.PubKey.Value}
+	}
+
+	gotSeq := signerInfo.Sequence
 	if gotSeq != signerData.Sequence {
 		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
 	}
 
-	signMode, err := parseSignMode(msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].ModeInfo)
+	signMode, err := parseSignMode(signerInfo.ModeInfo)
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
 	}
@@ -110,6 +170,12 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, errors.New("signature verification failed")
 	}
 
+	if session != nil {
+		if err := a.enforceSessionKeyScope(ctx, session, msg); err != nil {
+			return nil, err
+		}
+	}
+
 	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
 }
 
@@ -122,31 +188,31 @@ func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
 }
 
 // computeSignerData will populate signer data and also increase the sequence.
-func (a Account) computeSignerData(ctx context.Context) (secp256k1.PubKey, signing.SignerData, error) {
+func (a Account) computeSignerData(ctx context.Context) (cryptotypes.PubKey, signing.SignerData, error) {
 	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 	chainID := a.hs.HeaderInfo(ctx).ChainID
 
 	wantSequence, err := a.Sequence.Next(ctx)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 
-	pk, err := a.PubKey.Get(ctx)
+	pkAny, err := a.GetPubKey(ctx)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 
-	pkAny, err := codectypes.NewAnyWithValue(&pk)
+	pk, err := a.decodePubKey(&pkAny)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 
 	accNum, err := a.getNumber(ctx, addrStr)
 	if err != nil {
-		return secp256k1.PubKey{}, signing.SignerData{}, err
+		return nil, signing.SignerData{}, err
 	}
 
 	return pk, signing.SignerData{
@@ -208,9 +274,12 @@ func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
 
 func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.AddSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.RemoveSessionKey)
 	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
 }
 
 func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QuerySessionKeys)
 }
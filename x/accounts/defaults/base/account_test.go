@@ -0,0 +1,212 @@
+package base
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+type ProtoMsg = protoiface.MessageV1
+
+type mockHeaderService struct{ time *time.Time }
+
+func (h mockHeaderService) HeaderInfo(context.Context) header.Info { return header.Info{Time: *h.time} }
+
+type addressCodec struct{}
+
+func (addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+func setup(t *testing.T, blockTime *time.Time, options ...Option) (context.Context, Account) {
+	t.Helper()
+	ctx, ss := accountstd.NewMockContext(
+		0, []byte("base"), []byte("base"), nil,
+		func(context.Context, []byte, ProtoMsg, ProtoMsg) error { return nil },
+		func(context.Context, []byte, ProtoMsg) (ProtoMsg, error) { return nil, nil },
+		func(context.Context, ProtoMsg, ProtoMsg) error { return nil },
+	)
+
+	deps := accountstd.Dependencies{
+		SchemaBuilder:    collections.NewSchemaBuilder(ss),
+		AddressCodec:     addressCodec{},
+		LegacyStateCodec: codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+	}
+	deps.Environment.HeaderService = mockHeaderService{time: blockTime}
+
+	_, acc, err := NewAccount("base", nil, options...)(deps)
+	require.NoError(t, err)
+	return ctx, acc.(Account)
+}
+
+func packPubKey(t *testing.T, pk gogoproto.Message) *codectypes.Any {
+	t.Helper()
+	pkAny, err := codectypes.NewAnyWithValue(pk)
+	require.NoError(t, err)
+	return pkAny
+}
+
+func TestPubKeyTypes(t *testing.T) {
+	r1PrivKey, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	webAuthnKey, _ := newWebAuthnKey(t, "wallet.example")
+
+	k1 := packPubKey(t, secp256k1.GenPrivKey().PubKey())
+	r1 := packPubKey(t, r1PrivKey.PubKey())
+	ed := packPubKey(t, ed25519.GenPrivKey().PubKey())
+	webAuthn := packPubKey(t, webAuthnKey)
+
+	// only secp256k1 pubkeys are supported by default
+	blockTime := time.Now()
+	ctx, acc := setup(t, &blockTime)
+	_, err = acc.Init(ctx, &v1.MsgInit{PubKey: r1})
+	require.ErrorContains(t, err, "unsupported pubkey type")
+	_, err = acc.Init(ctx, &v1.MsgInit{PubKey: k1})
+	require.NoError(t, err)
+
+	ctx, acc = setup(t, &blockTime, WithSecp256K1PubKey(), WithSecp256R1PubKey(), WithEd25519PubKey(), WithWebAuthnPubKey())
+	_, err = acc.Init(ctx, &v1.MsgInit{})
+	require.Error(t, err)
+	_, err = acc.Init(ctx, &v1.MsgInit{PubKey: k1})
+	require.NoError(t, err)
+
+	// the pubkey can be swapped to any supported type
+	for _, pk := range []*codectypes.Any{r1, ed, webAuthn, k1} {
+		_, err = acc.SwapPubKey(ctx, &v1.MsgSwapPubKey{NewPubKey: pk})
+		require.NoError(t, err)
+
+		got, err := acc.PubKey.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, pk.TypeUrl, got.TypeUrl)
+		require.Equal(t, pk.Value, got.Value)
+	}
+
+	// invalid pubkeys are rejected
+	for _, pk := range []cryptotypes.PubKey{
+		&secp256k1.PubKey{Key: []byte("invalid")},
+		&ed25519.PubKey{Key: []byte("invalid")},
+		&v1.WebAuthnPubKey{Key: []byte("invalid"), RpId: "wallet.example"},
+		&v1.WebAuthnPubKey{Key: webAuthnKey.Key},
+	} {
+		_, err = acc.SwapPubKey(ctx, &v1.MsgSwapPubKey{NewPubKey: packPubKey(t, pk)})
+		require.Error(t, err, pk.Type())
+	}
+
+	// only the account can swap its pubkey
+	_, err = acc.SwapPubKey(accountstd.SetSender(ctx, []byte("other")), &v1.MsgSwapPubKey{NewPubKey: r1})
+	require.Error(t, err)
+}
+
+func TestLegacyPubKey(t *testing.T) {
+	blockTime := time.Now()
+	ctx, acc := setup(t, &blockTime, WithSecp256K1PubKey(), WithEd25519PubKey())
+
+	// accounts created before pubkeys of other types were supported keep their
+	// secp256k1 pubkey under the legacy prefix
+	legacyPubKey := secp256k1.GenPrivKey().PubKey().(*secp256k1.PubKey)
+	require.NoError(t, acc.LegacyPubKey.Set(ctx, *legacyPubKey))
+
+	got, err := acc.GetPubKey(ctx)
+	require.NoError(t, err)
+	legacyAny := packPubKey(t, legacyPubKey)
+	require.Equal(t, legacyAny.TypeUrl, got.TypeUrl)
+	require.Equal(t, legacyAny.Value, got.Value)
+	pk, _, err := acc.computeSignerData(ctx)
+	require.NoError(t, err)
+	require.True(t, legacyPubKey.Equals(pk))
+
+	// the legacy pubkey is removed once the pubkey is swapped
+	ed := packPubKey(t, ed25519.GenPrivKey().PubKey())
+	_, err = acc.SwapPubKey(ctx, &v1.MsgSwapPubKey{NewPubKey: ed})
+	require.NoError(t, err)
+	has, err := acc.LegacyPubKey.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	got, err = acc.GetPubKey(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ed.TypeUrl, got.TypeUrl)
+	require.Equal(t, ed.Value, got.Value)
+}
+
+func newWebAuthnKey(t *testing.T, rpID string) (*v1.WebAuthnPubKey, *ecdsa.PrivateKey) {
+	t.Helper()
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return &v1.WebAuthnPubKey{Key: elliptic.MarshalCompressed(elliptic.P256(), privKey.X, privKey.Y), RpId: rpID}, privKey
+}
+
+// webAuthnAssertion makes a WebAuthn assertion over the sign bytes, as made by
+// an authenticator.
+func webAuthnAssertion(t *testing.T, privKey *ecdsa.PrivateKey, rpID, clientDataType string, flags byte, signBytes []byte) []byte {
+	t.Helper()
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	authData := append(append(rpIDHash[:], flags), 0, 0, 0, 1)
+
+	challenge := sha256.Sum256(signBytes)
+	clientDataJSON := []byte(fmt.Sprintf(`{"type":%q,"challenge":%q,"origin":"https://%s"}`,
+		clientDataType, base64.RawURLEncoding.EncodeToString(challenge[:]), rpID))
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signedHash := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, privKey, signedHash[:])
+	require.NoError(t, err)
+
+	bz, err := (&v1.WebAuthnSignature{AuthenticatorData: authData, ClientDataJson: clientDataJSON, Signature: sig}).Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestWebAuthnVerifySignature(t *testing.T) {
+	pubKey, privKey := newWebAuthnKey(t, "wallet.example")
+	_, otherPrivKey := newWebAuthnKey(t, "wallet.example")
+	signBytes := []byte("sign bytes")
+
+	testCases := []struct {
+		name  string
+		sig   []byte
+		valid bool
+	}{
+		{"valid assertion", webAuthnAssertion(t, privKey, "wallet.example", "webauthn.get", 0x05, signBytes), true},
+		{"other sign bytes", webAuthnAssertion(t, privKey, "wallet.example", "webauthn.get", 0x05, []byte("other")), false},
+		{"other rp id", webAuthnAssertion(t, privKey, "other.example", "webauthn.get", 0x05, signBytes), false},
+		{"user not present", webAuthnAssertion(t, privKey, "wallet.example", "webauthn.get", 0x04, signBytes), false},
+		{"registration client data", webAuthnAssertion(t, privKey, "wallet.example", "webauthn.create", 0x05, signBytes), false},
+		{"other key", webAuthnAssertion(t, otherPrivKey, "wallet.example", "webauthn.get", 0x05, signBytes), false},
+		{"not an assertion", []byte("invalid"), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.valid, pubKey.VerifySignature(signBytes, tc.sig))
+		})
+	}
+
+	// keys which are not scoped to a relying party are invalid
+	noRPKey := &v1.WebAuthnPubKey{Key: pubKey.Key}
+	require.Error(t, noRPKey.Validate())
+	require.False(t, noRPKey.VerifySignature(signBytes, webAuthnAssertion(t, privKey, "", "webauthn.get", 0x01, signBytes)))
+	require.False(t, noRPKey.Equals(pubKey))
+	require.Equal(t, "webauthn", pubKey.Type())
+	require.Len(t, pubKey.Address(), 32)
+}
@@ -0,0 +1,128 @@
+package base
+
+import (
+	"errors"
+	"fmt"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// Option configures a base account.
+type Option func(a *Account)
+
+// pubKeyImpl is the constraint of the pubkey types supported by a base account.
+type pubKeyImpl[T any] interface {
+	*T
+	cryptotypes.PubKey
+}
+
+// pubKeyImplementation decodes and validates a supported pubkey type.
+type pubKeyImplementation struct {
+	decode func(bz []byte) (cryptotypes.PubKey, error)
+}
+
+// WithPubKey adds the pubkey type to the pubkey types supported by the account.
+func WithPubKey[T any, PT pubKeyImpl[T]]() Option {
+	return WithPubKeyWithValidationFunc[T, PT](func(PT) error { return nil })
+}
+
+// WithPubKeyWithValidationFunc adds the pubkey type to the pubkey types
+// supported by the account, the pubkeys are validated with the given function
+// when set.
+func WithPubKeyWithValidationFunc[T any, PT pubKeyImpl[T]](validateFn func(PT) error) Option {
+	impl := pubKeyImplementation{
+		decode: func(bz []byte) (cryptotypes.PubKey, error) {
+			pk := PT(new(T))
+			if err := gogoproto.Unmarshal(bz, pk); err != nil {
+				return nil, err
+			}
+			if err := validateFn(pk); err != nil {
+				return nil, err
+			}
+			return pk, nil
+		},
+	}
+	return func(a *Account) {
+		a.supportedPubKeys[codectypes.MsgTypeURL(PT(new(T)))] = impl
+	}
+}
+
+// WithSecp256K1PubKey adds secp256k1 pubkeys to the pubkey types supported by
+// the account.
+func WithSecp256K1PubKey() Option {
+	return WithPubKeyWithValidationFunc(func(pk *secp256k1.PubKey) error {
+		_, err := dcrd_secp256k1.ParsePubKey(pk.Key)
+		return err
+	})
+}
+
+// WithSecp256R1PubKey adds secp256r1 pubkeys to the pubkey types supported by
+// the account.
+func WithSecp256R1PubKey() Option {
+	// secp256r1 pubkeys are validated when unmarshalled
+	return WithPubKey[secp256r1.PubKey]()
+}
+
+// WithEd25519PubKey adds ed25519 pubkeys to the pubkey types supported by the
+// account.
+func WithEd25519PubKey() Option {
+	return WithPubKeyWithValidationFunc(func(pk *ed25519.PubKey) error {
+		if len(pk.Key) != ed25519.PubKeySize {
+			return fmt.Errorf("invalid ed25519 pubkey size: %d", len(pk.Key))
+		}
+		return nil
+	})
+}
+
+// WithWebAuthnPubKey adds WebAuthn pubkeys, such as passkeys, to the pubkey
+// types supported by the account.
+func WithWebAuthnPubKey() Option {
+	return WithPubKeyWithValidationFunc((*v1.WebAuthnPubKey).Validate)
+}
+
+// applyOptions applies the options to the account, only secp256k1 pubkeys are
+// supported if no pubkey type is added.
+func applyOptions(a *Account, options []Option) {
+	if len(options) == 0 {
+		options = []Option{WithSecp256K1PubKey()}
+	}
+	for _, option := range options {
+		option(a)
+	}
+}
+
+// NewPubKeyDecoder returns a function decoding and validating the pubkeys of the
+// types added by the options, with the same defaults as NewAccount. It allows
+// other accounts to support the same pubkey types as the base account.
+func NewPubKeyDecoder(options ...Option) func(pkAny *codectypes.Any) (cryptotypes.PubKey, error) {
+	acc := Account{supportedPubKeys: map[string]pubKeyImplementation{}}
+	applyOptions(&acc, options)
+	return acc.decodePubKey
+}
+
+// ValidatePubKey checks that the pubkey is a valid pubkey of a supported type.
+func (a Account) ValidatePubKey(pkAny *codectypes.Any) error {
+	_, err := a.decodePubKey(pkAny)
+	return err
+}
+
+// decodePubKey decodes the pubkey, which must be of a supported type.
+func (a Account) decodePubKey(pkAny *codectypes.Any) (cryptotypes.PubKey, error) {
+	if pkAny == nil {
+		return nil, errors.New("pubkey must be set")
+	}
+	impl, ok := a.supportedPubKeys[pkAny.TypeUrl]
+	if !ok {
+		return nil, fmt.Errorf("unsupported pubkey type: %s", pkAny.TypeUrl)
+	}
+	return impl.decode(pkAny.Value)
+}
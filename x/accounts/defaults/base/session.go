@@ -0,0 +1,371 @@
+package base
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	accountsv1 "cosmossdk.io/api/cosmos/accounts/v1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	msgSendTypeURL      = "/" + string(proto.MessageName(&bankv1beta1.MsgSend{}))
+	msgMultiSendTypeURL = "/" + string(proto.MessageName(&bankv1beta1.MsgMultiSend{}))
+	msgExecuteTypeURL   = "/" + string(proto.MessageName(&accountsv1.MsgExecute{}))
+
+	anyFullName = (&anypb.Any{}).ProtoReflect().Descriptor().FullName()
+)
+
+// AddSessionKey adds a session key to the account, or replaces the session
+// key with the same pubkey.
+func (a Account) AddSessionKey(ctx context.Context, msg *v1.MsgAddSessionKey) (*v1.MsgAddSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	session := msg.SessionKey
+	if session == nil {
+		return nil, errors.New("session key must be set")
+	}
+	pubKey, err := a.decodePubKey(session.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	if !session.Expiry.After(a.hs.HeaderInfo(ctx).Time) {
+		return nil, errors.New("session key expiry must be in the future")
+	}
+	if len(session.AllowedMessages) == 0 {
+		return nil, errors.New("session key must allow at least one message type")
+	}
+	if err := session.SpendLimits.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid session key spend limits: %w", err)
+	}
+	for _, typeURL := range session.AllowedMessages {
+		if err := checkSessionMessageType(session, typeURL); err != nil {
+			return nil, err
+		}
+	}
+	if err := a.checkExecuteTargets(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgAddSessionKeyResponse{}, a.SessionKeys.Set(ctx, pubKey.Address(), *session)
+}
+
+// RemoveSessionKey removes a session key from the account.
+func (a Account) RemoveSessionKey(ctx context.Context, msg *v1.MsgRemoveSessionKey) (*v1.MsgRemoveSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	pubKey, err := a.decodePubKey(msg.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	has, err := a.SessionKeys.Has(ctx, pubKey.Address())
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errors.New("session key not found")
+	}
+
+	return &v1.MsgRemoveSessionKeyResponse{}, a.SessionKeys.Remove(ctx, pubKey.Address())
+}
+
+// signingSessionKey returns the session key whose pubkey is set in the signer
+// info of the account, if any. A transaction signed by the pubkey of the
+// account, or without pubkey in its signer info, is not signed by a session key.
+func (a Account) signingSessionKey(ctx context.Context, signerPubKey *codectypes.Any, accountPubKey cryptotypes.PubKey) (*v1.SessionKey, cryptotypes.PubKey, error) {
+	if signerPubKey == nil {
+		return nil, nil, nil
+	}
+	pubKey, err := a.decodePubKey(signerPubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pubKey.Equals(accountPubKey) {
+		return nil, nil, nil
+	}
+
+	session, err := a.SessionKeys.Get(ctx, pubKey.Address())
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return nil, nil, errors.New("signer pubkey is neither the account pubkey nor a session key")
+	case err != nil:
+		return nil, nil, err
+	}
+	if session.PubKey.TypeUrl != signerPubKey.TypeUrl || !bytes.Equal(session.PubKey.Value, signerPubKey.Value) {
+		return nil, nil, errors.New("signer pubkey does not match the session key")
+	}
+	return &session, pubKey, nil
+}
+
+// enforceSessionKeyScope checks that the transaction is within the scope of
+// the session key which signed it, and deducts the coins it spends from the
+// spend limits of the session key, if any.
+func (a Account) enforceSessionKeyScope(ctx context.Context, session *v1.SessionKey, msg *aa_interface_v1.MsgAuthenticate) error {
+	if !a.hs.HeaderInfo(ctx).Time.Before(session.Expiry) {
+		return errors.New("session key expired")
+	}
+
+	for _, m := range msg.Tx.Body.Messages {
+		if !slices.Contains(session.AllowedMessages, m.TypeUrl) {
+			return fmt.Errorf("message %s is not allowed for the session key", m.TypeUrl)
+		}
+		if err := checkSessionMessageType(session, m.TypeUrl); err != nil {
+			return err
+		}
+		if err := a.checkExecuteTarget(ctx, session, m); err != nil {
+			return err
+		}
+	}
+	if len(session.SpendLimits) == 0 {
+		return nil
+	}
+
+	spent, err := a.spentCoins(ctx, msg)
+	if err != nil {
+		return err
+	}
+	remaining, hasNeg := session.SpendLimits.SafeSub(spent...)
+	if hasNeg {
+		return fmt.Errorf("spend limit of the session key exceeded: spending %s, limit %s", spent, session.SpendLimits)
+	}
+	if spent.IsZero() {
+		return nil
+	}
+
+	session.SpendLimits = remaining
+	pubKey, err := a.decodePubKey(session.PubKey)
+	if err != nil {
+		return err
+	}
+	return a.SessionKeys.Set(ctx, pubKey.Address(), *session)
+}
+
+// checkSessionMessageType checks that messages of the given type can be sent
+// by the session key:
+//   - messages carrying other messages or authorizations, such as an authz
+//     MsgExec or MsgGrant, would let the session key act beyond its scope. The
+//     only exception is the accounts MsgExecute, whose target must be allowed.
+//   - if the session key has spend limits, the coins spent by the message must
+//     be computable, i.e. the message must be a bank MsgSend or MsgMultiSend or
+//     an accounts MsgExecute.
+func checkSessionMessageType(session *v1.SessionKey, typeURL string) error {
+	if len(session.SpendLimits) != 0 && typeURL != msgSendTypeURL && typeURL != msgMultiSendTypeURL && typeURL != msgExecuteTypeURL {
+		return fmt.Errorf("message %s is not allowed for a session key with spend limits: its spend cannot be computed", typeURL)
+	}
+	if typeURL == msgExecuteTypeURL {
+		return nil
+	}
+
+	wraps, err := wrapsMessages(typeURL)
+	if err != nil {
+		return err
+	}
+	if wraps {
+		return fmt.Errorf("message %s is not allowed for session keys: it wraps other messages", typeURL)
+	}
+	return nil
+}
+
+// checkExecuteTargets checks that the session key can execute messages on
+// other accounts only if it allows MsgExecute, and not on the account itself,
+// which would let it manage the session keys or the pubkey of the account.
+func (a Account) checkExecuteTargets(ctx context.Context, session *v1.SessionKey) error {
+	allowsExecute := slices.Contains(session.AllowedMessages, msgExecuteTypeURL)
+	switch {
+	case allowsExecute && len(session.AllowedExecuteTargets) == 0:
+		return errors.New("session key allowing MsgExecute must allow at least one execute target")
+	case !allowsExecute && len(session.AllowedExecuteTargets) != 0:
+		return errors.New("session key execute targets require MsgExecute to be allowed")
+	}
+
+	for _, target := range session.AllowedExecuteTargets {
+		targetBytes, err := a.addrCodec.StringToBytes(target)
+		if err != nil {
+			return fmt.Errorf("invalid session key execute target %s: %w", target, err)
+		}
+		if bytes.Equal(targetBytes, accountstd.Whoami(ctx)) {
+			return errors.New("session keys cannot execute messages on their own account")
+		}
+	}
+	return nil
+}
+
+// checkExecuteTarget checks that the message, if it is an accounts MsgExecute,
+// targets one of the execute targets allowed for the session key.
+func (a Account) checkExecuteTarget(ctx context.Context, session *v1.SessionKey, m *codectypes.Any) error {
+	if m.TypeUrl != msgExecuteTypeURL {
+		return nil
+	}
+
+	execute := new(accountsv1.MsgExecute)
+	if err := proto.Unmarshal(m.Value, execute); err != nil {
+		return err
+	}
+	target, err := a.addrCodec.StringToBytes(execute.Target)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(target, accountstd.Whoami(ctx)) {
+		return errors.New("session keys cannot execute messages on their own account")
+	}
+	for _, allowed := range session.AllowedExecuteTargets {
+		allowedBytes, err := a.addrCodec.StringToBytes(allowed)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(target, allowedBytes) {
+			return nil
+		}
+	}
+	return fmt.Errorf("execute target %s is not allowed for the session key", execute.Target)
+}
+
+// wrapsMessages returns true if the messages of the given type can carry other
+// messages, i.e. if they contain a google.protobuf.Any.
+func wrapsMessages(typeURL string) (bool, error) {
+	name := protoreflect.FullName(typeURL[strings.LastIndex(typeURL, "/")+1:])
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(name)
+	if err != nil {
+		return false, fmt.Errorf("unknown message type %s: %w", typeURL, err)
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return false, fmt.Errorf("%s is not a message type", typeURL)
+	}
+	return containsAny(md, make(map[protoreflect.FullName]bool)), nil
+}
+
+func containsAny(md protoreflect.MessageDescriptor, visited map[protoreflect.FullName]bool) bool {
+	if md.FullName() == anyFullName {
+		return true
+	}
+	if visited[md.FullName()] {
+		return false
+	}
+	visited[md.FullName()] = true
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if field := fields.Get(i).Message(); field != nil && containsAny(field, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// spentCoins returns the coins spent by the account in the transaction: the
+// fees it pays, the coins it sends with the bank module and the funds it sends
+// with MsgExecute. It fails for the other messages, whose spend is unknown.
+func (a Account) spentCoins(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (sdk.Coins, error) {
+	addr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	spent := sdk.NewCoins()
+	if fee := msg.Tx.AuthInfo.Fee; fee != nil && fee.Granter == "" &&
+		(fee.Payer == addr || (fee.Payer == "" && msg.SignerIndex == 0)) {
+		spent = spent.Add(fee.Amount...)
+	}
+
+	for _, m := range msg.Tx.Body.Messages {
+		switch m.TypeUrl {
+		case msgSendTypeURL:
+			send := new(bankv1beta1.MsgSend)
+			if err := proto.Unmarshal(m.Value, send); err != nil {
+				return nil, err
+			}
+			if send.FromAddress != addr {
+				continue
+			}
+			amount, err := toCoins(send.Amount)
+			if err != nil {
+				return nil, err
+			}
+			spent = spent.Add(amount...)
+		case msgMultiSendTypeURL:
+			multiSend := new(bankv1beta1.MsgMultiSend)
+			if err := proto.Unmarshal(m.Value, multiSend); err != nil {
+				return nil, err
+			}
+			for _, input := range multiSend.Inputs {
+				if input.Address != addr {
+					continue
+				}
+				amount, err := toCoins(input.Coins)
+				if err != nil {
+					return nil, err
+				}
+				spent = spent.Add(amount...)
+			}
+		case msgExecuteTypeURL:
+			execute := new(accountsv1.MsgExecute)
+			if err := proto.Unmarshal(m.Value, execute); err != nil {
+				return nil, err
+			}
+			if execute.Sender != addr {
+				continue
+			}
+			funds, err := toCoins(execute.Funds)
+			if err != nil {
+				return nil, err
+			}
+			spent = spent.Add(funds...)
+		default:
+			return nil, fmt.Errorf("message %s is not allowed for a session key with spend limits: its spend cannot be computed", m.TypeUrl)
+		}
+	}
+	return spent, nil
+}
+
+func toCoins(coins []*basev1beta1.Coin) (sdk.Coins, error) {
+	res := make(sdk.Coins, 0, len(coins))
+	for _, coin := range coins {
+		amount, ok := math.NewIntFromString(coin.Amount)
+		if !ok {
+			return nil, fmt.Errorf("invalid coin amount: %s", coin.Amount)
+		}
+		res = res.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return res, nil
+}
+
+// QuerySessionKeys returns the session keys of the account which have not expired.
+func (a Account) QuerySessionKeys(ctx context.Context, _ *v1.QuerySessionKeys) (*v1.QuerySessionKeysResponse, error) {
+	now := a.hs.HeaderInfo(ctx).Time
+
+	var sessions []v1.SessionKey
+	err := a.SessionKeys.Walk(ctx, nil, func(_ []byte, session v1.SessionKey) (bool, error) {
+		if now.Before(session.Expiry) {
+			sessions = append(sessions, session)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySessionKeysResponse{SessionKeys: sessions}, nil
+}
@@ -0,0 +1,258 @@
+package base
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	accountsv1 "cosmossdk.io/api/cosmos/accounts/v1"
+	authzv1beta1 "cosmossdk.io/api/cosmos/authz/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func sendMsg(t *testing.T, from string, amount int64) *codectypes.Any {
+	t.Helper()
+	bz, err := proto.Marshal(&bankv1beta1.MsgSend{
+		FromAddress: from,
+		ToAddress:   "recipient",
+		Amount:      []*basev1beta1.Coin{{Denom: "stake", Amount: math.NewInt(amount).String()}},
+	})
+	require.NoError(t, err)
+	return &codectypes.Any{TypeUrl: msgSendTypeURL, Value: bz}
+}
+
+func executeMsg(t *testing.T, target string, funds ...*basev1beta1.Coin) *codectypes.Any {
+	t.Helper()
+	bz, err := proto.Marshal(&accountsv1.MsgExecute{Sender: "base", Target: target, Funds: funds})
+	require.NoError(t, err)
+	return &codectypes.Any{TypeUrl: msgExecuteTypeURL, Value: bz}
+}
+
+func authenticateMsg(fee sdk.Coins, msgs ...*codectypes.Any) *aa_interface_v1.MsgAuthenticate {
+	return &aa_interface_v1.MsgAuthenticate{
+		Tx: &tx.Tx{
+			Body:     &tx.TxBody{Messages: msgs},
+			AuthInfo: &tx.AuthInfo{Fee: &tx.Fee{Amount: fee}},
+		},
+	}
+}
+
+func TestSessionKeys(t *testing.T) {
+	blockTime := time.Now()
+	ctx, acc := setup(t, &blockTime)
+	accountPubKey := secp256k1.GenPrivKey().PubKey()
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: packPubKey(t, accountPubKey)})
+	require.NoError(t, err)
+
+	sessionPubKey := packPubKey(t, secp256k1.GenPrivKey().PubKey())
+	session := &v1.SessionKey{
+		PubKey:          sessionPubKey,
+		Expiry:          blockTime.Add(time.Hour),
+		AllowedMessages: []string{msgSendTypeURL},
+		SpendLimits:     sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}
+
+	// only the account can add session keys
+	_, err = acc.AddSessionKey(accountstd.SetSender(ctx, []byte("other")), &v1.MsgAddSessionKey{SessionKey: session})
+	require.ErrorContains(t, err, "unauthorized")
+
+	// invalid session keys are rejected
+	for _, invalid := range []*v1.SessionKey{
+		{PubKey: sessionPubKey, Expiry: blockTime, AllowedMessages: session.AllowedMessages},
+		{PubKey: sessionPubKey, Expiry: session.Expiry},
+		{PubKey: packPubKey(t, &secp256k1.PubKey{Key: []byte("invalid")}), Expiry: session.Expiry, AllowedMessages: session.AllowedMessages},
+		{PubKey: sessionPubKey, Expiry: session.Expiry, AllowedMessages: session.AllowedMessages, SpendLimits: sdk.Coins{{Denom: "stake", Amount: math.NewInt(-1)}}},
+	} {
+		_, err = acc.AddSessionKey(ctx, &v1.MsgAddSessionKey{SessionKey: invalid})
+		require.Error(t, err)
+	}
+
+	_, err = acc.AddSessionKey(ctx, &v1.MsgAddSessionKey{SessionKey: session})
+	require.NoError(t, err)
+
+	resp, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Len(t, resp.SessionKeys, 1)
+
+	// the signer pubkey selects the session key
+	got, _, err := acc.signingSessionKey(ctx, sessionPubKey, accountPubKey)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	got, _, err = acc.signingSessionKey(ctx, packPubKey(t, accountPubKey), accountPubKey)
+	require.NoError(t, err)
+	require.Nil(t, got)
+	got, _, err = acc.signingSessionKey(ctx, nil, accountPubKey)
+	require.NoError(t, err)
+	require.Nil(t, got)
+	_, _, err = acc.signingSessionKey(ctx, packPubKey(t, secp256k1.GenPrivKey().PubKey()), accountPubKey)
+	require.Error(t, err)
+
+	// the fees and the sends are deducted from the spend limits
+	scope := func() *v1.SessionKey {
+		session, _, err := acc.signingSessionKey(ctx, sessionPubKey, accountPubKey)
+		require.NoError(t, err)
+		return session
+	}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	require.NoError(t, acc.enforceSessionKeyScope(ctx, scope(), authenticateMsg(fee, sendMsg(t, "base", 50))))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), scope().SpendLimits)
+
+	// sends from other accounts are not deducted
+	require.NoError(t, acc.enforceSessionKeyScope(ctx, scope(), authenticateMsg(nil, sendMsg(t, "other", 50))))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), scope().SpendLimits)
+
+	err = acc.enforceSessionKeyScope(ctx, scope(), authenticateMsg(fee, sendMsg(t, "base", 31)))
+	require.ErrorContains(t, err, "spend limit")
+	err = acc.enforceSessionKeyScope(ctx, scope(), authenticateMsg(sdk.NewCoins(sdk.NewInt64Coin("atom", 1))))
+	require.ErrorContains(t, err, "spend limit")
+
+	// only the allowed messages can be sent
+	err = acc.enforceSessionKeyScope(ctx, scope(), authenticateMsg(nil, &codectypes.Any{TypeUrl: "/cosmos.bank.v1beta1.MsgBurn"}))
+	require.ErrorContains(t, err, "not allowed")
+
+	// expired session keys can't be used and are not listed
+	blockTime = blockTime.Add(time.Hour)
+	err = acc.enforceSessionKeyScope(ctx, scope(), authenticateMsg(nil, sendMsg(t, "base", 1)))
+	require.ErrorContains(t, err, "expired")
+	resp, err = acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Empty(t, resp.SessionKeys)
+
+	_, err = acc.RemoveSessionKey(ctx, &v1.MsgRemoveSessionKey{PubKey: sessionPubKey})
+	require.NoError(t, err)
+	_, err = acc.RemoveSessionKey(ctx, &v1.MsgRemoveSessionKey{PubKey: sessionPubKey})
+	require.ErrorContains(t, err, "not found")
+	_, _, err = acc.signingSessionKey(ctx, sessionPubKey, accountPubKey)
+	require.Error(t, err)
+}
+
+func TestSessionKeyMessageTypes(t *testing.T) {
+	blockTime := time.Now()
+	ctx, acc := setup(t, &blockTime)
+	accountPubKey := secp256k1.GenPrivKey().PubKey()
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: packPubKey(t, accountPubKey)})
+	require.NoError(t, err)
+
+	msgExecTypeURL := "/" + string(proto.MessageName(&authzv1beta1.MsgExec{}))
+	msgGrantTypeURL := "/" + string(proto.MessageName(&authzv1beta1.MsgGrant{}))
+	msgDelegateTypeURL := "/" + string(proto.MessageName(&stakingv1beta1.MsgDelegate{}))
+	newSession := func(spendLimits sdk.Coins, allowedMessages ...string) *v1.SessionKey {
+		session := &v1.SessionKey{
+			PubKey:          packPubKey(t, secp256k1.GenPrivKey().PubKey()),
+			Expiry:          blockTime.Add(time.Hour),
+			AllowedMessages: allowedMessages,
+			SpendLimits:     spendLimits,
+		}
+		if slices.Contains(allowedMessages, msgExecuteTypeURL) {
+			session.AllowedExecuteTargets = []string{"other"}
+		}
+		return session
+	}
+	withTargets := func(session *v1.SessionKey, targets ...string) *v1.SessionKey {
+		session.AllowedExecuteTargets = targets
+		return session
+	}
+	limits := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	testCases := []struct {
+		name    string
+		session *v1.SessionKey
+		expErr  string
+	}{
+		{"wrapping messages", newSession(nil, msgSendTypeURL, msgExecTypeURL), "wraps other messages"},
+		{"wrapping authorizations", newSession(nil, msgGrantTypeURL), "wraps other messages"},
+		{"unknown message", newSession(nil, "/cosmos.unknown.v1.MsgUnknown"), "unknown message type"},
+		{"spend not computable", newSession(limits, msgSendTypeURL, msgDelegateTypeURL), "spend cannot be computed"},
+		{"account execute without targets", withTargets(newSession(nil, msgExecuteTypeURL)), "at least one execute target"},
+		{"execute targets without account execute", withTargets(newSession(nil, msgSendTypeURL), "other"), "require MsgExecute"},
+		{"execute on own account", withTargets(newSession(nil, msgExecuteTypeURL), "other", "base"), "own account"},
+		{"account execute", newSession(nil, msgSendTypeURL, msgExecuteTypeURL), ""},
+		{"account execute with spend limits", newSession(limits, msgExecuteTypeURL), ""},
+		{"bank sends with spend limits", newSession(limits, msgSendTypeURL, msgMultiSendTypeURL), ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := acc.AddSessionKey(ctx, &v1.MsgAddSessionKey{SessionKey: tc.session})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// the message types are checked again when the session key is used
+	wrapping := newSession(nil, msgExecTypeURL)
+	require.NoError(t, acc.SessionKeys.Set(ctx, []byte("wrapping"), *wrapping))
+	err = acc.enforceSessionKeyScope(ctx, wrapping, authenticateMsg(nil, &codectypes.Any{TypeUrl: msgExecTypeURL}))
+	require.ErrorContains(t, err, "wraps other messages")
+
+	uncomputable := newSession(limits, msgDelegateTypeURL)
+	err = acc.enforceSessionKeyScope(ctx, uncomputable, authenticateMsg(nil, &codectypes.Any{TypeUrl: msgDelegateTypeURL}))
+	require.ErrorContains(t, err, "spend cannot be computed")
+}
+
+func TestSessionKeyExecuteTargets(t *testing.T) {
+	blockTime := time.Now()
+	ctx, acc := setup(t, &blockTime)
+	accountPubKey := secp256k1.GenPrivKey().PubKey()
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: packPubKey(t, accountPubKey)})
+	require.NoError(t, err)
+
+	sessionPubKey := packPubKey(t, secp256k1.GenPrivKey().PubKey())
+	session := &v1.SessionKey{
+		PubKey:                sessionPubKey,
+		Expiry:                blockTime.Add(time.Hour),
+		AllowedMessages:       []string{msgExecuteTypeURL},
+		SpendLimits:           sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		AllowedExecuteTargets: []string{"other"},
+	}
+	_, err = acc.AddSessionKey(ctx, &v1.MsgAddSessionKey{SessionKey: session})
+	require.NoError(t, err)
+	scope := func() *v1.SessionKey {
+		session, _, err := acc.signingSessionKey(ctx, sessionPubKey, accountPubKey)
+		require.NoError(t, err)
+		return session
+	}
+
+	// the session key can only execute messages on the allowed targets, and
+	// never on its own account, e.g. to add a session key without scope or swap
+	// the pubkey
+	require.NoError(t, acc.enforceSessionKeyScope(ctx, scope(), authenticateMsg(nil, executeMsg(t, "other"))))
+	err = acc.enforceSessionKeyScope(ctx, scope(), authenticateMsg(nil, executeMsg(t, "other"), executeMsg(t, "another")))
+	require.ErrorContains(t, err, "not allowed")
+	err = acc.enforceSessionKeyScope(ctx, scope(), authenticateMsg(nil, executeMsg(t, "other"), executeMsg(t, "base")))
+	require.ErrorContains(t, err, "own account")
+
+	// the funds sent with the executions are deducted from the spend limits
+	funds := &basev1beta1.Coin{Denom: "stake", Amount: "60"}
+	require.NoError(t, acc.enforceSessionKeyScope(ctx, scope(), authenticateMsg(nil, executeMsg(t, "other", funds))))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), scope().SpendLimits)
+	err = acc.enforceSessionKeyScope(ctx, scope(), authenticateMsg(nil, executeMsg(t, "other", funds)))
+	require.ErrorContains(t, err, "spend limit")
+}
+
+func TestSessionKeySpendFailsClosed(t *testing.T) {
+	blockTime := time.Now()
+	ctx, acc := setup(t, &blockTime)
+
+	// the spend of the messages other than the bank sends and the executions is
+	// unknown, they are never counted as spending nothing
+	msgDelegate := &codectypes.Any{TypeUrl: "/" + string(proto.MessageName(&stakingv1beta1.MsgDelegate{}))}
+	_, err := acc.spentCoins(ctx, authenticateMsg(nil, sendMsg(t, "base", 1), msgDelegate))
+	require.ErrorContains(t, err, "spend cannot be computed")
+}
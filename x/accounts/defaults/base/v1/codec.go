@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"cosmossdk.io/core/registry"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// RegisterInterfaces registers the pubkey types defined by the base account, so
+// that the signer infos of the txs signed by base accounts can be decoded.
+func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
+	registrar.RegisterImplementations((*cryptotypes.PubKey)(nil), &WebAuthnPubKey{})
+}
@@ -0,0 +1,132 @@
+package v1
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// webAuthnKeyType is the type of the WebAuthn pubkeys.
+	webAuthnKeyType = "webauthn"
+	// webAuthnGetType is the type of the client data of the assertions.
+	webAuthnGetType = "webauthn.get"
+	// authenticatorDataMinLen is the length of the rp ID hash, the flags and
+	// the signature counter of the authenticator data.
+	authenticatorDataMinLen = 37
+	// flagUserPresent is the flag of the authenticator data set when the user
+	// was present during the assertion.
+	flagUserPresent = 0x01
+)
+
+var _ cryptotypes.PubKey = (*WebAuthnPubKey)(nil)
+
+// clientData contains the fields of the client data of an assertion which
+// are verified.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+}
+
+// Validate checks that the key is a valid compressed secp256r1 pubkey and that
+// the key is scoped to a relying party.
+func (m *WebAuthnPubKey) Validate() error {
+	if m.RpId == "" {
+		return errors.New("rp ID must be set")
+	}
+	_, err := m.ecdsaKey()
+	return err
+}
+
+func (m *WebAuthnPubKey) ecdsaKey() (*ecdsa.PublicKey, error) {
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), m.Key)
+	if x == nil {
+		return nil, errors.New("invalid compressed secp256r1 pubkey")
+	}
+	return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+}
+
+// Address implements the cryptotypes.PubKey interface.
+func (m *WebAuthnPubKey) Address() cmtcrypto.Address {
+	return address.Hash(gogoproto.MessageName(m), m.Key)
+}
+
+// Bytes implements the cryptotypes.PubKey interface.
+func (m *WebAuthnPubKey) Bytes() []byte {
+	if m == nil {
+		return nil
+	}
+	return m.Key
+}
+
+// Equals implements the cryptotypes.PubKey interface.
+func (m *WebAuthnPubKey) Equals(other cryptotypes.PubKey) bool {
+	pk, ok := other.(*WebAuthnPubKey)
+	return ok && bytes.Equal(m.Key, pk.Key) && m.RpId == pk.RpId
+}
+
+// Type implements the cryptotypes.PubKey interface.
+func (m *WebAuthnPubKey) Type() string {
+	return webAuthnKeyType
+}
+
+// VerifySignature implements the cryptotypes.PubKey interface. The signature
+// must be an encoded WebAuthnSignature whose client data challenge is the hash
+// of msg.
+func (m *WebAuthnPubKey) VerifySignature(msg, sig []byte) bool {
+	var webAuthnSig WebAuthnSignature
+	if err := webAuthnSig.Unmarshal(sig); err != nil {
+		return false
+	}
+	return m.verify(msg, &webAuthnSig) == nil
+}
+
+func (m *WebAuthnPubKey) verify(msg []byte, sig *WebAuthnSignature) error {
+	pubKey, err := m.ecdsaKey()
+	if err != nil {
+		return err
+	}
+
+	var data clientData
+	if err := json.Unmarshal(sig.ClientDataJson, &data); err != nil {
+		return err
+	}
+	if data.Type != webAuthnGetType {
+		return errors.New("invalid client data type")
+	}
+	msgHash := sha256.Sum256(msg)
+	if data.Challenge != base64.RawURLEncoding.EncodeToString(msgHash[:]) {
+		return errors.New("invalid client data challenge")
+	}
+
+	authData := sig.AuthenticatorData
+	if len(authData) < authenticatorDataMinLen {
+		return errors.New("invalid authenticator data")
+	}
+	rpIDHash := sha256.Sum256([]byte(m.RpId))
+	if m.RpId == "" || !bytes.Equal(authData[:32], rpIDHash[:]) {
+		return errors.New("invalid rp ID hash")
+	}
+	if authData[32]&flagUserPresent == 0 {
+		return errors.New("user not present")
+	}
+
+	// the assertion signs the authenticator data followed by the hash of the
+	// client data.
+	clientDataHash := sha256.Sum256(sig.ClientDataJson)
+	signedHash := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	if !ecdsa.VerifyASN1(pubKey, signedHash[:], sig.Signature) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
@@ -0,0 +1,564 @@
+package multisig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/proto"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	MembersPrefix    = collections.NewPrefix(0)
+	ConfigPrefix     = collections.NewPrefix(1)
+	SequencePrefix   = collections.NewPrefix(2)
+	ProposalIDPrefix = collections.NewPrefix(3)
+	ProposalsPrefix  = collections.NewPrefix(4)
+	ApprovalsPrefix  = collections.NewPrefix(5)
+)
+
+var (
+	ErrUnauthorized    = errors.New("unauthorized")
+	ErrInvalidConfig   = errors.New("invalid multisig config")
+	ErrNotApproved     = errors.New("threshold not reached")
+	ErrTimelockActive  = errors.New("proposal timelock has not elapsed")
+	ErrProposalExpired = errors.New("proposal expired")
+)
+
+// NewAccount creates a multisig account whose members sign with the pubkey types
+// added by the options, as supported by the base account. If no pubkey type is
+// added, only secp256k1 pubkeys are supported.
+func NewAccount(name string, handlerMap *signing.HandlerMap, options ...base.Option) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		return name, Account{
+			Members:         collections.NewMap(deps.SchemaBuilder, MembersPrefix, "members", collections.BytesKey, codec.CollValue[v1.Member](deps.LegacyStateCodec)),
+			Config:          collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
+			Sequence:        collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			ProposalID:      collections.NewSequence(deps.SchemaBuilder, ProposalIDPrefix, "proposal_id"),
+			Proposals:       collections.NewMap(deps.SchemaBuilder, ProposalsPrefix, "proposals", collections.Uint64Key, codec.CollValue[v1.Proposal](deps.LegacyStateCodec)),
+			Approvals:       collections.NewKeySet(deps.SchemaBuilder, ApprovalsPrefix, "approvals", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey)),
+			addrCodec:       deps.AddressCodec,
+			hs:              deps.Environment.HeaderService,
+			signingHandlers: handlerMap,
+			decodePubKey:    base.NewPubKeyDecoder(options...),
+		}, nil
+	}
+}
+
+// Account implements a multisig account. Its members are identified by the
+// addresses of their accounts and have a weight, the members signing for the
+// account also have a pubkey. The account can be used in two ways:
+//   - synchronously, as the signer of a transaction carrying a MultiSignature
+//     of members whose total weight reaches the threshold.
+//   - asynchronously, by proposals created and approved by the members from
+//     their own accounts, which can be executed once the total weight of the
+//     approvals reaches the threshold and the timelock has elapsed, and before
+//     they expire.
+type Account struct {
+	// Members maps the address of the account of a member to the member.
+	Members    collections.Map[[]byte, v1.Member]
+	Config     collections.Item[v1.Config]
+	Sequence   collections.Sequence
+	ProposalID collections.Sequence
+	Proposals  collections.Map[uint64, v1.Proposal]
+	// Approvals contains the proposal IDs and the addresses of the members who
+	// approved them.
+	Approvals collections.KeySet[collections.Pair[uint64, []byte]]
+
+	addrCodec address.Codec
+	hs        header.Service
+
+	signingHandlers *signing.HandlerMap
+	// decodePubKey decodes and validates the pubkeys of the members.
+	decodePubKey func(pkAny *codectypes.Any) (cryptotypes.PubKey, error)
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if msg.Config == nil {
+		return nil, fmt.Errorf("%w: config must be set", ErrInvalidConfig)
+	}
+	for _, member := range msg.Members {
+		if member.Weight == 0 {
+			return nil, fmt.Errorf("%w: member weight must be positive", ErrInvalidConfig)
+		}
+		if err := a.setMember(ctx, member); err != nil {
+			return nil, err
+		}
+	}
+	if err := a.Config.Set(ctx, *msg.Config); err != nil {
+		return nil, err
+	}
+	return &v1.MsgInitResponse{}, a.validateConfig(ctx)
+}
+
+// UpdateConfig updates the members and the configuration of the account. It
+// must be sent by the account itself, through a multisig signed transaction or
+// an executed proposal.
+func (a Account) UpdateConfig(ctx context.Context, msg *v1.MsgUpdateConfig) (*v1.MsgUpdateConfigResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, ErrUnauthorized
+	}
+
+	for _, member := range msg.UpdateMembers {
+		if member.Weight != 0 {
+			if err := a.setMember(ctx, member); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		addr, err := a.addrCodec.StringToBytes(member.Address)
+		if err != nil {
+			return nil, err
+		}
+		if err := a.removeMember(ctx, addr); err != nil {
+			return nil, err
+		}
+	}
+	if msg.Config != nil {
+		if err := a.Config.Set(ctx, *msg.Config); err != nil {
+			return nil, err
+		}
+	}
+	return &v1.MsgUpdateConfigResponse{}, a.validateConfig(ctx)
+}
+
+func (a Account) setMember(ctx context.Context, member *v1.Member) error {
+	addr, err := a.addrCodec.StringToBytes(member.Address)
+	if err != nil {
+		return err
+	}
+	if member.PubKey != nil {
+		if _, err := a.decodePubKey(member.PubKey); err != nil {
+			return fmt.Errorf("%w: invalid pubkey of member %s: %w", ErrInvalidConfig, member.Address, err)
+		}
+	}
+	return a.Members.Set(ctx, addr, *member)
+}
+
+// removeMember removes a member and its approvals of the pending proposals, so
+// that they are not counted again if the member is added back.
+func (a Account) removeMember(ctx context.Context, addr []byte) error {
+	if err := a.Members.Remove(ctx, addr); err != nil {
+		return err
+	}
+	return a.Proposals.Walk(ctx, nil, func(id uint64, _ v1.Proposal) (bool, error) {
+		return false, a.Approvals.Remove(ctx, collections.Join(id, addr))
+	})
+}
+
+// validateConfig checks that the threshold can be reached by the members and
+// that proposals can be executed before they expire.
+func (a Account) validateConfig(ctx context.Context) error {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if config.Threshold == 0 {
+		return fmt.Errorf("%w: threshold must be positive", ErrInvalidConfig)
+	}
+	if config.Timelock < 0 {
+		return fmt.Errorf("%w: timelock cannot be negative", ErrInvalidConfig)
+	}
+	if config.Expiration <= config.Timelock {
+		return fmt.Errorf("%w: expiration must be greater than the timelock", ErrInvalidConfig)
+	}
+
+	var totalWeight uint64
+	err = a.Members.Walk(ctx, nil, func(_ []byte, member v1.Member) (bool, error) {
+		if totalWeight > math.MaxUint64-member.Weight {
+			return true, fmt.Errorf("%w: total weight overflows", ErrInvalidConfig)
+		}
+		totalWeight += member.Weight
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	if totalWeight < config.Threshold {
+		return fmt.Errorf("%w: total weight %d is lower than threshold %d", ErrInvalidConfig, totalWeight, config.Threshold)
+	}
+	return nil
+}
+
+// CreateProposal creates a proposal from a member, approved by the member.
+func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal) (*v1.MsgCreateProposalResponse, error) {
+	member, err := a.senderMember(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(msg.Messages) == 0 {
+		return nil, errors.New("proposal must contain messages")
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := a.ProposalID.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := a.hs.HeaderInfo(ctx).Time
+	err = a.Proposals.Set(ctx, id, v1.Proposal{
+		Id:         id,
+		Proposer:   member.Address,
+		Messages:   msg.Messages,
+		SubmitTime: now,
+		ExpiryTime: now.Add(config.Expiration),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.Approvals.Set(ctx, collections.Join(id, accountstd.Sender(ctx))); err != nil {
+		return nil, err
+	}
+	return &v1.MsgCreateProposalResponse{ProposalId: id}, nil
+}
+
+// Approve records the approval of a proposal by a member.
+func (a Account) Approve(ctx context.Context, msg *v1.MsgApprove) (*v1.MsgApproveResponse, error) {
+	if _, err := a.senderMember(ctx); err != nil {
+		return nil, err
+	}
+
+	proposal, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if a.expired(ctx, proposal) {
+		return nil, ErrProposalExpired
+	}
+
+	return &v1.MsgApproveResponse{}, a.Approvals.Set(ctx, collections.Join(msg.ProposalId, accountstd.Sender(ctx)))
+}
+
+// ExecuteProposal executes the messages of a proposal once the total weight of
+// the approvals reaches the threshold and the timelock has elapsed, and before
+// the proposal expires. The approvals are weighted with the members and the
+// weights at the time of the execution.
+func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal) (*v1.MsgExecuteProposalResponse, error) {
+	proposal, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if a.expired(ctx, proposal) {
+		return nil, ErrProposalExpired
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if a.hs.HeaderInfo(ctx).Time.Before(proposal.SubmitTime.Add(config.Timelock)) {
+		return nil, ErrTimelockActive
+	}
+
+	_, weight, err := a.approvals(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if weight < config.Threshold {
+		return nil, fmt.Errorf("%w: approval weight %d, threshold %d", ErrNotApproved, weight, config.Threshold)
+	}
+
+	// the proposal is deleted before its messages are executed, so that they
+	// cannot execute it again.
+	if err := a.removeProposal(ctx, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	responses, err := accountstd.ExecModuleAnys(ctx, proposal.Messages)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgExecuteProposalResponse{Responses: responses}, nil
+}
+
+// PruneProposals deletes the expired proposals and their approvals.
+func (a Account) PruneProposals(ctx context.Context, _ *v1.MsgPruneProposals) (*v1.MsgPruneProposalsResponse, error) {
+	var ids []uint64
+	err := a.Proposals.Walk(ctx, nil, func(id uint64, proposal v1.Proposal) (bool, error) {
+		if a.expired(ctx, proposal) {
+			ids = append(ids, id)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		if err := a.removeProposal(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	return &v1.MsgPruneProposalsResponse{ProposalIds: ids}, nil
+}
+
+// expired returns true if the proposal can no longer be approved nor executed.
+func (a Account) expired(ctx context.Context, proposal v1.Proposal) bool {
+	return !a.hs.HeaderInfo(ctx).Time.Before(proposal.ExpiryTime)
+}
+
+// removeProposal deletes a proposal and its approvals.
+func (a Account) removeProposal(ctx context.Context, id uint64) error {
+	if err := a.Proposals.Remove(ctx, id); err != nil {
+		return err
+	}
+	return a.Approvals.Clear(ctx, collections.NewPrefixedPairRange[uint64, []byte](id))
+}
+
+// senderMember returns the member sending the message.
+func (a Account) senderMember(ctx context.Context) (v1.Member, error) {
+	member, err := a.Members.Get(ctx, accountstd.Sender(ctx))
+	if errors.Is(err, collections.ErrNotFound) {
+		return v1.Member{}, fmt.Errorf("%w: sender is not a member", ErrUnauthorized)
+	}
+	return member, err
+}
+
+// approvals returns the addresses of the current members who approved the
+// proposal and the total weight of their approvals.
+func (a Account) approvals(ctx context.Context, proposalID uint64) (addrs []string, weight uint64, err error) {
+	rng := collections.NewPrefixedPairRange[uint64, []byte](proposalID)
+	err = a.Approvals.Walk(ctx, rng, func(key collections.Pair[uint64, []byte]) (bool, error) {
+		member, err := a.Members.Get(ctx, key.K2())
+		switch {
+		case errors.Is(err, collections.ErrNotFound):
+			// the approvals of a member are removed with it, this is only a
+			// safeguard
+			return false, nil
+		case err != nil:
+			return true, err
+		}
+		addrs = append(addrs, member.Address)
+		weight += member.Weight
+		return false, nil
+	})
+	return addrs, weight, err
+}
+
+// Authenticate implements the authentication flow of an abstracted multisig
+// account. The signature of the account must be a MultiSignature of members
+// whose total weight reaches the threshold.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	signerData, err := a.computeSignerData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := getTxData(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	gotSeq := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].Sequence
+	if gotSeq != signerData.Sequence {
+		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
+	}
+
+	signMode, err := parseSignMode(msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].ModeInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+	}
+
+	var multiSig v1.MultiSignature
+	if err := multiSig.Unmarshal(msg.Tx.Signatures[msg.SignerIndex]); err != nil {
+		return nil, fmt.Errorf("invalid multi signature: %w", err)
+	}
+
+	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.verifyMultiSignature(ctx, &multiSig, signBytes); err != nil {
+		return nil, err
+	}
+	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+}
+
+// verifyMultiSignature verifies the signatures of the members over the sign
+// bytes and checks that their total weight reaches the threshold.
+func (a Account) verifyMultiSignature(ctx context.Context, multiSig *v1.MultiSignature, signBytes []byte) error {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		weight uint64
+		signed = make(map[string]bool, len(multiSig.Signatures))
+	)
+	for _, sig := range multiSig.Signatures {
+		addr, err := a.addrCodec.StringToBytes(sig.Address)
+		if err != nil {
+			return err
+		}
+		if signed[string(addr)] {
+			return fmt.Errorf("duplicate signature of member %s", sig.Address)
+		}
+		signed[string(addr)] = true
+
+		member, err := a.Members.Get(ctx, addr)
+		if errors.Is(err, collections.ErrNotFound) {
+			return fmt.Errorf("%w: %s is not a member", ErrUnauthorized, sig.Address)
+		}
+		if err != nil {
+			return err
+		}
+		if member.PubKey == nil {
+			return fmt.Errorf("%w: member %s has no pubkey", ErrUnauthorized, sig.Address)
+		}
+
+		pubKey, err := a.decodePubKey(member.PubKey)
+		if err != nil {
+			return err
+		}
+		if !pubKey.VerifySignature(signBytes, sig.Signature) {
+			return fmt.Errorf("signature verification failed for member %s", sig.Address)
+		}
+		weight += member.Weight
+	}
+
+	if weight < config.Threshold {
+		return fmt.Errorf("%w: signatures weight %d, threshold %d", ErrNotApproved, weight, config.Threshold)
+	}
+	return nil
+}
+
+func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
+	single, ok := info.Sum.(*tx.ModeInfo_Single_)
+	if !ok {
+		return 0, fmt.Errorf("only sign mode single accepted got: %v", info.Sum)
+	}
+	return signingv1beta1.SignMode(single.Single.Mode), nil
+}
+
+// computeSignerData will populate signer data and also increase the sequence.
+func (a Account) computeSignerData(ctx context.Context) (signing.SignerData, error) {
+	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+	chainID := a.hs.HeaderInfo(ctx).ChainID
+
+	wantSequence, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	accNum, err := accountstd.QueryModule[accountsv1.AccountNumberResponse](ctx, &accountsv1.AccountNumberRequest{Address: addrStr})
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	return signing.SignerData{
+		Address:       addrStr,
+		ChainID:       chainID,
+		AccountNumber: accNum.Number,
+		Sequence:      wantSequence,
+	}, nil
+}
+
+func getTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData, error) {
+	txBody := new(txv1beta1.TxBody)
+	err := proto.Unmarshal(msg.RawTx.BodyBytes, txBody)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	authInfo := new(txv1beta1.AuthInfo)
+	err = proto.Unmarshal(msg.RawTx.AuthInfoBytes, authInfo)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	return signing.TxData{
+		Body:                       txBody,
+		AuthInfo:                   authInfo,
+		BodyBytes:                  msg.RawTx.BodyBytes,
+		AuthInfoBytes:              msg.RawTx.AuthInfoBytes,
+		BodyHasUnknownNonCriticals: false, // NOTE: amino signing must be disabled.
+	}, nil
+}
+
+func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []*v1.Member
+	err = a.Members.Walk(ctx, nil, func(_ []byte, member v1.Member) (bool, error) {
+		members = append(members, &member)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryConfigResponse{Members: members, Config: &config}, nil
+}
+
+func (a Account) QueryProposal(ctx context.Context, msg *v1.QueryProposal) (*v1.QueryProposalResponse, error) {
+	proposal, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals, weight, err := a.approvals(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryProposalResponse{Proposal: &proposal, Approvals: approvals, ApprovalWeight: weight}, nil
+}
+
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
+	accountstd.RegisterExecuteHandler(builder, a.CreateProposal)
+	accountstd.RegisterExecuteHandler(builder, a.Approve)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteProposal)
+	accountstd.RegisterExecuteHandler(builder, a.PruneProposals)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
+	accountstd.RegisterQueryHandler(builder, a.QueryProposal)
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+}
@@ -0,0 +1,401 @@
+package multisig
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+type ProtoMsg = protoiface.MessageV1
+
+type mockHeaderService struct{ time *time.Time }
+
+func (h mockHeaderService) HeaderInfo(context.Context) header.Info { return header.Info{Time: *h.time} }
+
+type addressCodec struct{}
+
+func (addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+// testMember is a member whose account address is not derived from its pubkey,
+// as for x/accounts accounts.
+type testMember struct {
+	address string
+	privKey cryptotypes.PrivKey
+	weight  uint64
+}
+
+func (m testMember) addr() []byte { return []byte(m.address) }
+
+func (m testMember) member() *v1.Member {
+	return m.withWeight(m.weight)
+}
+
+func (m testMember) withWeight(weight uint64) *v1.Member {
+	pubKey, err := codectypes.NewAnyWithValue(m.privKey.PubKey())
+	if err != nil {
+		panic(err)
+	}
+	return &v1.Member{Address: m.address, PubKey: pubKey, Weight: weight}
+}
+
+func (m testMember) sign(t *testing.T, signBytes []byte) *v1.MemberSignature {
+	t.Helper()
+	sig, err := m.privKey.Sign(signBytes)
+	require.NoError(t, err)
+	return &v1.MemberSignature{Address: m.address, Signature: sig}
+}
+
+func setup(t *testing.T, blockTime *time.Time, executed *[]ProtoMsg, options ...base.Option) (context.Context, Account) {
+	t.Helper()
+	ctx, ss := accountstd.NewMockContext(
+		0, []byte("multisig"), []byte("sender"), nil,
+		func(context.Context, []byte, ProtoMsg, ProtoMsg) error { return nil },
+		func(_ context.Context, _ []byte, msg ProtoMsg) (ProtoMsg, error) {
+			*executed = append(*executed, msg)
+			return &accountsv1.MsgExecuteResponse{}, nil
+		},
+		func(context.Context, ProtoMsg, ProtoMsg) error { return nil },
+	)
+
+	deps := accountstd.Dependencies{
+		SchemaBuilder:    collections.NewSchemaBuilder(ss),
+		AddressCodec:     addressCodec{},
+		LegacyStateCodec: codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+	}
+	deps.Environment.HeaderService = mockHeaderService{time: blockTime}
+
+	_, acc, err := NewAccount("multisig", nil, options...)(deps)
+	require.NoError(t, err)
+	return ctx, acc.(Account)
+}
+
+func newTestMembers(weights ...uint64) []testMember {
+	members := make([]testMember, len(weights))
+	for i, weight := range weights {
+		members[i] = newTestMember(secp256k1.GenPrivKey(), weight)
+	}
+	return members
+}
+
+func newTestMember(privKey cryptotypes.PrivKey, weight uint64) testMember {
+	return testMember{address: fmt.Sprintf("account-%X", privKey.PubKey().Address()), privKey: privKey, weight: weight}
+}
+
+func TestInit(t *testing.T) {
+	blockTime := time.Now()
+	ctx, acc := setup(t, &blockTime, nil)
+	members := newTestMembers(1, 2)
+
+	_, err := acc.Init(ctx, &v1.MsgInit{Members: []*v1.Member{members[0].member(), members[1].member()}, Config: &v1.Config{Threshold: 4, Expiration: time.Hour}})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+
+	_, err = acc.Init(ctx, &v1.MsgInit{Members: []*v1.Member{members[0].withWeight(0)}, Config: &v1.Config{Threshold: 1, Expiration: time.Hour}})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+
+	// the pubkeys must be valid pubkeys of a supported type
+	invalid, err := codectypes.NewAnyWithValue(&secp256k1.PubKey{Key: []byte("invalid")})
+	require.NoError(t, err)
+	_, err = acc.Init(ctx, &v1.MsgInit{Members: []*v1.Member{{Address: "member", PubKey: invalid, Weight: 1}}, Config: &v1.Config{Threshold: 1, Expiration: time.Hour}})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+	r1PrivKey, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	_, err = acc.Init(ctx, &v1.MsgInit{Members: []*v1.Member{newTestMember(r1PrivKey, 1).member()}, Config: &v1.Config{Threshold: 1, Expiration: time.Hour}})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+
+	_, err = acc.Init(ctx, &v1.MsgInit{Members: []*v1.Member{members[0].member(), members[1].member()}, Config: &v1.Config{Threshold: 3, Timelock: time.Hour, Expiration: time.Hour}})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+
+	_, err = acc.Init(ctx, &v1.MsgInit{Members: []*v1.Member{members[0].member(), members[1].member()}, Config: &v1.Config{Threshold: 3, Expiration: time.Hour}})
+	require.NoError(t, err)
+
+	res, err := acc.QueryConfig(ctx, &v1.QueryConfig{})
+	require.NoError(t, err)
+	require.Len(t, res.Members, 2)
+	require.Equal(t, uint64(3), res.Config.Threshold)
+}
+
+func TestProposals(t *testing.T) {
+	blockTime := time.Now()
+	var executed []ProtoMsg
+	ctx, acc := setup(t, &blockTime, &executed)
+	members := newTestMembers(1, 1, 2)
+
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Members: []*v1.Member{members[0].member(), members[1].member(), members[2].member()},
+		Config:  &v1.Config{Threshold: 2, Timelock: time.Hour, Expiration: 3 * time.Hour},
+	})
+	require.NoError(t, err)
+
+	msg, err := accountstd.PackAny(&accountsv1.MsgExecute{Sender: "multisig", Target: "other"})
+	require.NoError(t, err)
+
+	// only members can create proposals, from their accounts
+	_, err = acc.CreateProposal(ctx, &v1.MsgCreateProposal{Messages: []*codectypes.Any{msg}})
+	require.ErrorIs(t, err, ErrUnauthorized)
+	_, err = acc.CreateProposal(accountstd.SetSender(ctx, members[0].privKey.PubKey().Address()), &v1.MsgCreateProposal{Messages: []*codectypes.Any{msg}})
+	require.ErrorIs(t, err, ErrUnauthorized)
+
+	ctx = accountstd.SetSender(ctx, members[0].addr())
+	_, err = acc.CreateProposal(ctx, &v1.MsgCreateProposal{})
+	require.Error(t, err)
+	res, err := acc.CreateProposal(ctx, &v1.MsgCreateProposal{Messages: []*codectypes.Any{msg}})
+	require.NoError(t, err)
+	id := res.ProposalId
+
+	// the approval of the proposer is not enough
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: id})
+	require.ErrorIs(t, err, ErrTimelockActive)
+	blockTime = blockTime.Add(time.Hour)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: id})
+	require.ErrorIs(t, err, ErrNotApproved)
+
+	_, err = acc.Approve(accountstd.SetSender(ctx, []byte("other")), &v1.MsgApprove{ProposalId: id})
+	require.ErrorIs(t, err, ErrUnauthorized)
+	_, err = acc.Approve(accountstd.SetSender(ctx, members[1].addr()), &v1.MsgApprove{ProposalId: id})
+	require.NoError(t, err)
+
+	query, err := acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), query.ApprovalWeight)
+	require.ElementsMatch(t, []string{members[0].address, members[1].address}, query.Approvals)
+	require.Equal(t, members[0].address, query.Proposal.Proposer)
+
+	// anyone can execute an approved proposal
+	_, err = acc.ExecuteProposal(accountstd.SetSender(ctx, []byte("other")), &v1.MsgExecuteProposal{ProposalId: id})
+	require.NoError(t, err)
+	require.Len(t, executed, 1)
+	require.Equal(t, "other", executed[0].(*accountsv1.MsgExecute).Target)
+
+	// executed proposals are deleted with their approvals
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: id})
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = acc.Approve(ctx, &v1.MsgApprove{ProposalId: id})
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	has, err := acc.Approvals.Has(ctx, collections.Join(id, members[1].addr()))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the approvals of removed members are not counted, even if they are added
+	// back
+	res, err = acc.CreateProposal(ctx, &v1.MsgCreateProposal{Messages: []*codectypes.Any{msg}})
+	require.NoError(t, err)
+	_, err = acc.Approve(accountstd.SetSender(ctx, members[1].addr()), &v1.MsgApprove{ProposalId: res.ProposalId})
+	require.NoError(t, err)
+
+	selfCtx := accountstd.SetSender(ctx, []byte("multisig"))
+	_, err = acc.UpdateConfig(selfCtx, &v1.MsgUpdateConfig{UpdateMembers: []*v1.Member{members[1].withWeight(0)}})
+	require.NoError(t, err)
+	_, err = acc.UpdateConfig(selfCtx, &v1.MsgUpdateConfig{UpdateMembers: []*v1.Member{members[1].member()}})
+	require.NoError(t, err)
+
+	blockTime = blockTime.Add(time.Hour)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: res.ProposalId})
+	require.ErrorIs(t, err, ErrNotApproved)
+}
+
+func TestProposalWeights(t *testing.T) {
+	blockTime := time.Now()
+	var executed []ProtoMsg
+	ctx, acc := setup(t, &blockTime, &executed)
+	members := newTestMembers(1, 1, 1)
+
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Members: []*v1.Member{members[0].member(), members[1].member(), members[2].member()},
+		Config:  &v1.Config{Threshold: 2, Expiration: time.Hour},
+	})
+	require.NoError(t, err)
+
+	msg, err := accountstd.PackAny(&accountsv1.MsgExecute{Sender: "multisig", Target: "other"})
+	require.NoError(t, err)
+	res, err := acc.CreateProposal(accountstd.SetSender(ctx, members[0].addr()), &v1.MsgCreateProposal{Messages: []*codectypes.Any{msg}})
+	require.NoError(t, err)
+	_, err = acc.Approve(accountstd.SetSender(ctx, members[1].addr()), &v1.MsgApprove{ProposalId: res.ProposalId})
+	require.NoError(t, err)
+
+	// the approvals are weighted with the weights at the time of the execution
+	selfCtx := accountstd.SetSender(ctx, []byte("multisig"))
+	lowered := []*v1.Member{members[1].withWeight(1), members[2].withWeight(3)}
+	_, err = acc.UpdateConfig(selfCtx, &v1.MsgUpdateConfig{UpdateMembers: lowered, Config: &v1.Config{Threshold: 3, Expiration: time.Hour}})
+	require.NoError(t, err)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: res.ProposalId})
+	require.ErrorIs(t, err, ErrNotApproved)
+
+	raised := []*v1.Member{members[1].withWeight(2)}
+	_, err = acc.UpdateConfig(selfCtx, &v1.MsgUpdateConfig{UpdateMembers: raised})
+	require.NoError(t, err)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: res.ProposalId})
+	require.NoError(t, err)
+	require.Len(t, executed, 1)
+}
+
+func TestProposalExpiry(t *testing.T) {
+	blockTime := time.Now()
+	var executed []ProtoMsg
+	ctx, acc := setup(t, &blockTime, &executed)
+	members := newTestMembers(1, 1)
+
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Members: []*v1.Member{members[0].member(), members[1].member()},
+		Config:  &v1.Config{Threshold: 2, Expiration: time.Hour},
+	})
+	require.NoError(t, err)
+
+	msg, err := accountstd.PackAny(&accountsv1.MsgExecute{Sender: "multisig", Target: "other"})
+	require.NoError(t, err)
+	memberCtx := accountstd.SetSender(ctx, members[0].addr())
+	expiring, err := acc.CreateProposal(memberCtx, &v1.MsgCreateProposal{Messages: []*codectypes.Any{msg}})
+	require.NoError(t, err)
+	blockTime = blockTime.Add(30 * time.Minute)
+	pending, err := acc.CreateProposal(memberCtx, &v1.MsgCreateProposal{Messages: []*codectypes.Any{msg}})
+	require.NoError(t, err)
+
+	// expired proposals can no longer be approved nor executed
+	blockTime = blockTime.Add(30 * time.Minute)
+	_, err = acc.Approve(accountstd.SetSender(ctx, members[1].addr()), &v1.MsgApprove{ProposalId: expiring.ProposalId})
+	require.ErrorIs(t, err, ErrProposalExpired)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: expiring.ProposalId})
+	require.ErrorIs(t, err, ErrProposalExpired)
+	require.Empty(t, executed)
+
+	// anyone can delete the expired proposals
+	pruned, err := acc.PruneProposals(accountstd.SetSender(ctx, []byte("other")), &v1.MsgPruneProposals{})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{expiring.ProposalId}, pruned.ProposalIds)
+
+	_, err = acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: expiring.ProposalId})
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	has, err := acc.Approvals.Has(ctx, collections.Join(expiring.ProposalId, members[0].addr()))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, err = acc.Approve(accountstd.SetSender(ctx, members[1].addr()), &v1.MsgApprove{ProposalId: pending.ProposalId})
+	require.NoError(t, err)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: pending.ProposalId})
+	require.NoError(t, err)
+	require.Len(t, executed, 1)
+}
+
+func TestUpdateConfig(t *testing.T) {
+	blockTime := time.Now()
+	ctx, acc := setup(t, &blockTime, nil)
+	members := newTestMembers(1, 1, 1)
+
+	_, err := acc.Init(ctx, &v1.MsgInit{Members: []*v1.Member{members[0].member(), members[1].member()}, Config: &v1.Config{Threshold: 2, Expiration: time.Hour}})
+	require.NoError(t, err)
+
+	update := &v1.MsgUpdateConfig{
+		UpdateMembers: []*v1.Member{members[2].member(), {Address: members[0].address}},
+		Config:        &v1.Config{Threshold: 2, Timelock: time.Minute, Expiration: time.Hour},
+	}
+	_, err = acc.UpdateConfig(accountstd.SetSender(ctx, members[0].addr()), update)
+	require.ErrorIs(t, err, ErrUnauthorized)
+
+	selfCtx := accountstd.SetSender(ctx, []byte("multisig"))
+	_, err = acc.UpdateConfig(selfCtx, update)
+	require.NoError(t, err)
+
+	res, err := acc.QueryConfig(ctx, &v1.QueryConfig{})
+	require.NoError(t, err)
+	pubKeys := make(map[string][]byte)
+	for _, member := range res.Members {
+		pubKeys[member.Address] = member.PubKey.Value
+	}
+	require.Equal(t, map[string][]byte{
+		members[1].address: members[1].member().PubKey.Value,
+		members[2].address: members[2].member().PubKey.Value,
+	}, pubKeys)
+	require.Equal(t, v1.Config{Threshold: 2, Timelock: time.Minute, Expiration: time.Hour}, *res.Config)
+
+	// the threshold must be reachable
+	_, err = acc.UpdateConfig(selfCtx, &v1.MsgUpdateConfig{Config: &v1.Config{Threshold: 3, Expiration: time.Hour}})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+	_, err = acc.UpdateConfig(selfCtx, &v1.MsgUpdateConfig{UpdateMembers: []*v1.Member{{Address: members[1].address}}})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+}
+
+func TestVerifyMultiSignature(t *testing.T) {
+	blockTime := time.Now()
+	ctx, acc := setup(t, &blockTime, nil)
+	members := newTestMembers(1, 1, 2)
+	// members without a pubkey only act through proposals
+	noPubKey := &v1.Member{Address: "no_pub_key", Weight: 2}
+
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Members: []*v1.Member{members[0].member(), members[1].member(), members[2].member(), noPubKey},
+		Config:  &v1.Config{Threshold: 2, Expiration: time.Hour},
+	})
+	require.NoError(t, err)
+
+	signBytes := []byte("sign bytes")
+	sign := func(m testMember) *v1.MemberSignature { return m.sign(t, signBytes) }
+	nonMember := newTestMembers(5)[0]
+
+	testCases := []struct {
+		name   string
+		sigs   []*v1.MemberSignature
+		valid  bool
+		expErr error
+	}{
+		{"threshold reached", []*v1.MemberSignature{sign(members[0]), sign(members[1])}, true, nil},
+		{"threshold reached by one member", []*v1.MemberSignature{sign(members[2])}, true, nil},
+		{"threshold not reached", []*v1.MemberSignature{sign(members[0])}, false, ErrNotApproved},
+		{"duplicate signature", []*v1.MemberSignature{sign(members[0]), sign(members[0])}, false, nil},
+		{"non member", []*v1.MemberSignature{sign(members[0]), sign(nonMember)}, false, ErrUnauthorized},
+		{"invalid signature", []*v1.MemberSignature{sign(members[0]), {Address: members[1].address, Signature: []byte("invalid")}}, false, nil},
+		{"member without pubkey", []*v1.MemberSignature{{Address: noPubKey.Address, Signature: []byte("signature")}}, false, ErrUnauthorized},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := acc.verifyMultiSignature(ctx, &v1.MultiSignature{Signatures: tc.sigs}, signBytes)
+			switch {
+			case tc.valid:
+				require.NoError(t, err)
+			case tc.expErr != nil:
+				require.ErrorIs(t, err, tc.expErr)
+			default:
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMemberPubKeyTypes(t *testing.T) {
+	blockTime := time.Now()
+	ctx, acc := setup(t, &blockTime, nil, base.WithSecp256R1PubKey(), base.WithEd25519PubKey())
+
+	r1PrivKey, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	r1Member := newTestMember(r1PrivKey, 1)
+	edMember := newTestMember(ed25519.GenPrivKey(), 1)
+
+	// only the pubkey types added by the options are supported
+	_, err = acc.Init(ctx, &v1.MsgInit{Members: []*v1.Member{newTestMembers(1)[0].member()}, Config: &v1.Config{Threshold: 1, Expiration: time.Hour}})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+
+	_, err = acc.Init(ctx, &v1.MsgInit{Members: []*v1.Member{r1Member.member(), edMember.member()}, Config: &v1.Config{Threshold: 2, Expiration: time.Hour}})
+	require.NoError(t, err)
+
+	signBytes := []byte("sign bytes")
+	multiSig := &v1.MultiSignature{Signatures: []*v1.MemberSignature{r1Member.sign(t, signBytes), edMember.sign(t, signBytes)}}
+	require.NoError(t, acc.verifyMultiSignature(ctx, multiSig, signBytes))
+	require.Error(t, acc.verifyMultiSignature(ctx, multiSig, []byte("other sign bytes")))
+}
@@ -0,0 +1,363 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// The base account uses the prefixes 0 to 3.
+var (
+	ConfigPrefix   = collections.NewPrefix(10)
+	RecoveryPrefix = collections.NewPrefix(11)
+)
+
+var (
+	ErrUnauthorized     = errors.New("unauthorized")
+	ErrInvalidConfig    = errors.New("invalid recovery config")
+	ErrNoRecovery       = errors.New("no pending recovery")
+	ErrRecoveryPending  = errors.New("recovery already pending")
+	ErrAlreadyApproved  = errors.New("recovery already approved by guardian")
+	ErrNotApproved      = errors.New("recovery threshold not reached")
+	ErrRecoveryDelay    = errors.New("recovery delay has not elapsed")
+	ErrNotGuardian      = errors.New("sender is not a guardian")
+	ErrAlreadyRequested = errors.New("recovery cancellation already requested by guardian")
+)
+
+const (
+	EventTypeRecoveryInitiated = "recovery_initiated"
+	EventTypeRecoveryApproved  = "recovery_approved"
+	EventTypeRecoveryCancelled = "recovery_cancelled"
+	EventTypeCancelRequested   = "recovery_cancel_requested"
+	EventTypeRecoveryExecuted  = "recovery_executed"
+	EventTypeConfigUpdated     = "recovery_config_updated"
+
+	AttributeKeyGuardian      = "guardian"
+	AttributeKeyApprovals     = "approvals"
+	AttributeKeyCancellations = "cancellations"
+	AttributeKeyExecuteTime   = "execute_time"
+	AttributeKeyNewPubKeyType = "new_pub_key_type"
+)
+
+// NewAccount creates a recovery account supporting the pubkey types added by
+// the options, as the base account.
+func NewAccount(name string, handlerMap *signing.HandlerMap, options ...base.Option) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		_, baseAcc, err := base.NewAccount(name, handlerMap, options...)(deps)
+		if err != nil {
+			return "", nil, err
+		}
+		return name, Account{
+			Account:   baseAcc.(base.Account),
+			Config:    collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
+			Recovery:  collections.NewItem(deps.SchemaBuilder, RecoveryPrefix, "recovery", codec.CollValue[v1.Recovery](deps.LegacyStateCodec)),
+			addrCodec: deps.AddressCodec,
+			hs:        deps.Environment.HeaderService,
+			es:        deps.Environment.EventService,
+		}, nil
+	}
+}
+
+// Account implements a recovery account: a base account whose owner designates
+// guardians which can rotate its pubkey if the owner loses their key.
+// A guardian initiates the recovery with the new pubkey, and once approved by
+// the threshold of guardians, the recovery can be executed after a delay
+// during which the owner can cancel it. The threshold of guardians can also
+// cancel a recovery, e.g. one initiated by a malicious guardian.
+type Account struct {
+	base.Account
+
+	Config collections.Item[v1.Config]
+	// Recovery is the pending recovery of the account, if any.
+	Recovery collections.Item[v1.Recovery]
+
+	addrCodec address.Codec
+	hs        header.Service
+	es        event.Service
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if _, err := a.Account.Init(ctx, &basev1.MsgInit{PubKey: msg.PubKey}); err != nil {
+		return nil, err
+	}
+	if err := a.setConfig(ctx, msg.Config); err != nil {
+		return nil, err
+	}
+	return &v1.MsgInitResponse{}, nil
+}
+
+// UpdateConfig updates the recovery config, it must be sent by the account
+// itself. The pending recovery is cancelled, as it was approved by the
+// previous guardians.
+func (a Account) UpdateConfig(ctx context.Context, msg *v1.MsgUpdateConfig) (*v1.MsgUpdateConfigResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, ErrUnauthorized
+	}
+
+	if err := a.setConfig(ctx, msg.Config); err != nil {
+		return nil, err
+	}
+	if err := a.Recovery.Remove(ctx); err != nil {
+		return nil, err
+	}
+	return &v1.MsgUpdateConfigResponse{}, a.es.EventManager(ctx).EmitKV(EventTypeConfigUpdated)
+}
+
+func (a Account) setConfig(ctx context.Context, config *v1.Config) error {
+	if config == nil {
+		return fmt.Errorf("%w: config must be set", ErrInvalidConfig)
+	}
+	if config.Threshold == 0 || config.Threshold > uint64(len(config.Guardians)) {
+		return fmt.Errorf("%w: threshold must be between 1 and the number of guardians", ErrInvalidConfig)
+	}
+	if config.Delay < 0 {
+		return fmt.Errorf("%w: delay must not be negative", ErrInvalidConfig)
+	}
+
+	self := string(accountstd.Whoami(ctx))
+	seen := make(map[string]struct{}, len(config.Guardians))
+	for _, guardian := range config.Guardians {
+		addr, err := a.addrCodec.StringToBytes(guardian)
+		if err != nil {
+			return fmt.Errorf("%w: invalid guardian %s: %w", ErrInvalidConfig, guardian, err)
+		}
+		if string(addr) == self {
+			return fmt.Errorf("%w: the account cannot be its own guardian", ErrInvalidConfig)
+		}
+		if _, ok := seen[string(addr)]; ok {
+			return fmt.Errorf("%w: duplicate guardian %s", ErrInvalidConfig, guardian)
+		}
+		seen[string(addr)] = struct{}{}
+	}
+	return a.Config.Set(ctx, *config)
+}
+
+// InitiateRecovery starts the recovery of the account towards a new pubkey, it
+// must be sent by a guardian and is approved by them.
+func (a Account) InitiateRecovery(ctx context.Context, msg *v1.MsgInitiateRecovery) (*v1.MsgInitiateRecoveryResponse, error) {
+	guardian, config, err := a.guardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+	has, err := a.Recovery.Has(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, ErrRecoveryPending
+	}
+	if err := a.ValidatePubKey(msg.NewPubKey); err != nil {
+		return nil, err
+	}
+
+	recovery := v1.Recovery{
+		NewPubKey:    msg.NewPubKey,
+		InitiateTime: a.hs.HeaderInfo(ctx).Time,
+	}
+	err = a.es.EventManager(ctx).EmitKV(EventTypeRecoveryInitiated,
+		event.NewAttribute(AttributeKeyGuardian, guardian),
+		event.NewAttribute(AttributeKeyNewPubKeyType, msg.NewPubKey.TypeUrl),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MsgInitiateRecoveryResponse{}, a.approve(ctx, config, recovery, guardian)
+}
+
+// ApproveRecovery approves the pending recovery, it must be sent by a guardian.
+func (a Account) ApproveRecovery(ctx context.Context, _ *v1.MsgApproveRecovery) (*v1.MsgApproveRecoveryResponse, error) {
+	guardian, config, err := a.guardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recovery, err := a.pendingRecovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(recovery.Approvals, guardian) {
+		return nil, ErrAlreadyApproved
+	}
+	if slices.Contains(recovery.Cancellations, guardian) {
+		return nil, ErrAlreadyRequested
+	}
+	return &v1.MsgApproveRecoveryResponse{}, a.approve(ctx, config, recovery, guardian)
+}
+
+// approve adds the approval of the guardian to the recovery, and starts the
+// delay of the recovery once its approvals reach the threshold.
+func (a Account) approve(ctx context.Context, config v1.Config, recovery v1.Recovery, guardian string) error {
+	recovery.Approvals = append(recovery.Approvals, guardian)
+	attrs := []event.Attribute{
+		event.NewAttribute(AttributeKeyGuardian, guardian),
+		event.NewAttribute(AttributeKeyApprovals, strconv.Itoa(len(recovery.Approvals))),
+	}
+	if recovery.ExecuteTime == nil && uint64(len(recovery.Approvals)) >= config.Threshold {
+		executeTime := a.hs.HeaderInfo(ctx).Time.Add(config.Delay)
+		recovery.ExecuteTime = &executeTime
+		attrs = append(attrs, event.NewAttribute(AttributeKeyExecuteTime, executeTime.String()))
+	}
+
+	if err := a.Recovery.Set(ctx, recovery); err != nil {
+		return err
+	}
+	return a.es.EventManager(ctx).EmitKV(EventTypeRecoveryApproved, attrs...)
+}
+
+// CancelRecovery cancels the pending recovery when sent by the account itself,
+// meaning the owner still holds the key of the account. When sent by a
+// guardian who did not approve the recovery, it requests its cancellation: the
+// recovery is cancelled once requested by the threshold of guardians, so that
+// a single guardian cannot block the recovery of the account by initiating a
+// recovery the other guardians don't approve.
+func (a Account) CancelRecovery(ctx context.Context, _ *v1.MsgCancelRecovery) (*v1.MsgCancelRecoveryResponse, error) {
+	if accountstd.SenderIsSelf(ctx) {
+		if _, err := a.pendingRecovery(ctx); err != nil {
+			return nil, err
+		}
+		return &v1.MsgCancelRecoveryResponse{}, a.cancel(ctx)
+	}
+
+	guardian, config, err := a.guardian(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recovery, err := a.pendingRecovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(recovery.Approvals, guardian) {
+		return nil, ErrAlreadyApproved
+	}
+	if slices.Contains(recovery.Cancellations, guardian) {
+		return nil, ErrAlreadyRequested
+	}
+
+	recovery.Cancellations = append(recovery.Cancellations, guardian)
+	err = a.es.EventManager(ctx).EmitKV(EventTypeCancelRequested,
+		event.NewAttribute(AttributeKeyGuardian, guardian),
+		event.NewAttribute(AttributeKeyCancellations, strconv.Itoa(len(recovery.Cancellations))),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(recovery.Cancellations)) >= config.Threshold {
+		return &v1.MsgCancelRecoveryResponse{}, a.cancel(ctx)
+	}
+	return &v1.MsgCancelRecoveryResponse{}, a.Recovery.Set(ctx, recovery)
+}
+
+func (a Account) cancel(ctx context.Context) error {
+	if err := a.Recovery.Remove(ctx); err != nil {
+		return err
+	}
+	return a.es.EventManager(ctx).EmitKV(EventTypeRecoveryCancelled)
+}
+
+// ExecuteRecovery rotates the pubkey of the account to the pubkey of the
+// pending recovery, once approved and after its delay. It can be sent by
+// anyone.
+func (a Account) ExecuteRecovery(ctx context.Context, _ *v1.MsgExecuteRecovery) (*v1.MsgExecuteRecoveryResponse, error) {
+	recovery, err := a.pendingRecovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if recovery.ExecuteTime == nil {
+		return nil, ErrNotApproved
+	}
+	if a.hs.HeaderInfo(ctx).Time.Before(*recovery.ExecuteTime) {
+		return nil, fmt.Errorf("%w: executable from %s", ErrRecoveryDelay, recovery.ExecuteTime)
+	}
+
+	if err := a.SetPubKey(ctx, recovery.NewPubKey); err != nil {
+		return nil, err
+	}
+	if err := a.Recovery.Remove(ctx); err != nil {
+		return nil, err
+	}
+	// the session keys were granted by the previous key, which may be compromised.
+	if err := a.SessionKeys.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+	return &v1.MsgExecuteRecoveryResponse{}, a.es.EventManager(ctx).EmitKV(EventTypeRecoveryExecuted,
+		event.NewAttribute(AttributeKeyNewPubKeyType, recovery.NewPubKey.TypeUrl),
+	)
+}
+
+// guardian returns the address of the sender, which must be a guardian, and
+// the recovery config.
+func (a Account) guardian(ctx context.Context) (string, v1.Config, error) {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return "", v1.Config{}, err
+	}
+	sender, err := a.addrCodec.BytesToString(accountstd.Sender(ctx))
+	if err != nil {
+		return "", v1.Config{}, err
+	}
+	if !slices.Contains(config.Guardians, sender) {
+		return "", v1.Config{}, ErrNotGuardian
+	}
+	return sender, config, nil
+}
+
+func (a Account) pendingRecovery(ctx context.Context) (v1.Recovery, error) {
+	recovery, err := a.Recovery.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return v1.Recovery{}, ErrNoRecovery
+	}
+	return recovery, err
+}
+
+func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryConfigResponse{Config: &config}, nil
+}
+
+// QueryPendingRecovery returns the pending recovery, the recovery is unset if
+// there is none.
+func (a Account) QueryPendingRecovery(ctx context.Context, _ *v1.QueryPendingRecovery) (*v1.QueryPendingRecoveryResponse, error) {
+	recovery, err := a.pendingRecovery(ctx)
+	switch {
+	case errors.Is(err, ErrNoRecovery):
+		return &v1.QueryPendingRecoveryResponse{}, nil
+	case err != nil:
+		return nil, err
+	}
+	return &v1.QueryPendingRecoveryResponse{Recovery: &recovery}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	a.Account.RegisterExecuteHandlers(builder)
+	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
+	accountstd.RegisterExecuteHandler(builder, a.InitiateRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ApproveRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.CancelRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteRecovery)
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	a.Account.RegisterQueryHandlers(builder)
+	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
+	accountstd.RegisterQueryHandler(builder, a.QueryPendingRecovery)
+}
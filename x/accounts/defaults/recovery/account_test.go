@@ -0,0 +1,270 @@
+package recovery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+type ProtoMsg = protoiface.MessageV1
+
+type mockHeaderService struct{ time *time.Time }
+
+func (h mockHeaderService) HeaderInfo(context.Context) header.Info { return header.Info{Time: *h.time} }
+
+type mockEventService struct{ events *[]string }
+
+func (s mockEventService) EventManager(context.Context) event.Manager { return s }
+
+func (s mockEventService) Emit(protoiface.MessageV1) error { return nil }
+
+func (s mockEventService) EmitKV(eventType string, _ ...event.Attribute) error {
+	*s.events = append(*s.events, eventType)
+	return nil
+}
+
+type addressCodec struct{}
+
+func (addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+func setup(t *testing.T, blockTime *time.Time, events *[]string) (context.Context, Account) {
+	t.Helper()
+	ctx, ss := accountstd.NewMockContext(
+		0, []byte("recovery"), []byte("recovery"), nil,
+		func(context.Context, []byte, ProtoMsg, ProtoMsg) error { return nil },
+		func(context.Context, []byte, ProtoMsg) (ProtoMsg, error) { return nil, nil },
+		func(context.Context, ProtoMsg, ProtoMsg) error { return nil },
+	)
+
+	deps := accountstd.Dependencies{
+		SchemaBuilder:    collections.NewSchemaBuilder(ss),
+		AddressCodec:     addressCodec{},
+		LegacyStateCodec: codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+	}
+	deps.Environment.HeaderService = mockHeaderService{time: blockTime}
+	deps.Environment.EventService = mockEventService{events: events}
+
+	_, acc, err := NewAccount("recovery", nil)(deps)
+	require.NoError(t, err)
+	return ctx, acc.(Account)
+}
+
+func newPubKey(t *testing.T) *codectypes.Any {
+	t.Helper()
+	pk, err := codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	return pk
+}
+
+func asGuardian(ctx context.Context, guardian string) context.Context {
+	return accountstd.SetSender(ctx, []byte(guardian))
+}
+
+func TestInit(t *testing.T) {
+	blockTime := time.Now()
+	ctx, acc := setup(t, &blockTime, &[]string{})
+	pubKey := newPubKey(t)
+
+	for _, config := range []*v1.Config{
+		nil,
+		{Guardians: []string{"alice"}, Threshold: 2},
+		{Guardians: []string{"alice"}, Threshold: 0},
+		{Guardians: []string{"alice", "alice"}, Threshold: 1},
+		{Guardians: []string{"recovery"}, Threshold: 1},
+		{Guardians: []string{"alice"}, Threshold: 1, Delay: -time.Hour},
+	} {
+		_, err := acc.Init(ctx, &v1.MsgInit{PubKey: pubKey, Config: config})
+		require.ErrorIs(t, err, ErrInvalidConfig)
+	}
+
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: pubKey, Config: &v1.Config{Guardians: []string{"alice", "bob"}, Threshold: 2, Delay: time.Hour}})
+	require.NoError(t, err)
+
+	resp, err := acc.QueryConfig(ctx, &v1.QueryConfig{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Config.Threshold)
+	got, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, pubKey.Value, got.Value)
+}
+
+func TestRecovery(t *testing.T) {
+	blockTime := time.Now()
+	var events []string
+	ctx, acc := setup(t, &blockTime, &events)
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: newPubKey(t), Config: &v1.Config{Guardians: []string{"alice", "bob", "carol"}, Threshold: 2, Delay: time.Hour}})
+	require.NoError(t, err)
+	newKey := newPubKey(t)
+
+	// only guardians can initiate a recovery
+	_, err = acc.InitiateRecovery(asGuardian(ctx, "mallory"), &v1.MsgInitiateRecovery{NewPubKey: newKey})
+	require.ErrorIs(t, err, ErrNotGuardian)
+	_, err = acc.ApproveRecovery(asGuardian(ctx, "alice"), &v1.MsgApproveRecovery{})
+	require.ErrorIs(t, err, ErrNoRecovery)
+
+	_, err = acc.InitiateRecovery(asGuardian(ctx, "alice"), &v1.MsgInitiateRecovery{NewPubKey: newKey})
+	require.NoError(t, err)
+	_, err = acc.InitiateRecovery(asGuardian(ctx, "bob"), &v1.MsgInitiateRecovery{NewPubKey: newPubKey(t)})
+	require.ErrorIs(t, err, ErrRecoveryPending)
+	_, err = acc.ApproveRecovery(asGuardian(ctx, "alice"), &v1.MsgApproveRecovery{})
+	require.ErrorIs(t, err, ErrAlreadyApproved)
+
+	// the recovery can't be executed before reaching the threshold
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{})
+	require.ErrorIs(t, err, ErrNotApproved)
+
+	// the delay starts once the threshold is reached
+	blockTime = blockTime.Add(time.Minute)
+	_, err = acc.ApproveRecovery(asGuardian(ctx, "bob"), &v1.MsgApproveRecovery{})
+	require.NoError(t, err)
+
+	resp, err := acc.QueryPendingRecovery(ctx, &v1.QueryPendingRecovery{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice", "bob"}, resp.Recovery.Approvals)
+	require.True(t, blockTime.Add(time.Hour).Equal(*resp.Recovery.ExecuteTime))
+
+	blockTime = blockTime.Add(time.Hour - time.Second)
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{})
+	require.ErrorIs(t, err, ErrRecoveryDelay)
+
+	// anyone can execute the recovery after the delay
+	blockTime = blockTime.Add(time.Second)
+	_, err = acc.ExecuteRecovery(asGuardian(ctx, "mallory"), &v1.MsgExecuteRecovery{})
+	require.NoError(t, err)
+
+	got, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, newKey.Value, got.Value)
+
+	resp, err = acc.QueryPendingRecovery(ctx, &v1.QueryPendingRecovery{})
+	require.NoError(t, err)
+	require.Nil(t, resp.Recovery)
+
+	require.Equal(t, []string{
+		EventTypeRecoveryInitiated,
+		EventTypeRecoveryApproved,
+		EventTypeRecoveryApproved,
+		EventTypeRecoveryExecuted,
+	}, events)
+}
+
+func TestCancelRecovery(t *testing.T) {
+	blockTime := time.Now()
+	var events []string
+	ctx, acc := setup(t, &blockTime, &events)
+	oldKey := newPubKey(t)
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: oldKey, Config: &v1.Config{Guardians: []string{"alice"}, Threshold: 1, Delay: time.Hour}})
+	require.NoError(t, err)
+
+	_, err = acc.CancelRecovery(ctx, &v1.MsgCancelRecovery{})
+	require.ErrorIs(t, err, ErrNoRecovery)
+
+	_, err = acc.InitiateRecovery(asGuardian(ctx, "alice"), &v1.MsgInitiateRecovery{NewPubKey: newPubKey(t)})
+	require.NoError(t, err)
+
+	// the owner can cancel the recovery, guardians who approved it can't
+	_, err = acc.CancelRecovery(asGuardian(ctx, "mallory"), &v1.MsgCancelRecovery{})
+	require.ErrorIs(t, err, ErrNotGuardian)
+	_, err = acc.CancelRecovery(asGuardian(ctx, "alice"), &v1.MsgCancelRecovery{})
+	require.ErrorIs(t, err, ErrAlreadyApproved)
+	_, err = acc.CancelRecovery(ctx, &v1.MsgCancelRecovery{})
+	require.NoError(t, err)
+
+	blockTime = blockTime.Add(time.Hour)
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{})
+	require.ErrorIs(t, err, ErrNoRecovery)
+	got, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, oldKey.Value, got.Value)
+
+	// updating the config cancels the pending recovery
+	_, err = acc.InitiateRecovery(asGuardian(ctx, "alice"), &v1.MsgInitiateRecovery{NewPubKey: newPubKey(t)})
+	require.NoError(t, err)
+	_, err = acc.UpdateConfig(asGuardian(ctx, "alice"), &v1.MsgUpdateConfig{Config: &v1.Config{Guardians: []string{"alice"}, Threshold: 1}})
+	require.ErrorIs(t, err, ErrUnauthorized)
+	_, err = acc.UpdateConfig(ctx, &v1.MsgUpdateConfig{Config: &v1.Config{Guardians: []string{"bob"}, Threshold: 1}})
+	require.NoError(t, err)
+	resp, err := acc.QueryPendingRecovery(ctx, &v1.QueryPendingRecovery{})
+	require.NoError(t, err)
+	require.Nil(t, resp.Recovery)
+	_, err = acc.InitiateRecovery(asGuardian(ctx, "alice"), &v1.MsgInitiateRecovery{NewPubKey: newPubKey(t)})
+	require.ErrorIs(t, err, ErrNotGuardian)
+
+	require.Contains(t, events, EventTypeRecoveryCancelled)
+	require.Contains(t, events, EventTypeConfigUpdated)
+}
+
+func TestRecoveryGriefing(t *testing.T) {
+	blockTime := time.Now()
+	var events []string
+	ctx, acc := setup(t, &blockTime, &events)
+	_, err := acc.Init(ctx, &v1.MsgInit{PubKey: newPubKey(t), Config: &v1.Config{Guardians: []string{"alice", "bob", "mallory"}, Threshold: 2, Delay: time.Hour}})
+	require.NoError(t, err)
+
+	// the owner lost their key and a malicious guardian initiates a recovery
+	// towards its own key, blocking the recovery initiated by other guardians
+	_, err = acc.InitiateRecovery(asGuardian(ctx, "mallory"), &v1.MsgInitiateRecovery{NewPubKey: newPubKey(t)})
+	require.NoError(t, err)
+	newKey := newPubKey(t)
+	_, err = acc.InitiateRecovery(asGuardian(ctx, "alice"), &v1.MsgInitiateRecovery{NewPubKey: newKey})
+	require.ErrorIs(t, err, ErrRecoveryPending)
+
+	// the other guardians cancel it
+	_, err = acc.CancelRecovery(asGuardian(ctx, "alice"), &v1.MsgCancelRecovery{})
+	require.NoError(t, err)
+	_, err = acc.CancelRecovery(asGuardian(ctx, "alice"), &v1.MsgCancelRecovery{})
+	require.ErrorIs(t, err, ErrAlreadyRequested)
+	_, err = acc.ApproveRecovery(asGuardian(ctx, "alice"), &v1.MsgApproveRecovery{})
+	require.ErrorIs(t, err, ErrAlreadyRequested)
+
+	resp, err := acc.QueryPendingRecovery(ctx, &v1.QueryPendingRecovery{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice"}, resp.Recovery.Cancellations)
+
+	// the cancellation reaching the threshold and the initiation of the new
+	// recovery are sent in the same transaction
+	_, err = acc.CancelRecovery(asGuardian(ctx, "bob"), &v1.MsgCancelRecovery{})
+	require.NoError(t, err)
+	_, err = acc.InitiateRecovery(asGuardian(ctx, "alice"), &v1.MsgInitiateRecovery{NewPubKey: newKey})
+	require.NoError(t, err)
+	_, err = acc.ApproveRecovery(asGuardian(ctx, "bob"), &v1.MsgApproveRecovery{})
+	require.NoError(t, err)
+
+	// the malicious guardian alone can't cancel the recovery
+	_, err = acc.CancelRecovery(asGuardian(ctx, "mallory"), &v1.MsgCancelRecovery{})
+	require.NoError(t, err)
+
+	blockTime = blockTime.Add(time.Hour)
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{})
+	require.NoError(t, err)
+	got, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, newKey.Value, got.Value)
+
+	require.Equal(t, []string{
+		EventTypeRecoveryInitiated,
+		EventTypeRecoveryApproved,
+		EventTypeCancelRequested,
+		EventTypeCancelRequested,
+		EventTypeRecoveryCancelled,
+		EventTypeRecoveryInitiated,
+		EventTypeRecoveryApproved,
+		EventTypeRecoveryApproved,
+		EventTypeCancelRequested,
+		EventTypeRecoveryExecuted,
+	}, events)
+}
@@ -12,6 +12,8 @@ import (
 	"cosmossdk.io/x/accounts/accountstd"
 	baseaccount "cosmossdk.io/x/accounts/defaults/base"
 	"cosmossdk.io/x/accounts/defaults/lockup"
+	"cosmossdk.io/x/accounts/defaults/multisig"
+	"cosmossdk.io/x/accounts/defaults/recovery"
 	"cosmossdk.io/x/tx/signing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -33,6 +35,7 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
+	Config       *modulev1.Module
 	Cdc          codec.Codec
 	Environment  appmodule.Environment
 	AddressCodec address.Codec
@@ -63,9 +66,26 @@ func (s directHandler) GetSignBytes(_ context.Context, _ signing.SignerData, _ s
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	handler := directHandler{}
-	account := baseaccount.NewAccount("base", signing.NewHandlerMap(handler))
+	account := baseaccount.NewAccount("base", signing.NewHandlerMap(handler),
+		baseaccount.WithSecp256K1PubKey(),
+		baseaccount.WithSecp256R1PubKey(),
+		baseaccount.WithEd25519PubKey(),
+		baseaccount.WithWebAuthnPubKey(),
+	)
+	multisigAccount := multisig.NewAccount("multisig", signing.NewHandlerMap(handler),
+		baseaccount.WithSecp256K1PubKey(),
+		baseaccount.WithSecp256R1PubKey(),
+		baseaccount.WithEd25519PubKey(),
+		baseaccount.WithWebAuthnPubKey(),
+	)
+	recoveryAccount := recovery.NewAccount("recovery", signing.NewHandlerMap(handler),
+		baseaccount.WithSecp256K1PubKey(),
+		baseaccount.WithSecp256R1PubKey(),
+		baseaccount.WithEd25519PubKey(),
+		baseaccount.WithWebAuthnPubKey(),
+	)
 	accountskeeper, err := NewKeeper(
-		in.Cdc, in.Environment, in.AddressCodec, in.Registry, account,
+		in.Cdc, in.Environment, in.AddressCodec, in.Registry, account, multisigAccount, recoveryAccount,
 		accountstd.AddAccount(lockup.CONTINUOUS_LOCKING_ACCOUNT, lockup.NewContinuousLockingAccount),
 		accountstd.AddAccount(lockup.PERIODIC_LOCKING_ACCOUNT, lockup.NewPeriodicLockingAccount),
 		accountstd.AddAccount(lockup.DELAYED_LOCKING_ACCOUNT, lockup.NewDelayedLockingAccount),
@@ -74,6 +94,11 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	if err != nil {
 		panic(err)
 	}
+	accountskeeper.SetBundledTxGasLimits(BundledTxGasLimits{
+		Authenticate:   in.Config.AuthenticateGasLimit,
+		BundlerPayment: in.Config.BundlerPaymentGasLimit,
+		Execute:        in.Config.ExecuteGasLimit,
+	})
 	m := NewAppModule(in.Cdc, accountskeeper)
 	return ModuleOutputs{AccountsKeeper: accountskeeper, Module: m}
 }
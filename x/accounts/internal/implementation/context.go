@@ -135,3 +135,11 @@ func Whoami(ctx context.Context) []byte {
 
 // Funds returns the funds associated with the execution context.
 func Funds(ctx context.Context) sdk.Coins { return getCtx(ctx).funds }
+
+// SetSender returns a copy of the account context with the given sender. It is
+// meant to be used in tests only.
+func SetSender(ctx context.Context, sender []byte) context.Context {
+	v := getCtx(ctx)
+	v.sender = sender
+	return addCtx(ctx, v)
+}
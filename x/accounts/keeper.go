@@ -60,6 +60,8 @@ func NewKeeper(
 		AccountsState:    collections.NewMap(sb, implementation.AccountStatePrefix, "accounts_state", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.BytesValue),
 	}
 
+	keeper.bundledTxGasLimits = DefaultBundledTxGasLimits()
+
 	schema, err := sb.Build()
 	if err != nil {
 		return Keeper{}, err
@@ -82,6 +84,9 @@ type Keeper struct {
 
 	accounts map[string]implementation.Implementation
 
+	// bundledTxGasLimits are the gas limits of the execution of the bundled txs.
+	bundledTxGasLimits BundledTxGasLimits
+
 	// Schema is the schema for the module.
 	Schema collections.Schema
 	// AccountNumber is the last global account number.
@@ -300,7 +305,6 @@ func (k Keeper) makeAccountContext(ctx context.Context, accountNumber uint64, ac
 
 // sendAnyMessages it a helper function that executes untyped codectypes.Any messages
 // The messages must all belong to a module.
-// nolint: unused // TODO: remove nolint when we bring back bundler payments
 func (k Keeper) sendAnyMessages(ctx context.Context, sender []byte, anyMessages []*implementation.Any) ([]*implementation.Any, error) {
 	anyResponses := make([]*implementation.Any, len(anyMessages))
 	for i := range anyMessages {
@@ -1,14 +1,22 @@
 package accounts
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
 	"cosmossdk.io/collections"
 	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/accounts/internal/implementation"
+	v1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/decode"
 
 	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 var (
@@ -18,8 +26,54 @@ var (
 	ErrBundlerPayment = errors.New("bundler payment failed")
 	// ErrExecution is returned when the execution fails.
 	ErrExecution = errors.New("execution failed")
+	// ErrInvalidBundledTx is returned when a bundled tx is malformed.
+	ErrInvalidBundledTx = errors.New("invalid bundled tx")
+	// ErrNotAbstractedAccount is returned when the signer of a bundled tx is
+	// not an abstracted account.
+	ErrNotAbstractedAccount = errors.New("not an abstracted account")
 )
 
+// BundledTxGasLimits defines the gas limits of the execution of the bundled txs.
+// A zero gas limit stands for its default value.
+type BundledTxGasLimits struct {
+	// Authenticate is the gas limit of the authentication of a bundled tx by its
+	// account, it defaults to DefaultAuthenticateGasLimit.
+	Authenticate uint64
+	// BundlerPayment is the gas limit of the payment of the bundler by the
+	// account, it defaults to DefaultBundlerPaymentGasLimit.
+	BundlerPayment uint64
+	// Execute is the gas limit of the messages of a bundled tx whose fee has no
+	// gas limit, it defaults to ExecuteGasLimit.
+	Execute uint64
+}
+
+// DefaultBundledTxGasLimits returns the default gas limits of the execution of
+// the bundled txs.
+func DefaultBundledTxGasLimits() BundledTxGasLimits {
+	return BundledTxGasLimits{
+		Authenticate:   DefaultAuthenticateGasLimit,
+		BundlerPayment: DefaultBundlerPaymentGasLimit,
+		Execute:        ExecuteGasLimit,
+	}
+}
+
+// SetBundledTxGasLimits sets the gas limits of the execution of the bundled txs,
+// the unset ones keep their default value. It must be called before the keeper
+// is used.
+func (k *Keeper) SetBundledTxGasLimits(limits BundledTxGasLimits) {
+	defaults := DefaultBundledTxGasLimits()
+	if limits.Authenticate == 0 {
+		limits.Authenticate = defaults.Authenticate
+	}
+	if limits.BundlerPayment == 0 {
+		limits.BundlerPayment = defaults.BundlerPayment
+	}
+	if limits.Execute == 0 {
+		limits.Execute = defaults.Execute
+	}
+	k.bundledTxGasLimits = limits
+}
+
 // IsAbstractedAccount returns if the provided address is an abstracted account or not.
 func (k Keeper) IsAbstractedAccount(ctx context.Context, addr []byte) (bool, error) {
 	accType, err := k.AccountsByType.Get(ctx, addr)
@@ -44,3 +98,161 @@ func (k Keeper) AuthenticateAccount(ctx context.Context, addr []byte, msg *aa_in
 	}
 	return nil
 }
+
+// ExecuteBundledTx executes a tx bundled by the bundler on behalf of an
+// abstracted account. The account is the signer of the messages of the tx,
+// the tx is executed in three steps:
+//   - the account authenticates the tx through its Authenticate handler.
+//   - the account reimburses the bundler with the fee of the tx.
+//   - the messages of the tx are executed on behalf of the account.
+//
+// The authentication and the bundler payment are atomic: if either fails, the
+// tx is not executed. The execution of the messages is atomic too, but its
+// failure does not revert the bundler payment. Each step is limited to the gas
+// limits set with SetBundledTxGasLimits, the execution of the messages to the
+// gas limit of the tx fee if set.
+// The failure of the tx is reported in the response, it does not fail the
+// bundle.
+func (k Keeper) ExecuteBundledTx(ctx context.Context, bundler string, bundledTx *tx.TxRaw) *v1.BundledTxResponse {
+	responses, err := k.executeBundledTx(ctx, bundler, bundledTx)
+	if err != nil {
+		return &v1.BundledTxResponse{Error: err.Error()}
+	}
+	return &v1.BundledTxResponse{ExecResponses: responses}
+}
+
+func (k Keeper) executeBundledTx(ctx context.Context, bundler string, bundledTx *tx.TxRaw) ([]*implementation.Any, error) {
+	bundlerAddr, err := k.addressCodec.StringToBytes(bundler)
+	if err != nil {
+		return nil, err
+	}
+	decodedTx, err := k.decodeBundledTx(ctx, bundledTx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidBundledTx, err)
+	}
+	accAddr, err := k.bundledTxSigner(decodedTx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidBundledTx, err)
+	}
+	isAbstracted, err := k.IsAbstractedAccount(ctx, accAddr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAbstracted {
+		return nil, ErrNotAbstractedAccount
+	}
+
+	err = k.BranchService.Execute(ctx, func(ctx context.Context) error {
+		_, err := k.BranchService.ExecuteWithGasLimit(ctx, k.bundledTxGasLimits.Authenticate, func(ctx context.Context) error {
+			return k.AuthenticateAccount(ctx, accAddr, &aa_interface_v1.MsgAuthenticate{
+				Bundler:     bundler,
+				RawTx:       bundledTx,
+				Tx:          decodedTx,
+				SignerIndex: 0,
+			})
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = k.BranchService.ExecuteWithGasLimit(ctx, k.bundledTxGasLimits.BundlerPayment, func(ctx context.Context) error {
+			return k.maybeSendFunds(ctx, accAddr, bundlerAddr, decodedTx.AuthInfo.Fee.Amount)
+		})
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrBundlerPayment, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	executionGasLimit := decodedTx.AuthInfo.Fee.GasLimit
+	if executionGasLimit == 0 {
+		executionGasLimit = k.bundledTxGasLimits.Execute
+	}
+	var responses []*implementation.Any
+	_, err = k.BranchService.ExecuteWithGasLimit(ctx, executionGasLimit, func(ctx context.Context) error {
+		resps, err := k.sendAnyMessages(ctx, accAddr, decodedTx.Body.Messages)
+		responses = resps
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrExecution, err)
+	}
+	return responses, nil
+}
+
+// decodeBundledTx decodes and validates a bundled tx. A bundled tx is signed by
+// a single signer, and its fee is paid by the signer.
+//
+// Unknown fields are rejected as by the tx decoder, except that the non-critical
+// ones are rejected from the body too: the accounts authenticate the bundled txs
+// assuming the body has none.
+func (k Keeper) decodeBundledTx(ctx context.Context, bundledTx *tx.TxRaw) (*tx.Tx, error) {
+	if bundledTx == nil {
+		return nil, errors.New("empty tx")
+	}
+	resolver := k.codec.InterfaceRegistry()
+	if err := decode.RejectUnknownFieldsStrict(bundledTx.BodyBytes, (&txv1beta1.TxBody{}).ProtoReflect().Descriptor(), resolver); err != nil {
+		return nil, err
+	}
+	if err := decode.RejectUnknownFieldsStrict(bundledTx.AuthInfoBytes, (&txv1beta1.AuthInfo{}).ProtoReflect().Descriptor(), resolver); err != nil {
+		return nil, err
+	}
+
+	// the messages are unpacked when executed.
+	body := new(tx.TxBody)
+	if err := gogoproto.Unmarshal(bundledTx.BodyBytes, body); err != nil {
+		return nil, err
+	}
+	authInfo := new(tx.AuthInfo)
+	if err := gogoproto.Unmarshal(bundledTx.AuthInfoBytes, authInfo); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(body.Messages) == 0:
+		return nil, errors.New("tx has no messages")
+	case len(body.ExtensionOptions) != 0 || len(body.NonCriticalExtensionOptions) != 0:
+		return nil, errors.New("tx extension options are not supported")
+	case body.Unordered:
+		// bundled txs are ordered by the sequence of their account
+		return nil, errors.New("unordered txs are not supported")
+	case body.TimeoutHeight != 0 && uint64(k.HeaderService.HeaderInfo(ctx).Height) > body.TimeoutHeight:
+		return nil, fmt.Errorf("tx timed out at height %d", body.TimeoutHeight)
+	case len(authInfo.SignerInfos) != 1 || len(bundledTx.Signatures) != 1:
+		return nil, errors.New("tx must have exactly one signer")
+	}
+	if authInfo.Fee == nil {
+		authInfo.Fee = &tx.Fee{}
+	}
+	if authInfo.Fee.Payer != "" || authInfo.Fee.Granter != "" {
+		return nil, errors.New("tx fee must be paid by the signer")
+	}
+
+	return &tx.Tx{Body: body, AuthInfo: authInfo, Signatures: bundledTx.Signatures}, nil
+}
+
+// bundledTxSigner returns the signer of the messages of the bundled tx, which
+// must all be signed by the same account.
+func (k Keeper) bundledTxSigner(decodedTx *tx.Tx) ([]byte, error) {
+	var signer []byte
+	for i, msg := range decodedTx.Body.Messages {
+		signers, _, err := k.codec.GetMsgAnySigners(msg)
+		if err != nil {
+			return nil, err
+		}
+		if len(signers) != 1 {
+			return nil, fmt.Errorf("message %d must have exactly one signer, got %d", i, len(signers))
+		}
+		if i == 0 {
+			signer = signers[0]
+			continue
+		}
+		if !bytes.Equal(signer, signers[0]) {
+			return nil, fmt.Errorf("message %d has a different signer", i)
+		}
+	}
+	return signer, nil
+}
@@ -0,0 +1,160 @@
+package accounts
+
+import (
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/internal/implementation"
+	"cosmossdk.io/x/accounts/testing/account_abstraction"
+	rotationv1 "cosmossdk.io/x/accounts/testing/rotation/v1"
+	v1 "cosmossdk.io/x/accounts/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func bundledTx(t *testing.T, authInfo *tx.AuthInfo, signatures int, msgs ...*bankv1beta1.MsgSend) *tx.TxRaw {
+	t.Helper()
+	body := &tx.TxBody{}
+	for _, msg := range msgs {
+		msgAny, err := implementation.PackAny(msg)
+		require.NoError(t, err)
+		body.Messages = append(body.Messages, &codectypes.Any{TypeUrl: msgAny.TypeUrl, Value: msgAny.Value})
+	}
+	bodyBytes, err := gogoproto.Marshal(body)
+	require.NoError(t, err)
+	authInfoBytes, err := gogoproto.Marshal(authInfo)
+	require.NoError(t, err)
+	return &tx.TxRaw{BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes, Signatures: make([][]byte, signatures)}
+}
+
+// withBody modifies the body of a bundled tx.
+func withBody(t *testing.T, raw *tx.TxRaw, modify func(body *tx.TxBody)) *tx.TxRaw {
+	t.Helper()
+	body := &tx.TxBody{}
+	require.NoError(t, gogoproto.Unmarshal(raw.BodyBytes, body))
+	modify(body)
+	bodyBytes, err := gogoproto.Marshal(body)
+	require.NoError(t, err)
+	return &tx.TxRaw{BodyBytes: bodyBytes, AuthInfoBytes: raw.AuthInfoBytes, Signatures: raw.Signatures}
+}
+
+// withUnknownField appends a varint field, unknown to the tx protos, to the body
+// or the auth info of a bundled tx.
+func withUnknownField(raw *tx.TxRaw, inBody bool, num protowire.Number) *tx.TxRaw {
+	field := protowire.AppendVarint(protowire.AppendTag(nil, num, protowire.VarintType), 1)
+	if inBody {
+		return &tx.TxRaw{BodyBytes: append(raw.BodyBytes, field...), AuthInfoBytes: raw.AuthInfoBytes, Signatures: raw.Signatures}
+	}
+	return &tx.TxRaw{BodyBytes: raw.BodyBytes, AuthInfoBytes: append(raw.AuthInfoBytes, field...), Signatures: raw.Signatures}
+}
+
+func send(from string) *bankv1beta1.MsgSend {
+	return &bankv1beta1.MsgSend{
+		FromAddress: from,
+		ToAddress:   "recipient",
+		Amount:      []*basev1beta1.Coin{{Denom: "atom", Amount: "10"}},
+	}
+}
+
+func TestExecuteBundle(t *testing.T) {
+	k, ctx := newKeeper(t,
+		accountstd.AddAccount("test", NewTestAccount),
+		accountstd.AddAccount("aa_minimal", account_abstraction.NewMinimalAbstractedAccount),
+	)
+	s := NewMsgServer(k)
+
+	_, aaAddr, err := k.Init(ctx, "aa_minimal", []byte("creator"), &rotationv1.MsgInit{PubKeyBytes: []byte("pubkey")}, nil)
+	require.NoError(t, err)
+	_, testAddr, err := k.Init(ctx, "test", []byte("creator"), &types.Empty{}, nil)
+	require.NoError(t, err)
+	aa, other := string(aaAddr), string(testAddr)
+
+	isAbstracted, err := k.IsAbstractedAccount(ctx, aaAddr)
+	require.NoError(t, err)
+	require.True(t, isAbstracted)
+
+	oneSigner := &tx.AuthInfo{
+		SignerInfos: []*tx.SignerInfo{{}},
+		Fee:         &tx.Fee{Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 1))},
+	}
+
+	valid := bundledTx(t, oneSigner, 1, send(aa), send(aa))
+	extensionOption := &codectypes.Any{TypeUrl: "/cosmos.bank.v1beta1.MsgSend"}
+
+	testCases := []struct {
+		name    string
+		tx      *tx.TxRaw
+		wantErr error
+	}{
+		{"ok", valid, nil},
+		{"no messages", bundledTx(t, oneSigner, 1), ErrInvalidBundledTx},
+		{"multiple signers", bundledTx(t, &tx.AuthInfo{SignerInfos: []*tx.SignerInfo{{}, {}}}, 2, send(aa)), ErrInvalidBundledTx},
+		{"messages of different signers", bundledTx(t, oneSigner, 1, send(aa), send(other)), ErrInvalidBundledTx},
+		{"fee payer", bundledTx(t, &tx.AuthInfo{SignerInfos: []*tx.SignerInfo{{}}, Fee: &tx.Fee{Payer: other}}, 1, send(aa)), ErrInvalidBundledTx},
+		{"not an abstracted account", bundledTx(t, oneSigner, 1, send(other)), ErrNotAbstractedAccount},
+		{"invalid tx", &tx.TxRaw{BodyBytes: []byte("invalid")}, ErrInvalidBundledTx},
+		{"extension options", withBody(t, valid, func(body *tx.TxBody) { body.ExtensionOptions = []*codectypes.Any{extensionOption} }), ErrInvalidBundledTx},
+		{"non-critical extension options", withBody(t, valid, func(body *tx.TxBody) { body.NonCriticalExtensionOptions = []*codectypes.Any{extensionOption} }), ErrInvalidBundledTx},
+		{"unordered", withBody(t, valid, func(body *tx.TxBody) { body.Unordered, body.TimeoutHeight = true, 100 }), ErrInvalidBundledTx},
+		// e.g. the timeout_timestamp of newer txs
+		{"unknown body field", withUnknownField(valid, true, 5), ErrInvalidBundledTx},
+		{"unknown non-critical body field", withUnknownField(valid, true, 1030), ErrInvalidBundledTx},
+		{"unknown auth info field", withUnknownField(valid, false, 10), ErrInvalidBundledTx},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := s.ExecuteBundle(ctx, &v1.MsgExecuteBundle{Bundler: "bundler", Txs: []*tx.TxRaw{tc.tx}})
+			require.NoError(t, err)
+			require.Len(t, resp.Responses, 1)
+			if tc.wantErr != nil {
+				require.Contains(t, resp.Responses[0].Error, tc.wantErr.Error())
+				require.Empty(t, resp.Responses[0].ExecResponses)
+				return
+			}
+			require.Empty(t, resp.Responses[0].Error)
+			require.Len(t, resp.Responses[0].ExecResponses, 2)
+		})
+	}
+
+	// empty bundles are rejected
+	_, err = s.ExecuteBundle(ctx, &v1.MsgExecuteBundle{Bundler: "bundler"})
+	require.ErrorIs(t, err, ErrInvalidBundledTx)
+}
+
+func TestExecuteBundleGasLimits(t *testing.T) {
+	k, ctx := newKeeper(t, accountstd.AddAccount("aa_minimal", account_abstraction.NewMinimalAbstractedAccount))
+	var gasLimits []uint64
+	k.BranchService = branchService{gasLimits: &gasLimits}
+
+	_, aaAddr, err := k.Init(ctx, "aa_minimal", []byte("creator"), &rotationv1.MsgInit{PubKeyBytes: []byte("pubkey")}, nil)
+	require.NoError(t, err)
+	noGasLimit := bundledTx(t, &tx.AuthInfo{SignerInfos: []*tx.SignerInfo{{}}}, 1, send(string(aaAddr)))
+	withGasLimit := bundledTx(t, &tx.AuthInfo{SignerInfos: []*tx.SignerInfo{{}}, Fee: &tx.Fee{GasLimit: 50_000}}, 1, send(string(aaAddr)))
+
+	// the defaults are not the simulation gas limits
+	resp := k.ExecuteBundledTx(ctx, "bundler", noGasLimit)
+	require.Empty(t, resp.Error)
+	require.Equal(t, []uint64{DefaultAuthenticateGasLimit, DefaultBundlerPaymentGasLimit, ExecuteGasLimit}, gasLimits)
+
+	// the unset gas limits keep their default value
+	gasLimits = nil
+	k.SetBundledTxGasLimits(BundledTxGasLimits{Authenticate: 100_000, Execute: 300_000})
+	resp = k.ExecuteBundledTx(ctx, "bundler", noGasLimit)
+	require.Empty(t, resp.Error)
+	require.Equal(t, []uint64{100_000, DefaultBundlerPaymentGasLimit, 300_000}, gasLimits)
+
+	// the messages are limited to the gas limit of the tx fee if set
+	gasLimits = nil
+	resp = k.ExecuteBundledTx(ctx, "bundler", withGasLimit)
+	require.Empty(t, resp.Error)
+	require.Equal(t, []uint64{100_000, DefaultBundlerPaymentGasLimit, 50_000}, gasLimits)
+}
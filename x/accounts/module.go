@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/registry"
 	"cosmossdk.io/x/accounts/cli"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
 	v1 "cosmossdk.io/x/accounts/v1"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -52,6 +53,9 @@ func (AppModule) Name() string { return ModuleName }
 
 func (m AppModule) RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	msgservice.RegisterMsgServiceDesc(registrar, v1.MsgServiceDesc())
+	// the secp256k1, secp256r1 and ed25519 pubkeys are registered by the crypto
+	// codec, the base accounts also support WebAuthn pubkeys
+	basev1.RegisterInterfaces(registrar)
 }
 
 // App module services
@@ -0,0 +1,65 @@
+package accounts
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/v1"
+	authsigning "cosmossdk.io/x/auth/signing"
+	authtx "cosmossdk.io/x/auth/tx"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestRegisterInterfaces(t *testing.T) {
+	opts := codectestutil.CodecOptions{}
+	ir := opts.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(ir)
+	AppModule{}.RegisterInterfaces(ir)
+	cdc := codec.NewProtoCodec(ir)
+	txConfig := authtx.NewTxConfig(cdc, opts.GetAddressCodec(), opts.GetValidatorCodec(), authtx.DefaultSignModes)
+
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pubKey := &basev1.WebAuthnPubKey{Key: elliptic.MarshalCompressed(elliptic.P256(), privKey.X, privKey.Y), RpId: "wallet.example"}
+	sender, err := opts.GetAddressCodec().BytesToString(pubKey.Address())
+	require.NoError(t, err)
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&v1.MsgExecute{Sender: sender, Target: sender}))
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte("signature")},
+		Sequence: 1,
+	}))
+	txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	tx, err := txConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	sigs, err := tx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.True(t, pubKey.Equals(sigs[0].PubKey))
+
+	// the codec resolves the pubkey through the interface registry
+	var protoTx txtypes.Tx
+	require.NoError(t, cdc.Unmarshal(txBytes, &protoTx))
+	require.True(t, pubKey.Equals(protoTx.AuthInfo.SignerInfos[0].PublicKey.GetCachedValue().(cryptotypes.PubKey)))
+
+	txJSON, err := cdc.MarshalJSON(&protoTx)
+	require.NoError(t, err)
+	protoTx = txtypes.Tx{}
+	require.NoError(t, cdc.UnmarshalJSON(txJSON, &protoTx))
+	require.True(t, pubKey.Equals(protoTx.AuthInfo.SignerInfos[0].PublicKey.GetCachedValue().(cryptotypes.PubKey)))
+}
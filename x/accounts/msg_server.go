@@ -2,6 +2,7 @@ package accounts
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/x/accounts/internal/implementation"
@@ -96,5 +97,19 @@ func (m msgServer) Execute(ctx context.Context, execute *v1.MsgExecute) (*v1.Msg
 }
 
 func (m msgServer) ExecuteBundle(ctx context.Context, req *v1.MsgExecuteBundle) (*v1.MsgExecuteBundleResponse, error) {
-	panic("impl")
+	_, err := m.k.addressCodec.StringToBytes(req.Bundler)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Txs) == 0 {
+		return nil, fmt.Errorf("%w: empty bundle", ErrInvalidBundledTx)
+	}
+
+	// the failure of a bundled tx does not fail the bundle, as the bundler
+	// is reimbursed by the txs which were executed.
+	responses := make([]*v1.BundledTxResponse, len(req.Txs))
+	for i, bundledTx := range req.Txs {
+		responses[i] = m.k.ExecuteBundledTx(ctx, req.Bundler, bundledTx)
+	}
+	return &v1.MsgExecuteBundleResponse{Responses: responses}, nil
 }
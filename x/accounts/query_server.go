@@ -89,4 +89,10 @@ const (
 	SimulateAuthenticateGasLimit   = 1_000_000
 	SimulateBundlerPaymentGasLimit = SimulateAuthenticateGasLimit
 	ExecuteGasLimit                = SimulateAuthenticateGasLimit
+
+	// DefaultAuthenticateGasLimit and DefaultBundlerPaymentGasLimit are the
+	// default gas limits of the authentication and of the bundler payment of the
+	// bundled txs, see BundledTxGasLimits.
+	DefaultAuthenticateGasLimit   = 500_000
+	DefaultBundlerPaymentGasLimit = 200_000
 )
@@ -42,6 +42,23 @@ func (e eventService) EmitKV(eventType string, attrs ...event.Attribute) error {
 
 func (e eventService) EventManager(ctx context.Context) event.Manager { return e }
 
+// branchService executes the functions without isolation, the mock store does
+// not support branching. The gas limits are recorded if gasLimits is set.
+type branchService struct {
+	gasLimits *[]uint64
+}
+
+func (branchService) Execute(ctx context.Context, f func(ctx context.Context) error) error {
+	return f(ctx)
+}
+
+func (b branchService) ExecuteWithGasLimit(ctx context.Context, gasLimit uint64, f func(ctx context.Context) error) (uint64, error) {
+	if b.gasLimits != nil {
+		*b.gasLimits = append(*b.gasLimits, gasLimit)
+	}
+	return 0, f(ctx)
+}
+
 func newKeeper(t *testing.T, accounts ...implementation.AccountCreatorFunc) (Keeper, context.Context) {
 	t.Helper()
 
@@ -79,6 +96,7 @@ func newKeeper(t *testing.T, accounts ...implementation.AccountCreatorFunc) (Kee
 		msgRouter,
 	))
 	env.EventService = eventService{}
+	env.BranchService = branchService{}
 	m, err := NewKeeper(codec.NewProtoCodec(ir), env, addressCodec, ir, accounts...)
 	require.NoError(t, err)
 	return m, ctx
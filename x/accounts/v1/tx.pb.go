@@ -340,8 +340,10 @@ func (m *MsgExecuteBundle) GetTxs() []*tx.TxRaw {
 
 // BundledTxResponse defines the response of a bundled tx.
 type BundledTxResponse struct {
-	ExecResponses *any.Any `protobuf:"bytes,1,opt,name=exec_responses,json=execResponses,proto3" json:"exec_responses,omitempty"`
-	Error         string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// exec_responses defines the responses of the messages of the bundled tx.
+	ExecResponses []*any.Any `protobuf:"bytes,1,rep,name=exec_responses,json=execResponses,proto3" json:"exec_responses,omitempty"`
+	// error defines the error of the bundled tx, if it failed.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
 func (m *BundledTxResponse) Reset()         { *m = BundledTxResponse{} }
@@ -377,7 +379,7 @@ func (m *BundledTxResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BundledTxResponse proto.InternalMessageInfo
 
-func (m *BundledTxResponse) GetExecResponses() *any.Any {
+func (m *BundledTxResponse) GetExecResponses() []*any.Any {
 	if m != nil {
 		return m.ExecResponses
 	}
@@ -450,46 +452,45 @@ func init() {
 func init() { proto.RegisterFile("cosmos/accounts/v1/tx.proto", fileDescriptor_29c2b6d8a13d4189) }
 
 var fileDescriptor_29c2b6d8a13d4189 = []byte{
-	// 609 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x9b, 0xb6, 0xf9, 0x7a, 0xd3, 0x9f, 0x8f, 0x51, 0x55, 0x5c, 0x57, 0x72, 0x4b, 0xf8,
-	0x8b, 0x2a, 0x18, 0x37, 0x85, 0x55, 0x59, 0xb5, 0x15, 0x08, 0x16, 0x5d, 0x60, 0x75, 0xc5, 0xa6,
-	0xf2, 0xcf, 0x64, 0x88, 0xda, 0x78, 0x22, 0xdf, 0x71, 0x71, 0x76, 0x88, 0x07, 0x40, 0x3c, 0x07,
-	0xab, 0x3e, 0x46, 0x97, 0x5d, 0xb2, 0x40, 0x80, 0x1a, 0xa4, 0xbe, 0x06, 0xb2, 0x3d, 0xe3, 0x04,
-	0x4a, 0xa2, 0x2e, 0x59, 0x65, 0xe6, 0x9e, 0x73, 0xef, 0x9c, 0x73, 0xc6, 0x19, 0x58, 0x0b, 0x04,
-	0x76, 0x05, 0x3a, 0x5e, 0x10, 0x88, 0x24, 0x92, 0xe8, 0x9c, 0xb6, 0x1c, 0x99, 0xd2, 0x5e, 0x2c,
-	0xa4, 0x20, 0xa4, 0x00, 0xa9, 0x06, 0xe9, 0x69, 0xcb, 0x5a, 0xe5, 0x42, 0xf0, 0x13, 0xe6, 0xe4,
-	0x0c, 0x3f, 0x69, 0x3b, 0x5e, 0xd4, 0x2f, 0xe8, 0xd6, 0x6d, 0x35, 0xab, 0x8b, 0x3c, 0x1b, 0xd3,
-	0x45, 0xae, 0x00, 0x5b, 0x01, 0xbe, 0x87, 0xcc, 0x39, 0x6d, 0xf9, 0x4c, 0x7a, 0x2d, 0x27, 0x10,
-	0x9d, 0x48, 0xe1, 0x96, 0xc2, 0x65, 0x5a, 0xa2, 0x5a, 0x83, 0xb5, 0xcc, 0x05, 0x17, 0xf9, 0xd2,
-	0xc9, 0x56, 0x45, 0xb5, 0xf1, 0xd3, 0x80, 0xda, 0x01, 0xf2, 0x57, 0x51, 0x47, 0x92, 0x15, 0x98,
-	0x45, 0x16, 0x85, 0x2c, 0x36, 0x8d, 0x0d, 0xa3, 0x39, 0xe7, 0xaa, 0x1d, 0xb9, 0x03, 0xf3, 0x4a,
-	0xf8, 0x91, 0xec, 0xf7, 0x98, 0x39, 0x95, 0xa3, 0x75, 0x55, 0x3b, 0xec, 0xf7, 0x18, 0xa1, 0x50,
-	0xeb, 0x32, 0x44, 0x8f, 0x33, 0xb3, 0xba, 0x61, 0x34, 0xeb, 0xdb, 0xcb, 0xb4, 0xb0, 0x47, 0xb5,
-	0x3d, 0xba, 0x1b, 0xf5, 0x5d, 0x4d, 0x22, 0x1e, 0xcc, 0xb4, 0x93, 0x28, 0x44, 0x73, 0x7a, 0xa3,
-	0xda, 0xac, 0x6f, 0xaf, 0x52, 0x15, 0x50, 0x66, 0x8c, 0x2a, 0xe9, 0x74, 0x5f, 0x74, 0xa2, 0xbd,
-	0xad, 0xf3, 0x6f, 0xeb, 0x95, 0xcf, 0xdf, 0xd7, 0x9b, 0xbc, 0x23, 0xdf, 0x26, 0x3e, 0x0d, 0x44,
-	0xd7, 0x51, 0x2e, 0x8b, 0x9f, 0xc7, 0x18, 0x1e, 0x3b, 0x99, 0x2e, 0xcc, 0x1b, 0xd0, 0x2d, 0x26,
-	0xef, 0xd4, 0x3f, 0x5c, 0x9d, 0x6d, 0x2a, 0x0b, 0x8d, 0x13, 0x58, 0x52, 0x2e, 0x5d, 0x86, 0x3d,
-	0x11, 0x21, 0x23, 0x0f, 0x61, 0x49, 0xbb, 0xf2, 0xc2, 0x30, 0x66, 0x88, 0xca, 0xf6, 0xa2, 0x2a,
-	0xef, 0x16, 0x55, 0xb2, 0x05, 0xff, 0xc5, 0xaa, 0x29, 0xb7, 0x3e, 0xce, 0x5c, 0xc9, 0x6a, 0x7c,
-	0x35, 0x00, 0x0e, 0x90, 0x3f, 0x4f, 0x59, 0x90, 0x48, 0x36, 0x36, 0xd7, 0x15, 0x98, 0x95, 0x5e,
-	0xcc, 0x99, 0x54, 0x89, 0xaa, 0xdd, 0x3f, 0x1f, 0xe6, 0x0b, 0x20, 0x43, 0x77, 0x65, 0x9e, 0xa3,
-	0x31, 0x19, 0x37, 0x8a, 0xa9, 0x0d, 0xff, 0x0f, 0xe7, 0xec, 0x25, 0x51, 0x78, 0xc2, 0x88, 0x09,
-	0x35, 0x3f, 0x5f, 0xe9, 0xb0, 0xf4, 0x96, 0x6c, 0x42, 0x55, 0xa6, 0x68, 0x4e, 0xe5, 0x1e, 0x4d,
-	0xed, 0x51, 0xa6, 0xa5, 0xc3, 0xc3, 0xd4, 0xf5, 0xde, 0xb9, 0x19, 0x69, 0x67, 0x3e, 0x93, 0xab,
-	0x3b, 0x1b, 0x6d, 0xb8, 0x55, 0x4c, 0x0f, 0x0f, 0xd3, 0x52, 0xee, 0x33, 0x58, 0x64, 0x29, 0x0b,
-	0x8e, 0xb4, 0x1a, 0x9c, 0x28, 0x7a, 0x21, 0xe3, 0xea, 0x5e, 0x24, 0xcb, 0x30, 0xc3, 0xe2, 0x58,
-	0xc4, 0xea, 0xe2, 0x8a, 0x4d, 0xe3, 0x08, 0xcc, 0x3f, 0xfd, 0x94, 0xc7, 0xed, 0xc3, 0xdc, 0xe8,
-	0x49, 0x99, 0x87, 0xfb, 0xf4, 0xfa, 0xab, 0x40, 0xaf, 0x09, 0x75, 0x87, 0x7d, 0xdb, 0x1f, 0xa7,
-	0xa0, 0x7a, 0x80, 0x9c, 0xbc, 0x84, 0xe9, 0xfc, 0x0f, 0xbb, 0xf6, 0xb7, 0x09, 0xea, 0x3b, 0xb7,
-	0xee, 0x4e, 0x00, 0x4b, 0x59, 0xaf, 0xa1, 0xa6, 0xbf, 0x52, 0x7b, 0x0c, 0x5f, 0xe1, 0xd6, 0x83,
-	0xc9, 0x78, 0x39, 0x32, 0x80, 0x85, 0xdf, 0xaf, 0xf4, 0xde, 0xe4, 0xc6, 0x82, 0x65, 0x3d, 0xba,
-	0x09, 0x4b, 0x1f, 0x62, 0xcd, 0xbc, 0xbf, 0x3a, 0xdb, 0x34, 0xf6, 0x9e, 0x9e, 0x5f, 0xda, 0xc6,
-	0xc5, 0xa5, 0x6d, 0xfc, 0xb8, 0xb4, 0x8d, 0x4f, 0x03, 0xbb, 0x72, 0x31, 0xb0, 0x2b, 0x5f, 0x06,
-	0x76, 0xe5, 0x8d, 0x7a, 0x09, 0x31, 0x3c, 0xa6, 0x1d, 0xe1, 0xa4, 0xa3, 0xcf, 0xb2, 0x3f, 0x9b,
-	0x5f, 0xed, 0x93, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x6a, 0x98, 0xb0, 0xb3, 0x05, 0x00,
-	0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0x93, 0xb6, 0xa1, 0x37, 0x7d, 0xc0, 0xa8, 0x2a, 0xae, 0x2b, 0xb9, 0x25, 0xbc, 0xa2,
+	0x0a, 0xc6, 0x4d, 0x61, 0x55, 0x56, 0x4d, 0x05, 0x82, 0x45, 0x16, 0x58, 0x59, 0xb1, 0x89, 0xfc,
+	0x98, 0x0c, 0x51, 0x13, 0x4f, 0xe4, 0x19, 0x07, 0x67, 0x87, 0xf8, 0x00, 0xc4, 0x77, 0xb0, 0xea,
+	0x67, 0x74, 0xd9, 0x25, 0x0b, 0x04, 0x28, 0x41, 0xea, 0x6f, 0x20, 0xdb, 0x33, 0x4e, 0x69, 0x49,
+	0xd4, 0x25, 0xab, 0xcc, 0xcc, 0x39, 0xf7, 0xce, 0x39, 0xe7, 0x3a, 0x03, 0xdb, 0x1e, 0xe3, 0x7d,
+	0xc6, 0x2d, 0xc7, 0xf3, 0x58, 0x14, 0x08, 0x6e, 0x0d, 0xeb, 0x96, 0x88, 0xf1, 0x20, 0x64, 0x82,
+	0x21, 0x94, 0x81, 0x58, 0x81, 0x78, 0x58, 0x37, 0xb6, 0x28, 0x63, 0xb4, 0x47, 0xac, 0x94, 0xe1,
+	0x46, 0x1d, 0xcb, 0x09, 0x46, 0x19, 0xdd, 0xb8, 0x2b, 0x7b, 0xf5, 0x39, 0x4d, 0xda, 0xf4, 0x39,
+	0x95, 0x80, 0x29, 0x01, 0xd7, 0xe1, 0xc4, 0x1a, 0xd6, 0x5d, 0x22, 0x9c, 0xba, 0xe5, 0xb1, 0x6e,
+	0x20, 0x71, 0x43, 0xe2, 0x22, 0xce, 0x51, 0xa5, 0xc1, 0xd8, 0xa0, 0x8c, 0xb2, 0x74, 0x69, 0x25,
+	0xab, 0xec, 0xb4, 0xfa, 0x5b, 0x83, 0x72, 0x93, 0xd3, 0x37, 0x41, 0x57, 0xa0, 0x4d, 0x58, 0xe2,
+	0x24, 0xf0, 0x49, 0xa8, 0x6b, 0xbb, 0x5a, 0x6d, 0xd9, 0x96, 0x3b, 0x74, 0x0f, 0x56, 0xa4, 0xf0,
+	0xb6, 0x18, 0x0d, 0x88, 0x5e, 0x4c, 0xd1, 0x8a, 0x3c, 0x6b, 0x8d, 0x06, 0x04, 0x61, 0x28, 0xf7,
+	0x09, 0xe7, 0x0e, 0x25, 0x7a, 0x69, 0x57, 0xab, 0x55, 0x0e, 0x36, 0x70, 0x66, 0x0f, 0x2b, 0x7b,
+	0xf8, 0x28, 0x18, 0xd9, 0x8a, 0x84, 0x1c, 0x58, 0xec, 0x44, 0x81, 0xcf, 0xf5, 0x85, 0xdd, 0x52,
+	0xad, 0x72, 0xb0, 0x85, 0x65, 0x40, 0x89, 0x31, 0x2c, 0xa5, 0xe3, 0x63, 0xd6, 0x0d, 0x1a, 0xfb,
+	0x67, 0x3f, 0x76, 0x0a, 0x5f, 0x7f, 0xee, 0xd4, 0x68, 0x57, 0xbc, 0x8f, 0x5c, 0xec, 0xb1, 0xbe,
+	0x25, 0x5d, 0x66, 0x3f, 0x4f, 0xb9, 0x7f, 0x62, 0x25, 0xba, 0x78, 0x5a, 0xc0, 0xed, 0xac, 0xf3,
+	0x61, 0xe5, 0xd3, 0xc5, 0xe9, 0x9e, 0xb4, 0x50, 0xed, 0xc1, 0xba, 0x74, 0x69, 0x13, 0x3e, 0x60,
+	0x01, 0x27, 0xe8, 0x31, 0xac, 0x2b, 0x57, 0x8e, 0xef, 0x87, 0x84, 0x73, 0x69, 0x7b, 0x4d, 0x1e,
+	0x1f, 0x65, 0xa7, 0x68, 0x1f, 0x6e, 0x85, 0xb2, 0x48, 0x2f, 0xce, 0x31, 0x97, 0xb3, 0xaa, 0xdf,
+	0x35, 0x80, 0x26, 0xa7, 0x2f, 0x63, 0xe2, 0x45, 0x82, 0xcc, 0xcc, 0x75, 0x13, 0x96, 0x84, 0x13,
+	0x52, 0x22, 0x64, 0xa2, 0x72, 0xf7, 0xdf, 0x87, 0xf9, 0x0a, 0xd0, 0xd4, 0x5d, 0x9e, 0xe7, 0xe5,
+	0x98, 0xb4, 0x1b, 0xc5, 0xd4, 0x81, 0xdb, 0xd3, 0x3e, 0x8d, 0x28, 0xf0, 0x7b, 0x04, 0xe9, 0x50,
+	0x76, 0xd3, 0x95, 0x0a, 0x4b, 0x6d, 0xd1, 0x1e, 0x94, 0x44, 0xcc, 0xf5, 0x62, 0xea, 0x51, 0x57,
+	0x1e, 0x45, 0x9c, 0x3b, 0x6c, 0xc5, 0xb6, 0xf3, 0xc1, 0x4e, 0x48, 0x87, 0x2b, 0x89, 0x5c, 0x55,
+	0x59, 0xed, 0xc0, 0x9d, 0xac, 0xbb, 0xdf, 0x8a, 0x73, 0xb9, 0x2f, 0x60, 0x8d, 0xc4, 0xc4, 0x6b,
+	0x2b, 0x35, 0xc9, 0xf4, 0x4b, 0x33, 0x45, 0xaf, 0x26, 0x5c, 0x55, 0xcb, 0xd1, 0x06, 0x2c, 0x92,
+	0x30, 0x64, 0xa1, 0x1c, 0x5c, 0xb6, 0xa9, 0xb6, 0x41, 0xbf, 0xea, 0x27, 0xbf, 0xee, 0x18, 0x96,
+	0xaf, 0xde, 0xf4, 0x10, 0x5f, 0x7f, 0x15, 0xf0, 0x35, 0xa1, 0xf6, 0xb4, 0xee, 0xe0, 0x73, 0x11,
+	0x4a, 0x4d, 0x4e, 0xd1, 0x6b, 0x58, 0x48, 0xff, 0xb0, 0xdb, 0xff, 0xea, 0x20, 0xbf, 0x73, 0xe3,
+	0xfe, 0x1c, 0x30, 0x97, 0xf5, 0x16, 0xca, 0xea, 0x2b, 0x35, 0x67, 0xf0, 0x25, 0x6e, 0x3c, 0x9a,
+	0x8f, 0xe7, 0x2d, 0x3d, 0x58, 0xfd, 0x7b, 0xa4, 0x0f, 0xe6, 0x17, 0x66, 0x2c, 0xe3, 0xc9, 0x4d,
+	0x58, 0xea, 0x12, 0x63, 0xf1, 0xe3, 0xc5, 0xe9, 0x9e, 0xd6, 0x78, 0x7e, 0x36, 0x36, 0xb5, 0xf3,
+	0xb1, 0xa9, 0xfd, 0x1a, 0x9b, 0xda, 0x97, 0x89, 0x59, 0x38, 0x9f, 0x98, 0x85, 0x6f, 0x13, 0xb3,
+	0xf0, 0x4e, 0xbe, 0x84, 0xdc, 0x3f, 0xc1, 0x5d, 0x66, 0xc5, 0x97, 0x9f, 0x65, 0x77, 0x29, 0x1d,
+	0xed, 0xb3, 0x3f, 0x03, 0x00, 0xbb, 0x40, 0x93, 0x43, 0xb3, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -926,17 +927,19 @@ func (m *BundledTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ExecResponses != nil {
-		{
-			size, err := m.ExecResponses.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.ExecResponses) > 0 {
+		for iNdEx := len(m.ExecResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
@@ -1098,9 +1101,11 @@ func (m *BundledTxResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.ExecResponses != nil {
-		l = m.ExecResponses.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ExecResponses) > 0 {
+		for _, e := range m.ExecResponses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
 	l = len(m.Error)
 	if l > 0 {
@@ -1876,10 +1881,8 @@ func (m *BundledTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecResponses == nil {
-				m.ExecResponses = &any.Any{}
-			}
-			if err := m.ExecResponses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExecResponses = append(m.ExecResponses, &any.Any{})
+			if err := m.ExecResponses[len(m.ExecResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
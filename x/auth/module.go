@@ -8,6 +8,7 @@ import (
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/registry"
 	"cosmossdk.io/x/auth/keeper"
@@ -27,8 +28,9 @@ const (
 )
 
 var (
-	_ module.AppModuleSimulation = AppModule{}
-	_ module.HasName             = AppModule{}
+	_ module.AppModuleSimulation  = AppModule{}
+	_ module.HasName              = AppModule{}
+	_ module.HasCollectionsSchema = AppModule{}
 
 	_ appmodule.HasGenesis    = AppModule{}
 	_ appmodule.AppModule     = AppModule{}
@@ -47,6 +49,11 @@ type AppModule struct {
 // IsAppModule implements the appmodule.AppModule interface.
 func (am AppModule) IsAppModule() {}
 
+// CollectionsSchema implements module.HasCollectionsSchema.
+func (am AppModule) CollectionsSchema() collections.Schema {
+	return am.accountKeeper.Schema
+}
+
 // NewAppModule creates a new AppModule object
 func NewAppModule(cdc codec.Codec, accountKeeper keeper.AccountKeeper, ak types.AccountsModKeeper, randGenAccountsFn types.RandomGenesisAccountsFn) AppModule {
 	return AppModule{
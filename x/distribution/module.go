@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/registry"
 	"cosmossdk.io/x/distribution/client/cli"
@@ -27,11 +28,12 @@ import (
 const ConsensusVersion = 4
 
 var (
-	_ module.HasName             = AppModule{}
-	_ module.HasAminoCodec       = AppModule{}
-	_ module.HasGRPCGateway      = AppModule{}
-	_ module.AppModuleSimulation = AppModule{}
-	_ module.HasInvariants       = AppModule{}
+	_ module.HasName              = AppModule{}
+	_ module.HasCollectionsSchema = AppModule{}
+	_ module.HasAminoCodec        = AppModule{}
+	_ module.HasGRPCGateway       = AppModule{}
+	_ module.AppModuleSimulation  = AppModule{}
+	_ module.HasInvariants        = AppModule{}
 
 	_ appmodule.AppModule             = AppModule{}
 	_ appmodule.HasBeginBlocker       = AppModule{}
@@ -69,6 +71,11 @@ func NewAppModule(
 // IsAppModule implements the appmodule.AppModule interface.
 func (am AppModule) IsAppModule() {}
 
+// CollectionsSchema implements module.HasCollectionsSchema.
+func (am AppModule) CollectionsSchema() collections.Schema {
+	return am.keeper.Schema
+}
+
 // Name returns the distribution module's name.
 func (AppModule) Name() string {
 	return types.ModuleName
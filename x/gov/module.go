@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/registry"
 	govclient "cosmossdk.io/x/gov/client"
@@ -29,11 +30,12 @@ import (
 const ConsensusVersion = 6
 
 var (
-	_ module.HasName             = AppModule{}
-	_ module.HasAminoCodec       = AppModule{}
-	_ module.HasGRPCGateway      = AppModule{}
-	_ module.AppModuleSimulation = AppModule{}
-	_ module.HasInvariants       = AppModule{}
+	_ module.HasName              = AppModule{}
+	_ module.HasCollectionsSchema = AppModule{}
+	_ module.HasAminoCodec        = AppModule{}
+	_ module.HasGRPCGateway       = AppModule{}
+	_ module.AppModuleSimulation  = AppModule{}
+	_ module.HasInvariants        = AppModule{}
 
 	_ appmodule.AppModule             = AppModule{}
 	_ appmodule.HasEndBlocker         = AppModule{}
@@ -73,6 +75,11 @@ func NewAppModule(
 // IsAppModule implements the appmodule.AppModule interface.
 func (am AppModule) IsAppModule() {}
 
+// CollectionsSchema implements module.HasCollectionsSchema.
+func (am AppModule) CollectionsSchema() collections.Schema {
+	return am.keeper.Schema
+}
+
 // Name returns the gov module's name.
 func (AppModule) Name() string {
 	return govtypes.ModuleName
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/registry"
 	"cosmossdk.io/depinject"
@@ -27,13 +28,14 @@ const (
 )
 
 var (
-	_ module.AppModuleSimulation = AppModule{}
-	_ module.HasName             = AppModule{}
-	_ module.HasAminoCodec       = AppModule{}
-	_ module.HasGRPCGateway      = AppModule{}
-	_ module.HasInvariants       = AppModule{}
-	_ module.HasABCIGenesis      = AppModule{}
-	_ module.HasABCIEndBlock     = AppModule{}
+	_ module.AppModuleSimulation  = AppModule{}
+	_ module.HasName              = AppModule{}
+	_ module.HasCollectionsSchema = AppModule{}
+	_ module.HasAminoCodec        = AppModule{}
+	_ module.HasGRPCGateway       = AppModule{}
+	_ module.HasInvariants        = AppModule{}
+	_ module.HasABCIGenesis       = AppModule{}
+	_ module.HasABCIEndBlock      = AppModule{}
 
 	_ appmodule.AppModule             = AppModule{}
 	_ appmodule.HasBeginBlocker       = AppModule{}
@@ -70,6 +72,11 @@ func NewAppModule(
 // IsAppModule implements the appmodule.AppModule interface.
 func (am AppModule) IsAppModule() {}
 
+// CollectionsSchema implements module.HasCollectionsSchema.
+func (am AppModule) CollectionsSchema() collections.Schema {
+	return am.keeper.Schema
+}
+
 // Name returns the staking module's name.
 func (AppModule) Name() string {
 	return types.ModuleName